@@ -0,0 +1,126 @@
+#!/bin/sh
+
+# This script exports or imports the slashing protection history of a validator client as an EIP-3076 interchange file.
+# It runs in a one-off container of the client's image, with the same data folders as start-vc.sh.
+# Usage: slashing-protection.sh export|import <file>
+# An export of a client without a slashing protection database succeeds without writing the file.
+
+OPERATION=$1
+FILE=$2
+
+if [ "$OPERATION" != "export" ] && [ "$OPERATION" != "import" ]; then
+    echo "Unknown operation [$OPERATION]"
+    exit 1
+fi
+if [ -z "$FILE" ]; then
+    echo "No interchange file given"
+    exit 1
+fi
+
+# Set up the network-based flags
+if [ "$NETWORK" = "mainnet" ]; then
+    LH_NETWORK="mainnet"
+    LODESTAR_NETWORK="mainnet"
+elif [ "$NETWORK" = "holesky" ]; then
+    LH_NETWORK="holesky"
+    LODESTAR_NETWORK="holesky"
+else
+    echo "Unknown network [$NETWORK]"
+    exit 1
+fi
+
+# Report a client that hasn't created its slashing protection database yet
+check_db() {
+    if [ "$OPERATION" = "export" ] && [ ! -e "$1" ]; then
+        echo "No slashing protection database found for $CC_CLIENT."
+        exit 0
+    fi
+}
+
+set -e
+
+
+# Lighthouse
+if [ "$CC_CLIENT" = "lighthouse" ]; then
+    LH_DATADIR="/validators/lighthouse"
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        LH_DATADIR="/validators/web3signer"
+    fi
+    check_db "$LH_DATADIR/validators/slashing_protection.sqlite"
+
+    mkdir -p "$LH_DATADIR/validators"
+    exec /usr/local/bin/lighthouse account validator slashing-protection $OPERATION "$FILE" \
+        --network $LH_NETWORK \
+        --datadir $LH_DATADIR
+fi
+
+
+# Lodestar
+if [ "$CC_CLIENT" = "lodestar" ]; then
+    check_db "/validators/lodestar/validator-db"
+
+    exec /usr/app/node_modules/.bin/lodestar validator slashing-protection $OPERATION \
+        --network $LODESTAR_NETWORK \
+        --dataDir /validators/lodestar \
+        --beaconNodes $CC_API_ENDPOINT \
+        --file "$FILE"
+fi
+
+
+# Nimbus
+if [ "$CC_CLIENT" = "nimbus" ]; then
+    check_db "/validators/nimbus/validators/slashing_protection.sqlite3"
+
+    # The validator client has no slashing protection tools, so this runs in the beacon node image
+    mkdir -p /validators/nimbus/validators
+    exec /home/user/nimbus-eth2/build/nimbus_beacon_node slashingdb $OPERATION "$FILE" \
+        --data-dir=/validators/nimbus \
+        --validators-dir=/validators/nimbus/validators
+fi
+
+
+# Prysm
+if [ "$CC_CLIENT" = "prysm" ]; then
+    PRYSM_DATADIR="/validators/prysm-non-hd/direct"
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        PRYSM_DATADIR="/validators/prysm-non-hd"
+    fi
+    check_db "$PRYSM_DATADIR/validator.db"
+
+    if [ "$OPERATION" = "export" ]; then
+        # Prysm names the exported file itself
+        EXPORT_DIR=$(mktemp -d)
+        /app/cmd/validator/validator slashing-protection-history export \
+            --accept-terms-of-use \
+            --datadir=$PRYSM_DATADIR \
+            --slashing-protection-export-dir=$EXPORT_DIR
+        mv "$EXPORT_DIR/slashing_protection.json" "$FILE"
+        rm -rf "$EXPORT_DIR"
+        exit 0
+    fi
+
+    mkdir -p $PRYSM_DATADIR
+    exec /app/cmd/validator/validator slashing-protection-history import \
+        --accept-terms-of-use \
+        --datadir=$PRYSM_DATADIR \
+        --slashing-protection-json-file="$FILE"
+fi
+
+
+# Teku
+if [ "$CC_CLIENT" = "teku" ]; then
+    check_db "/validators/teku/slashprotection"
+
+    if [ "$OPERATION" = "export" ]; then
+        exec /opt/teku/bin/teku slashing-protection export \
+            --data-path=/validators/teku \
+            --to="$FILE"
+    fi
+
+    exec /opt/teku/bin/teku slashing-protection import \
+        --data-path=/validators/teku \
+        --from="$FILE"
+fi
+
+echo "Unknown validator client [$CC_CLIENT]"
+exit 1
@@ -52,10 +52,43 @@ if [ "$CC_CLIENT" = "lighthouse" ]; then
         CC_URL_STRING="$CC_API_ENDPOINT,$FALLBACK_CC_API_ENDPOINT"
     fi
 
+    # Lighthouse can't fetch keys from a remote signer, so it uses the definitions the Stader node writes for them
+    LH_DATADIR="/validators/lighthouse"
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        LH_DATADIR="/validators/web3signer"
+    fi
+
+    # Each data folder has its own slashing protection database, so when the remote signer setting changes, bring over
+    # the history of the folder that was used last before starting
+    LH_LAST_DATADIR_FILE="/validators/lighthouse-datadir"
+    LH_LAST_DATADIR="/validators/lighthouse"
+    if [ -f "$LH_LAST_DATADIR_FILE" ]; then
+        LH_LAST_DATADIR=$(cat "$LH_LAST_DATADIR_FILE")
+    fi
+    if [ "$LH_LAST_DATADIR" != "$LH_DATADIR" ] && [ -f "$LH_LAST_DATADIR/validators/slashing_protection.sqlite" ]; then
+        echo "Moving the slashing protection history from $LH_LAST_DATADIR to $LH_DATADIR..."
+        mkdir -p "$LH_DATADIR/validators"
+        LH_INTERCHANGE_FILE="$LH_DATADIR/slashing-protection-$(date +%s).json"
+        /usr/local/bin/lighthouse account validator slashing-protection export "$LH_INTERCHANGE_FILE" \
+            --network $LH_NETWORK \
+            --datadir $LH_LAST_DATADIR || exit 1
+        /usr/local/bin/lighthouse account validator slashing-protection import "$LH_INTERCHANGE_FILE" \
+            --network $LH_NETWORK \
+            --datadir $LH_DATADIR || exit 1
+    fi
+    echo "$LH_DATADIR" > "$LH_LAST_DATADIR_FILE"
+
+    # Only start a new slashing protection database when there is no history at all; otherwise Lighthouse must refuse
+    # to start without it
+    LH_INIT_SLASHING_PROTECTION=""
+    if [ ! -f "$LH_DATADIR/validators/slashing_protection.sqlite" ] && [ ! -f "$LH_LAST_DATADIR/validators/slashing_protection.sqlite" ]; then
+        LH_INIT_SLASHING_PROTECTION="--init-slashing-protection"
+    fi
+
     CMD="/usr/local/bin/lighthouse validator \
         --network $LH_NETWORK \
-        --datadir /validators/lighthouse \
-        --init-slashing-protection \
+        --datadir $LH_DATADIR \
+        $LH_INIT_SLASHING_PROTECTION \
         --logfile-max-number 0 \
         --beacon-nodes $CC_URL_STRING \
         --suggested-fee-recipient $(cat /validators/$FEE_RECIPIENT_FILE) \
@@ -111,6 +144,10 @@ if [ "$CC_CLIENT" = "lodestar" ]; then
         CMD="$CMD --builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --externalSigner.url $REMOTE_SIGNER_URL --externalSigner.fetch"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics.address 0.0.0.0 --metrics.port $VC_METRICS_PORT"
     fi
@@ -146,6 +183,10 @@ if [ "$CC_CLIENT" = "nimbus" ]; then
         CMD="$CMD --payload-builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --web3-signer-url=$REMOTE_SIGNER_URL"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics-address=0.0.0.0 --metrics-port=$VC_METRICS_PORT"
     fi
@@ -187,6 +228,10 @@ if [ "$CC_CLIENT" = "prysm" ]; then
         CMD="$CMD --enable-builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --validators-external-signer-url $REMOTE_SIGNER_URL --validators-external-signer-public-keys $REMOTE_SIGNER_URL/api/v1/eth2/publicKeys"
+    fi
+
     if [ "$DOPPELGANGER_DETECTION" = "true" ]; then
         CMD="$CMD --enable-doppelganger"
     fi
@@ -246,6 +291,10 @@ if [ "$CC_CLIENT" = "teku" ]; then
         CMD="$CMD --doppelganger-detection-enabled"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --validators-external-signer-url=$REMOTE_SIGNER_URL --validators-external-signer-public-keys=external-signer"
+    fi
+
     exec ${CMD} --validators-graffiti="$GRAFFITI"
 
 fi
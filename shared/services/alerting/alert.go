@@ -0,0 +1,78 @@
+package alerting
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services/config"
+)
+
+// Timeout for notifier HTTP requests
+const notifierTimeout = 10 * time.Second
+
+type Severity string
+
+const (
+	Severity_Warning  Severity = "warning"
+	Severity_Critical Severity = "critical"
+)
+
+// A notification about an alert firing or being resolved
+type Alert struct {
+	// Identifies the condition, so the same condition is only reported once while it lasts
+	Key        string    `json:"key"`
+	Severity   Severity  `json:"severity"`
+	Title      string    `json:"title"`
+	Message    string    `json:"message"`
+	Resolved   bool      `json:"resolved"`
+	FiredAt    time.Time `json:"firedAt"`
+	ResolvedAt time.Time `json:"resolvedAt,omitempty"`
+}
+
+// Get the one line summary of the alert
+func (a Alert) Subject() string {
+	if a.Resolved {
+		return fmt.Sprintf("[RESOLVED] %s", a.Title)
+	}
+	return fmt.Sprintf("[%s] %s", strings.ToUpper(string(a.Severity)), a.Title)
+}
+
+// Get the alert as plain text
+func (a Alert) Text() string {
+	if a.Resolved {
+		return fmt.Sprintf("%s\n\n%s\n\nFired at %s, resolved at %s.", a.Subject(), a.Message, a.FiredAt.Format(time.RFC1123), a.ResolvedAt.Format(time.RFC1123))
+	}
+	return fmt.Sprintf("%s\n\n%s\n\nFired at %s.", a.Subject(), a.Message, a.FiredAt.Format(time.RFC1123))
+}
+
+// Delivers alerts to one destination
+type Notifier interface {
+	GetName() string
+	Notify(alert Alert) error
+}
+
+// Create the notifiers that are configured
+func NewNotifiers(cfg *config.AlertingConfig) []Notifier {
+	client := &http.Client{Timeout: notifierTimeout}
+	notifiers := []Notifier{}
+
+	if url := cfg.WebhookUrl.Value.(string); url != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(client, url))
+	}
+	if host := cfg.SmtpHost.Value.(string); host != "" {
+		recipients := []string{}
+		for _, recipient := range strings.Split(cfg.SmtpTo.Value.(string), ",") {
+			if recipient = strings.TrimSpace(recipient); recipient != "" {
+				recipients = append(recipients, recipient)
+			}
+		}
+		notifiers = append(notifiers, NewSmtpNotifier(host, cfg.SmtpPort.Value.(uint16), cfg.SmtpUsername.Value.(string), cfg.SmtpPassword.Value.(string), cfg.SmtpFrom.Value.(string), recipients))
+	}
+	if token := cfg.TelegramBotToken.Value.(string); token != "" {
+		notifiers = append(notifiers, NewTelegramNotifier(client, cfg.TelegramApiUrl.Value.(string), token, cfg.TelegramChatId.Value.(string)))
+	}
+
+	return notifiers
+}
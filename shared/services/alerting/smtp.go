@@ -0,0 +1,114 @@
+package alerting
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sends alerts by email
+type SmtpNotifier struct {
+	host       string
+	address    string
+	auth       smtp.Auth
+	from       string
+	recipients []string
+}
+
+func NewSmtpNotifier(host string, port uint16, username string, password string, from string, recipients []string) *SmtpNotifier {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SmtpNotifier{
+		host:       host,
+		address:    net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10)),
+		auth:       auth,
+		from:       from,
+		recipients: recipients,
+	}
+}
+
+func (n *SmtpNotifier) GetName() string {
+	return "smtp"
+}
+
+func (n *SmtpNotifier) Notify(alert Alert) error {
+	if len(n.recipients) == 0 {
+		return fmt.Errorf("no email recipients are configured")
+	}
+
+	message := strings.Join([]string{
+		fmt.Sprintf("From: %s", n.from),
+		fmt.Sprintf("To: %s", strings.Join(n.recipients, ", ")),
+		fmt.Sprintf("Subject: Stader node: %s", alert.Subject()),
+		fmt.Sprintf("Date: %s", time.Now().Format(time.RFC1123Z)),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		alert.Text(),
+	}, "\r\n")
+
+	if err := n.send([]byte(message)); err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+
+	return nil
+}
+
+// Send a message the way smtp.SendMail does, but with a deadline on the whole exchange so a server that
+// stops answering can't stall the guardian
+func (n *SmtpNotifier) send(message []byte) error {
+	conn, err := net.DialTimeout("tcp", n.address, notifierTimeout)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(notifierTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return err
+		}
+	}
+	if n.auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("the server doesn't support authentication")
+		}
+		if err := client.Auth(n.auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(n.from); err != nil {
+		return err
+	}
+	for _, recipient := range n.recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,54 @@
+package alerting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Sends alerts as messages through a Telegram compatible Bot API
+type TelegramNotifier struct {
+	client *http.Client
+	apiUrl string
+	token  string
+	chatId string
+}
+
+func NewTelegramNotifier(client *http.Client, apiUrl string, token string, chatId string) *TelegramNotifier {
+	return &TelegramNotifier{
+		client: client,
+		apiUrl: strings.TrimSuffix(apiUrl, "/"),
+		token:  token,
+		chatId: chatId,
+	}
+}
+
+func (n *TelegramNotifier) GetName() string {
+	return "telegram"
+}
+
+func (n *TelegramNotifier) Notify(alert Alert) error {
+	body, err := json.Marshal(map[string]string{
+		"chat_id": n.chatId,
+		"text":    alert.Text(),
+	})
+	if err != nil {
+		return fmt.Errorf("error encoding alert: %w", err)
+	}
+
+	// Don't include the URL in errors, it contains the bot token
+	response, err := n.client.Post(fmt.Sprintf("%s/bot%s/sendMessage", n.apiUrl, n.token), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error sending Telegram message")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("error sending Telegram message: HTTP status %d; response body: '%s'", response.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package alerting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Posts alerts as JSON to a URL
+type WebhookNotifier struct {
+	client *http.Client
+	url    string
+}
+
+func NewWebhookNotifier(client *http.Client, url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		client: client,
+		url:    url,
+	}
+}
+
+func (n *WebhookNotifier) GetName() string {
+	return "webhook"
+}
+
+func (n *WebhookNotifier) Notify(alert Alert) error {
+	body, err := json.Marshal(struct {
+		Alert
+		Text string `json:"text"`
+	}{
+		Alert: alert,
+		Text:  alert.Text(),
+	})
+	if err != nil {
+		return fmt.Errorf("error encoding alert: %w", err)
+	}
+
+	response, err := n.client.Post(n.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error posting alert: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		responseBody, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("error posting alert: HTTP status %d; response body: '%s'", response.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
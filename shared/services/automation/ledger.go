@@ -0,0 +1,124 @@
+package automation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Actions older than this are dropped when the ledger is saved
+const actionRetention = 90 * 24 * time.Hour
+
+// A transaction the node daemon sent on its own
+type Action struct {
+	Time   int64       `json:"time"`
+	Task   string      `json:"task"`
+	Kind   string      `json:"kind"`
+	Asset  string      `json:"asset"`
+	Amount *big.Int    `json:"amount"`
+	TxHash common.Hash `json:"txHash"`
+	Reason string      `json:"reason"`
+}
+
+type ledgerFile struct {
+	Actions []Action `json:"actions"`
+}
+
+// On-disk record of the daemon's automatic actions, used to enforce spending limits across restarts
+type Ledger struct {
+	path string
+	data ledgerFile
+	lock sync.Mutex
+}
+
+// Load the ledger at the given path; a missing file yields an empty ledger
+func LoadLedger(path string) (*Ledger, error) {
+	ledger := &Ledger{
+		path: path,
+		data: ledgerFile{
+			Actions: []Action{},
+		},
+	}
+
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ledger, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read automation ledger %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, &ledger.data); err != nil {
+		return nil, fmt.Errorf("could not decode automation ledger %s: %w", path, err)
+	}
+	if ledger.data.Actions == nil {
+		ledger.data.Actions = []Action{}
+	}
+
+	return ledger, nil
+}
+
+// Record an action and write the ledger to disk
+func (l *Ledger) Record(action Action) error {
+	// Hold the lock until the file is replaced, so an older snapshot can never overwrite a newer one
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if action.Time == 0 {
+		action.Time = time.Now().Unix()
+	}
+	cutoff := time.Now().Add(-actionRetention).Unix()
+	kept := []Action{}
+	for _, existing := range l.data.Actions {
+		if existing.Time >= cutoff {
+			kept = append(kept, existing)
+		}
+	}
+	l.data.Actions = append(kept, action)
+	bytes, err := json.MarshalIndent(l.data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode automation ledger: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("could not create automation ledger folder: %w", err)
+	}
+	tmpPath := l.path + ".tmp"
+	if err := os.WriteFile(tmpPath, bytes, 0600); err != nil {
+		return fmt.Errorf("could not write automation ledger %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, l.path); err != nil {
+		return fmt.Errorf("could not replace automation ledger %s: %w", l.path, err)
+	}
+
+	return nil
+}
+
+// The total amount of an asset a task spent since the given time
+func (l *Ledger) SpentSince(task string, asset string, since time.Time) *big.Int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	total := big.NewInt(0)
+	for _, action := range l.data.Actions {
+		if action.Task == task && action.Asset == asset && action.Time >= since.Unix() && action.Amount != nil {
+			total.Add(total, action.Amount)
+		}
+	}
+	return total
+}
+
+// Get a copy of the actions, oldest first
+func (l *Ledger) Actions() []Action {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	actions := make([]Action, len(l.data.Actions))
+	copy(actions, l.data.Actions)
+	return actions
+}
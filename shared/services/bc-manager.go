@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/fatih/color"
 	"github.com/stader-labs/stader-node/shared/services/beacon"
 	"github.com/stader-labs/stader-node/shared/services/beacon/client"
@@ -189,6 +190,28 @@ func (m *BeaconClientManager) GetBeaconBlock(blockId string) (beacon.BeaconBlock
 	return result1.(beacon.BeaconBlock), result2.(bool), nil
 }
 
+// Get the root of a beacon block
+func (m *BeaconClientManager) GetBeaconBlockRoot(blockId string) (common.Hash, bool, error) {
+	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
+		return client.GetBeaconBlockRoot(blockId)
+	})
+	if err != nil {
+		return common.Hash{}, false, err
+	}
+	return result1.(common.Hash), result2.(bool), nil
+}
+
+// Get the justified and finalized checkpoints of a state
+func (m *BeaconClientManager) GetFinalityCheckpoints(stateId string) (beacon.FinalityCheckpoints, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetFinalityCheckpoints(stateId)
+	})
+	if err != nil {
+		return beacon.FinalityCheckpoints{}, err
+	}
+	return result.(beacon.FinalityCheckpoints), nil
+}
+
 // Get the Beacon chain's head information
 func (m *BeaconClientManager) GetBeaconHead() (beacon.BeaconHead, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
@@ -266,6 +289,17 @@ func (m *BeaconClientManager) GetValidatorProposerDuties(indices []uint64, epoch
 	return result.(map[uint64]uint64), nil
 }
 
+// Get the proposer of every slot in an epoch
+func (m *BeaconClientManager) GetProposerSlots(epoch uint64) (map[uint64]uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetProposerSlots(epoch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[uint64]uint64), nil
+}
+
 // Get the Beacon chain's domain data
 func (m *BeaconClientManager) GetExitDomainData(domainType []byte, network cfgtypes.Network) ([]byte, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
@@ -93,8 +93,24 @@ type Committee struct {
 
 type AttestationInfo struct {
 	AggregationBits bitfield.Bitlist
+	CommitteeBits   bitfield.Bitvector64
 	SlotIndex       uint64
 	CommitteeIndex  uint64
+	BeaconBlockRoot common.Hash
+	SourceEpoch     uint64
+	SourceRoot      common.Hash
+	TargetEpoch     uint64
+	TargetRoot      common.Hash
+}
+
+type Checkpoint struct {
+	Epoch uint64
+	Root  common.Hash
+}
+type FinalityCheckpoints struct {
+	PreviousJustified Checkpoint
+	CurrentJustified  Checkpoint
+	Finalized         Checkpoint
 }
 
 type NodeVersion struct {
@@ -142,16 +158,33 @@ type Client interface {
 	GetEth2DepositContract() (Eth2DepositContract, error)
 	GetAttestations(blockId string) ([]AttestationInfo, bool, error)
 	GetBeaconBlock(blockId string) (BeaconBlock, bool, error)
+	GetBeaconBlockRoot(blockId string) (common.Hash, bool, error)
 	GetBeaconHead() (BeaconHead, error)
+	GetFinalityCheckpoints(stateId string) (FinalityCheckpoints, error)
 	GetValidatorStatusByIndex(index string, opts *ValidatorStatusOptions) (ValidatorStatus, error)
 	GetValidatorStatus(pubkey types.ValidatorPubkey, opts *ValidatorStatusOptions) (ValidatorStatus, error)
 	GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *ValidatorStatusOptions) (map[types.ValidatorPubkey]ValidatorStatus, error)
 	GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error)
 	GetValidatorSyncDuties(indices []uint64, epoch uint64) (map[uint64]bool, error)
 	GetValidatorProposerDuties(indices []uint64, epoch uint64) (map[uint64]uint64, error)
+	GetProposerSlots(epoch uint64) (map[uint64]uint64, error)
 	GetExitDomainData(domainType []byte, network config.Network) ([]byte, error)
 	ExitValidator(validatorIndex, epoch uint64, signature types.ValidatorSignature) error
 	Close() error
 	GetEth1DataForEth2Block(blockId string) (Eth1Data, bool, error)
 	GetCommitteesForEpoch(epoch *uint64) ([]Committee, error)
 }
+
+// Get the indices of the committees an attestation aggregates, in the order their bits appear in the aggregation bits.
+// Since Electra, an attestation can cover several committees of its slot and lists them in its committee bits;
+// before, it covers only the committee in its data.
+func (a AttestationInfo) CommitteeIndices() []uint64 {
+	if a.CommitteeBits == nil {
+		return []uint64{a.CommitteeIndex}
+	}
+	indices := []uint64{}
+	for _, index := range a.CommitteeBits.BitIndices() {
+		indices = append(indices, uint64(index))
+	}
+	return indices
+}
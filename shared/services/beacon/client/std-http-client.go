@@ -60,6 +60,7 @@ const (
 	RequestVoluntaryExitPath         = "/eth/v1/beacon/pool/voluntary_exits"
 	RequestAttestationsPath          = "/eth/v1/beacon/blocks/%s/attestations"
 	RequestBeaconBlockPath           = "/eth/v2/beacon/blocks/%s"
+	RequestBeaconBlockRootPath       = "/eth/v1/beacon/blocks/%s/root"
 	RequestValidatorSyncDuties       = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties   = "/eth/v1/validator/duties/proposer/%s"
 
@@ -214,6 +215,19 @@ func (c *StandardHttpClient) GetBeaconHead() (beacon.BeaconHead, error) {
 
 }
 
+// Get the justified and finalized checkpoints of a state
+func (c *StandardHttpClient) GetFinalityCheckpoints(stateId string) (beacon.FinalityCheckpoints, error) {
+	finalityCheckpoints, err := c.getFinalityCheckpoints(stateId)
+	if err != nil {
+		return beacon.FinalityCheckpoints{}, err
+	}
+	return beacon.FinalityCheckpoints{
+		PreviousJustified: getCheckpoint(finalityCheckpoints.Data.PreviousJustified),
+		CurrentJustified:  getCheckpoint(finalityCheckpoints.Data.CurrentJustified),
+		Finalized:         getCheckpoint(finalityCheckpoints.Data.Finalized),
+	}, nil
+}
+
 // Get a validator's status
 func (c *StandardHttpClient) GetValidatorStatus(pubkey types.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
 
@@ -408,6 +422,30 @@ func (c *StandardHttpClient) GetValidatorProposerDuties(indices []uint64, epoch
 	return proposerMap, nil
 }
 
+// Get the proposer of every slot in the given epoch, keyed by slot
+func (c *StandardHttpClient) GetProposerSlots(epoch uint64) (map[uint64]uint64, error) {
+
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestValidatorProposerDuties, strconv.FormatUint(epoch, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("Could not get validator proposer duties: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get validator proposer duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+
+	var response ProposerDutiesResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode validator proposer duties data: %w", err)
+	}
+
+	proposers := make(map[uint64]uint64, len(response.Data))
+	for _, duty := range response.Data {
+		proposers[uint64(duty.Slot)] = uint64(duty.ValidatorIndex)
+	}
+
+	return proposers, nil
+}
+
 // Get a validator's index
 func (c *StandardHttpClient) GetValidatorIndex(pubkey types.ValidatorPubkey) (uint64, error) {
 
@@ -437,15 +475,7 @@ func (c *StandardHttpClient) GetExitDomainData(domainType []byte, network config
 	}
 
 	// Get fork version
-	var capellaForkVersion string
-	// TODO - we currently only support mainnet and testnet envs. We will have to update this as we change n/ws
-	if network == config.Network_Mainnet {
-		capellaForkVersion = eth2.MainnetCapellaForkVersion
-	} else {
-		capellaForkVersion = eth2.HoleskyCapellaForkVersion
-	}
-
-	decodedForkVersion, err := hexutil.Decode(capellaForkVersion)
+	decodedForkVersion, err := eth2.GetCapellaForkVersion(network)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -501,12 +531,9 @@ func (c *StandardHttpClient) GetAttestations(blockId string) ([]beacon.Attestati
 	// Add attestation info
 	attestationInfo := make([]beacon.AttestationInfo, len(attestations.Data))
 	for i, attestation := range attestations.Data {
-		bitString := hexutil.RemovePrefix(attestation.AggregationBits)
-		attestationInfo[i].SlotIndex = uint64(attestation.Data.Slot)
-		attestationInfo[i].CommitteeIndex = uint64(attestation.Data.Index)
-		attestationInfo[i].AggregationBits, err = hex.DecodeString(bitString)
+		attestationInfo[i], err = getAttestationInfo(attestation)
 		if err != nil {
-			return nil, false, fmt.Errorf("Error decoding aggregation bits for attestation %d of block %s: %w", i, blockId, err)
+			return nil, false, fmt.Errorf("Error decoding attestation %d of block %s: %w", i, blockId, err)
 		}
 	}
 
@@ -538,14 +565,9 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 
 	// Add attestation info
 	for i, attestation := range block.Data.Message.Body.Attestations {
-		bitString := hexutil.RemovePrefix(attestation.AggregationBits)
-		info := beacon.AttestationInfo{
-			SlotIndex:      uint64(attestation.Data.Slot),
-			CommitteeIndex: uint64(attestation.Data.Index),
-		}
-		info.AggregationBits, err = hex.DecodeString(bitString)
+		info, err := getAttestationInfo(attestation)
 		if err != nil {
-			return beacon.BeaconBlock{}, false, fmt.Errorf("Error decoding aggregation bits for attestation %d of block %s: %w", i, blockId, err)
+			return beacon.BeaconBlock{}, false, fmt.Errorf("Error decoding attestation %d of block %s: %w", i, blockId, err)
 		}
 		beaconBlock.Attestations = append(beaconBlock.Attestations, info)
 	}
@@ -553,6 +575,25 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 	return beaconBlock, true, nil
 }
 
+// Get the root of the given beacon block; returns false if there is no block for the id (e.g. a missed slot)
+func (c *StandardHttpClient) GetBeaconBlockRoot(blockId string) (common.Hash, bool, error) {
+	responseBody, status, err := c.getRequest(fmt.Sprintf(RequestBeaconBlockRootPath, blockId))
+	if err != nil {
+		return common.Hash{}, false, fmt.Errorf("Could not get beacon block root: %w", err)
+	}
+	if status == http.StatusNotFound {
+		return common.Hash{}, false, nil
+	}
+	if status != http.StatusOK {
+		return common.Hash{}, false, fmt.Errorf("Could not get beacon block root: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var root BeaconBlockRootResponse
+	if err := json.Unmarshal(responseBody, &root); err != nil {
+		return common.Hash{}, false, fmt.Errorf("Could not decode beacon block root: %w", err)
+	}
+	return common.BytesToHash(root.Data.Root), true, nil
+}
+
 // Get the attestation committees for the given epoch, or the current epoch if nil
 func (c *StandardHttpClient) GetCommitteesForEpoch(epoch *uint64) ([]beacon.Committee, error) {
 	response, err := c.getCommittees("head", epoch)
@@ -576,6 +617,43 @@ func (c *StandardHttpClient) GetCommitteesForEpoch(epoch *uint64) ([]beacon.Comm
 	return committees, nil
 }
 
+// Convert an attestation
+func getAttestationInfo(attestation Attestation) (beacon.AttestationInfo, error) {
+	info := beacon.AttestationInfo{
+		SlotIndex:       uint64(attestation.Data.Slot),
+		CommitteeIndex:  uint64(attestation.Data.Index),
+		BeaconBlockRoot: common.BytesToHash(attestation.Data.BeaconBlockRoot),
+		SourceEpoch:     uint64(attestation.Data.Source.Epoch),
+		SourceRoot:      common.BytesToHash(attestation.Data.Source.Root),
+		TargetEpoch:     uint64(attestation.Data.Target.Epoch),
+		TargetRoot:      common.BytesToHash(attestation.Data.Target.Root),
+	}
+
+	var err error
+	info.AggregationBits, err = hex.DecodeString(hexutil.RemovePrefix(attestation.AggregationBits))
+	if err != nil {
+		return beacon.AttestationInfo{}, fmt.Errorf("Error decoding aggregation bits: %w", err)
+	}
+
+	// Only Electra attestations have committee bits
+	if attestation.CommitteeBits != "" {
+		info.CommitteeBits, err = hex.DecodeString(hexutil.RemovePrefix(attestation.CommitteeBits))
+		if err != nil {
+			return beacon.AttestationInfo{}, fmt.Errorf("Error decoding committee bits: %w", err)
+		}
+	}
+
+	return info, nil
+}
+
+// Convert a checkpoint
+func getCheckpoint(checkpoint Checkpoint) beacon.Checkpoint {
+	return beacon.Checkpoint{
+		Epoch: uint64(checkpoint.Epoch),
+		Root:  common.BytesToHash(checkpoint.Root),
+	}
+}
+
 // Get sync status
 func (c *StandardHttpClient) getSyncStatus() (SyncStatusResponse, error) {
 	responseBody, status, err := c.getRequest(RequestSyncStatusPath)
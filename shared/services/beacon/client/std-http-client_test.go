@@ -0,0 +1,79 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGetAttestationInfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		attestation string
+		committees  []uint64
+		setBits     []uint64
+		bitCount    uint64
+	}{
+		{
+			name: "pre-Electra",
+			attestation: `{
+				"aggregation_bits": "0x0a",
+				"data": {
+					"slot": "4", "index": "1",
+					"beacon_block_root": "0x0000000000000000000000000000000000000000000000000000000000000004",
+					"source": {"epoch": "0", "root": "0x0000000000000000000000000000000000000000000000000000000000000001"},
+					"target": {"epoch": "1", "root": "0x0000000000000000000000000000000000000000000000000000000000000004"}
+				}
+			}`,
+			committees: []uint64{1},
+			setBits:    []uint64{1},
+			bitCount:   3,
+		},
+		{
+			name: "Electra",
+			attestation: `{
+				"aggregation_bits": "0x52",
+				"committee_bits": "0x0300000000000000",
+				"data": {
+					"slot": "4", "index": "0",
+					"beacon_block_root": "0x0000000000000000000000000000000000000000000000000000000000000004",
+					"source": {"epoch": "0", "root": "0x0000000000000000000000000000000000000000000000000000000000000001"},
+					"target": {"epoch": "1", "root": "0x0000000000000000000000000000000000000000000000000000000000000004"}
+				}
+			}`,
+			committees: []uint64{0, 1},
+			setBits:    []uint64{1, 4},
+			bitCount:   6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attestation Attestation
+			if err := json.Unmarshal([]byte(test.attestation), &attestation); err != nil {
+				t.Fatal(err)
+			}
+			info, err := getAttestationInfo(attestation)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if committees := info.CommitteeIndices(); !reflect.DeepEqual(committees, test.committees) {
+				t.Errorf("expected committees %v, got %v", test.committees, committees)
+			}
+			if info.AggregationBits.Len() != test.bitCount {
+				t.Errorf("expected %d aggregation bits, got %d", test.bitCount, info.AggregationBits.Len())
+			}
+			setBits := []uint64{}
+			for _, bit := range info.AggregationBits.BitIndices() {
+				setBits = append(setBits, uint64(bit))
+			}
+			if !reflect.DeepEqual(setBits, test.setBits) {
+				t.Errorf("expected set bits %v, got %v", test.setBits, setBits)
+			}
+			if info.SlotIndex != 4 || info.SourceEpoch != 0 || info.TargetEpoch != 1 {
+				t.Errorf("unexpected vote data %+v", info)
+			}
+		})
+	}
+}
@@ -75,15 +75,9 @@ type GenesisResponse struct {
 }
 type FinalityCheckpointsResponse struct {
 	Data struct {
-		PreviousJustified struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"previous_justified"`
-		CurrentJustified struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"current_justified"`
-		Finalized struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"finalized"`
+		PreviousJustified Checkpoint `json:"previous_justified"`
+		CurrentJustified  Checkpoint `json:"current_justified"`
+		Finalized         Checkpoint `json:"finalized"`
 	} `json:"data"`
 }
 type ForkResponse struct {
@@ -147,6 +141,12 @@ type ProposerDutiesResponse struct {
 }
 type ProposerDuty struct {
 	ValidatorIndex uinteger `json:"validator_index"`
+	Slot           uinteger `json:"slot"`
+}
+type BeaconBlockRootResponse struct {
+	Data struct {
+		Root byteArray `json:"root"`
+	} `json:"data"`
 }
 
 type CommitteesResponse struct {
@@ -161,12 +161,21 @@ type Committee struct {
 
 type Attestation struct {
 	AggregationBits string `json:"aggregation_bits"`
+	CommitteeBits   string `json:"committee_bits,omitempty"`
 	Data            struct {
-		Slot  uinteger `json:"slot"`
-		Index uinteger `json:"index"`
+		Slot            uinteger   `json:"slot"`
+		Index           uinteger   `json:"index"`
+		BeaconBlockRoot byteArray  `json:"beacon_block_root"`
+		Source          Checkpoint `json:"source"`
+		Target          Checkpoint `json:"target"`
 	} `json:"data"`
 }
 
+type Checkpoint struct {
+	Epoch uinteger  `json:"epoch"`
+	Root  byteArray `json:"root"`
+}
+
 // Unsigned integer type
 type uinteger uint64
 
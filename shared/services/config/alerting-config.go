@@ -0,0 +1,247 @@
+package config
+
+import (
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+// Defaults
+const (
+	defaultAlertHealthFactorThreshold float64 = 1.2
+	defaultAlertUnclaimedCycleAge     uint64  = 3
+	defaultAlertRepeatInterval        uint64  = 24
+	defaultAlertSmtpPort              uint16  = 587
+	defaultAlertTelegramApiUrl        string  = "https://api.telegram.org"
+)
+
+// Configuration for the guardian's alert notifications
+type AlertingConfig struct {
+	Title string `yaml:"-"`
+
+	EnableAlerting config.Parameter `yaml:"enableAlerting,omitempty"`
+
+	HealthFactorThreshold config.Parameter `yaml:"healthFactorThreshold,omitempty"`
+
+	UnclaimedCycleAge config.Parameter `yaml:"unclaimedCycleAge,omitempty"`
+
+	RepeatInterval config.Parameter `yaml:"repeatInterval,omitempty"`
+
+	WebhookUrl config.Parameter `yaml:"webhookUrl,omitempty"`
+
+	SmtpHost config.Parameter `yaml:"smtpHost,omitempty"`
+
+	SmtpPort config.Parameter `yaml:"smtpPort,omitempty"`
+
+	SmtpUsername config.Parameter `yaml:"smtpUsername,omitempty"`
+
+	SmtpPassword config.Parameter `yaml:"smtpPassword,omitempty"`
+
+	SmtpFrom config.Parameter `yaml:"smtpFrom,omitempty"`
+
+	SmtpTo config.Parameter `yaml:"smtpTo,omitempty"`
+
+	TelegramApiUrl config.Parameter `yaml:"telegramApiUrl,omitempty"`
+
+	TelegramBotToken config.Parameter `yaml:"telegramBotToken,omitempty"`
+
+	TelegramChatId config.Parameter `yaml:"telegramChatId,omitempty"`
+}
+
+// Generates a new alerting config
+func NewAlertingConfig(cfg *StaderConfig) *AlertingConfig {
+	return &AlertingConfig{
+		Title: "Alerting Settings",
+
+		EnableAlerting: config.Parameter{
+			ID:                   "enableAlerting",
+			Name:                 "Enable Alerting",
+			Description:          "Enable this to have the guardian send notifications when something needs your attention, such as a low health factor, a slashed validator or low SD collateral.\n\nAlerts are sent to every notifier configured below.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		HealthFactorThreshold: config.Parameter{
+			ID:                   "alertHealthFactorThreshold",
+			Name:                 "Health Factor Threshold",
+			Description:          "Send an alert when the health factor of your SD Utility Pool position drops below this value. Your position can be liquidated once it drops below 1.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultAlertHealthFactorThreshold},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		UnclaimedCycleAge: config.Parameter{
+			ID:                   "alertUnclaimedCycleAge",
+			Name:                 "Unclaimed Cycle Age",
+			Description:          "Send an alert when the rewards of a Socializing Pool cycle are still unclaimed this many cycles after it ended. Set to 0 to disable.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultAlertUnclaimedCycleAge},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		RepeatInterval: config.Parameter{
+			ID:                   "alertRepeatInterval",
+			Name:                 "Repeat Interval",
+			Description:          "How many hours to wait before sending a reminder for an alert that is still firing. Set to 0 to only notify once.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultAlertRepeatInterval},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WebhookUrl: config.Parameter{
+			ID:                   "alertWebhookUrl",
+			Name:                 "Webhook URL",
+			Description:          "The URL to POST alerts to as JSON. Leave blank to disable the webhook notifier.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpHost: config.Parameter{
+			ID:                   "alertSmtpHost",
+			Name:                 "SMTP Host",
+			Description:          "The SMTP server used to send alert emails. Leave blank to disable the email notifier.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpPort: config.Parameter{
+			ID:                   "alertSmtpPort",
+			Name:                 "SMTP Port",
+			Description:          "The port of the SMTP server.",
+			Type:                 config.ParameterType_Uint16,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultAlertSmtpPort},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpUsername: config.Parameter{
+			ID:                   "alertSmtpUsername",
+			Name:                 "SMTP Username",
+			Description:          "The username to log in to the SMTP server with. Leave blank if the server doesn't need authentication.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpPassword: config.Parameter{
+			ID:                   "alertSmtpPassword",
+			Name:                 "SMTP Password",
+			Description:          "The password to log in to the SMTP server with.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpFrom: config.Parameter{
+			ID:                   "alertSmtpFrom",
+			Name:                 "Email Sender",
+			Description:          "The address alert emails are sent from.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SmtpTo: config.Parameter{
+			ID:                   "alertSmtpTo",
+			Name:                 "Email Recipients",
+			Description:          "A comma separated list of addresses to send alert emails to.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TelegramApiUrl: config.Parameter{
+			ID:                   "alertTelegramApiUrl",
+			Name:                 "Telegram API URL",
+			Description:          "The base URL of the Telegram Bot API. Change this if you use a self-hosted Bot API server or a compatible service.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultAlertTelegramApiUrl},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TelegramBotToken: config.Parameter{
+			ID:                   "alertTelegramBotToken",
+			Name:                 "Telegram Bot Token",
+			Description:          "The token of the bot that sends the alerts. Leave blank to disable the Telegram notifier.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		TelegramChatId: config.Parameter{
+			ID:                   "alertTelegramChatId",
+			Name:                 "Telegram Chat ID",
+			Description:          "The ID of the chat the bot sends the alerts to.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *AlertingConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.EnableAlerting,
+		&cfg.HealthFactorThreshold,
+		&cfg.UnclaimedCycleAge,
+		&cfg.RepeatInterval,
+		&cfg.WebhookUrl,
+		&cfg.SmtpHost,
+		&cfg.SmtpPort,
+		&cfg.SmtpUsername,
+		&cfg.SmtpPassword,
+		&cfg.SmtpFrom,
+		&cfg.SmtpTo,
+		&cfg.TelegramApiUrl,
+		&cfg.TelegramBotToken,
+		&cfg.TelegramChatId,
+	}
+}
+
+// The the title for the config
+func (cfg *AlertingConfig) GetConfigTitle() string {
+	return cfg.Title
+}
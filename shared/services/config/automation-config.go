@@ -0,0 +1,205 @@
+package config
+
+import (
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+// Defaults
+const (
+	defaultSdProtectionHealthFactor  float64 = 1.5
+	defaultSdProtectionMaxSdPerDay   float64 = 1000
+	defaultSdProtectionMaxFee        float64 = 50
+	defaultSdProtectionReserveSd     float64 = 0
+	defaultAutoClaimThreshold        float64 = 0.1
+	defaultAutoClaimMaxGasShare      float64 = 5
+	defaultClRewardsSweepMinimum     float64 = 0.05
+	defaultClRewardsSweepMaxGasShare float64 = 5
+)
+
+// Configuration for the transactions the node daemon sends on its own
+type AutomationConfig struct {
+	Title string `yaml:"-"`
+
+	EnableSdProtection config.Parameter `yaml:"enableSdProtection,omitempty"`
+
+	SdProtectionHealthFactor config.Parameter `yaml:"sdProtectionHealthFactor,omitempty"`
+
+	SdProtectionMaxSdPerDay config.Parameter `yaml:"sdProtectionMaxSdPerDay,omitempty"`
+
+	SdProtectionMaxFee config.Parameter `yaml:"sdProtectionMaxFee,omitempty"`
+
+	SdProtectionReserveSd config.Parameter `yaml:"sdProtectionReserveSd,omitempty"`
+
+	EnableAutoClaim config.Parameter `yaml:"enableAutoClaim,omitempty"`
+
+	AutoClaimThreshold config.Parameter `yaml:"autoClaimThreshold,omitempty"`
+
+	AutoClaimMaxGasShare config.Parameter `yaml:"autoClaimMaxGasShare,omitempty"`
+
+	EnableClRewardsSweep config.Parameter `yaml:"enableClRewardsSweep,omitempty"`
+
+	ClRewardsSweepMinimum config.Parameter `yaml:"clRewardsSweepMinimum,omitempty"`
+
+	ClRewardsSweepMaxGasShare config.Parameter `yaml:"clRewardsSweepMaxGasShare,omitempty"`
+}
+
+// Generates a new automation config
+func NewAutomationConfig(cfg *StaderConfig) *AutomationConfig {
+	return &AutomationConfig{
+		Title: "Automation Settings",
+
+		EnableSdProtection: config.Parameter{
+			ID:                   "enableSdProtection",
+			Name:                 "Enable SD Collateral Protection",
+			Description:          "Enable this to have the node daemon protect your SD positions with the SD in your node wallet: it repays utilized SD when the health factor of your SD Utility Pool position drops below the trigger below, and deposits SD collateral when your validators don't have the minimum SD bond.\n\nThe daemon approves the SD it spends and sends these transactions on its own, within the limits below.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SdProtectionHealthFactor: config.Parameter{
+			ID:                   "sdProtectionHealthFactor",
+			Name:                 "Health Factor Trigger",
+			Description:          "Repay utilized SD when the health factor of your SD Utility Pool position drops below this value. The daemon repays enough to keep it above this value for 30 days. Your position can be liquidated once it drops below 1.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultSdProtectionHealthFactor},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SdProtectionMaxSdPerDay: config.Parameter{
+			ID:                   "sdProtectionMaxSdPerDay",
+			Name:                 "Max SD per Day",
+			Description:          "The most SD the daemon spends on repays and collateral deposits in any 24 hours.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultSdProtectionMaxSdPerDay},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SdProtectionMaxFee: config.Parameter{
+			ID:                   "sdProtectionMaxFee",
+			Name:                 "Max Fee",
+			Description:          "The highest max fee (in gwei) the daemon pays for these transactions. It waits while the network's base fee is above it.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultSdProtectionMaxFee},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		SdProtectionReserveSd: config.Parameter{
+			ID:                   "sdProtectionReserveSd",
+			Name:                 "SD Reserve",
+			Description:          "The SD the daemon always leaves in your node wallet.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultSdProtectionReserveSd},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableAutoClaim: config.Parameter{
+			ID:                   "enableAutoClaim",
+			Name:                 "Enable Auto Claim",
+			Description:          "Enable this to have the node daemon claim your rewards on its own: it moves the balance of your EL rewards vault to the operator rewards collector, claims your Socializing Pool rewards for the cycles whose merkle proofs it has downloaded, and claims the operator rewards collector to your reward address.\n\nEach claim is only sent when its value is above the threshold below and its gas cost is a small enough share of it. The Tx Fee Cap setting also applies.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoClaimThreshold: config.Parameter{
+			ID:                   "autoClaimThreshold",
+			Name:                 "Claim Threshold",
+			Description:          "The value (in ETH) a claim must be worth before the daemon sends it. SD rewards are valued at the current SD price.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultAutoClaimThreshold},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		AutoClaimMaxGasShare: config.Parameter{
+			ID:                   "autoClaimMaxGasShare",
+			Name:                 "Max Gas Share",
+			Description:          "The most a claim's estimated gas cost may be, as a percentage of the value it claims. The daemon waits for cheaper gas otherwise.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultAutoClaimMaxGasShare},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		EnableClRewardsSweep: config.Parameter{
+			ID:                   "enableClRewardsSweep",
+			Name:                 "Enable CL Rewards Sweep",
+			Description:          "Enable this to have the node daemon send the CL rewards in the withdraw vaults of your validators to the operator rewards collector, the most rewards per unit of gas first. Vaults above the rewards threshold are left for the Stader oracles to settle.\n\nThe CL Rewards Sweep Max Gas Share and Tx Fee Cap settings apply to each distribution.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ClRewardsSweepMinimum: config.Parameter{
+			ID:                   "clRewardsSweepMinimum",
+			Name:                 "CL Rewards Sweep Minimum",
+			Description:          "The operator rewards (in ETH) a withdraw vault must hold before the daemon distributes it.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultClRewardsSweepMinimum},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ClRewardsSweepMaxGasShare: config.Parameter{
+			ID:                   "clRewardsSweepMaxGasShare",
+			Name:                 "CL Rewards Sweep Max Gas Share",
+			Description:          "The most a CL rewards distribution's estimated gas cost may be, as a percentage of the operator rewards it moves. The daemon waits for cheaper gas otherwise.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: defaultClRewardsSweepMaxGasShare},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *AutomationConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.EnableSdProtection,
+		&cfg.SdProtectionHealthFactor,
+		&cfg.SdProtectionMaxSdPerDay,
+		&cfg.SdProtectionMaxFee,
+		&cfg.SdProtectionReserveSd,
+		&cfg.EnableAutoClaim,
+		&cfg.AutoClaimThreshold,
+		&cfg.AutoClaimMaxGasShare,
+		&cfg.EnableClRewardsSweep,
+		&cfg.ClRewardsSweepMinimum,
+		&cfg.ClRewardsSweepMaxGasShare,
+	}
+}
+
+// The the title for the config
+func (cfg *AutomationConfig) GetConfigTitle() string {
+	return cfg.Title
+}
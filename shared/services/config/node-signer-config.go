@@ -0,0 +1,64 @@
+package config
+
+import (
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+// Configuration for signing with the node account through an external signer
+type NodeSignerConfig struct {
+	Title string `yaml:"-"`
+
+	Url config.Parameter `yaml:"url,omitempty"`
+
+	Address config.Parameter `yaml:"address,omitempty"`
+}
+
+// Generates a new node signer config
+func NewNodeSignerConfig(cfg *StaderConfig) *NodeSignerConfig {
+	return &NodeSignerConfig{
+		Title: "Node Signer Settings",
+
+		Url: config.Parameter{
+			ID:                   "url",
+			Name:                 "External Signer URL",
+			Description:          "The URL of an external signer holding the node account key that implements Clef's JSON-RPC API, such as `http://clef:8550`. When set, the node account's transactions and messages are signed by it, so the node wallet's mnemonic isn't needed to send them.\n\nLeave this blank to sign with the node wallet.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Api},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		Address: config.Parameter{
+			ID:                   "address",
+			Name:                 "Node Account Address",
+			Description:          "The address of the node account in the external signer. It can be left blank if the external signer only holds one account.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Api},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *NodeSignerConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.Url,
+		&cfg.Address,
+	}
+}
+
+// The the title for the config
+func (cfg *NodeSignerConfig) GetConfigTitle() string {
+	return cfg.Title
+}
+
+// Get the URL of the external signer, or an empty string when the node wallet signs
+func (cfg *NodeSignerConfig) GetUrl() string {
+	url, _ := cfg.Url.Value.(string)
+	return url
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"github.com/stader-labs/stader-node/shared/types/config"
+)
+
+// Configuration for signing with validator keys held by a remote signer
+type RemoteSignerConfig struct {
+	Title string `yaml:"-"`
+
+	Url config.Parameter `yaml:"url,omitempty"`
+
+	ImportKeys config.Parameter `yaml:"importKeys,omitempty"`
+}
+
+// Generates a new remote signer config
+func NewRemoteSignerConfig(cfg *StaderConfig) *RemoteSignerConfig {
+	return &RemoteSignerConfig{
+		Title: "Remote Signer Settings",
+
+		Url: config.Parameter{
+			ID:                   "url",
+			Name:                 "Remote Signer URL",
+			Description:          "The URL of a Web3Signer-compatible remote signer holding your validator keys, such as `http://web3signer:9000`. When set, your validator client signs duties through it, and deposits, exits and presigned exit messages are signed by it instead of by the node.\n\nLeave this blank to keep the validator keys in your validator client's keystores.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Validator, config.ContainerID_Node, config.ContainerID_Api},
+			EnvironmentVariables: []string{"REMOTE_SIGNER_URL"},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
+		ImportKeys: config.Parameter{
+			ID:                   "importKeys",
+			Name:                 "Import New Keys",
+			Description:          "Import the validator keys the node creates or recovers into the remote signer through its key manager API. Disable this if the remote signer loads its keys from somewhere else; they must then be added to it before they are deposited.",
+			Type:                 config.ParameterType_Bool,
+			Default:              map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node, config.ContainerID_Api},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *RemoteSignerConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.Url,
+		&cfg.ImportKeys,
+	}
+}
+
+// The the title for the config
+func (cfg *RemoteSignerConfig) GetConfigTitle() string {
+	return cfg.Title
+}
+
+// Get the URL of the remote signer, or an empty string when validator keys are kept locally
+func (cfg *RemoteSignerConfig) GetUrl() string {
+	url, _ := cfg.Url.Value.(string)
+	return url
+}
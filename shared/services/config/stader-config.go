@@ -131,6 +131,18 @@ type StaderConfig struct {
 
 	BitflyNodeMetrics *BitflyNodeMetricsConfig `yaml:"bitflyNodeMetrics,omitempty"`
 
+	// Guardian alerts
+	Alerting *AlertingConfig `yaml:"alerting,omitempty"`
+
+	// Node daemon transactions
+	Automation *AutomationConfig `yaml:"automation,omitempty"`
+
+	// Remote signer for validator keys
+	RemoteSigner *RemoteSignerConfig `yaml:"remoteSigner,omitempty"`
+
+	// External signer for the node account
+	NodeSigner *NodeSignerConfig `yaml:"nodeSigner,omitempty"`
+
 	// Native mode
 	Native *NativeConfig `yaml:"native,omitempty"`
 
@@ -505,6 +517,10 @@ func NewStaderConfig(staderDir string, isNativeMode bool) *StaderConfig {
 	cfg.Prometheus = NewPrometheusConfig(cfg)
 	cfg.Exporter = NewExporterConfig(cfg)
 	cfg.BitflyNodeMetrics = NewBitflyNodeMetricsConfig(cfg)
+	cfg.Alerting = NewAlertingConfig(cfg)
+	cfg.Automation = NewAutomationConfig(cfg)
+	cfg.RemoteSigner = NewRemoteSignerConfig(cfg)
+	cfg.NodeSigner = NewNodeSignerConfig(cfg)
 	cfg.Native = NewNativeConfig(cfg)
 	cfg.MevBoost = NewMevBoostConfig(cfg)
 
@@ -607,6 +623,10 @@ func (cfg *StaderConfig) GetSubconfigs() map[string]config.Config {
 		"prometheus":         cfg.Prometheus,
 		"exporter":           cfg.Exporter,
 		"bitflyNodeMetrics":  cfg.BitflyNodeMetrics,
+		"alerting":           cfg.Alerting,
+		"automation":         cfg.Automation,
+		"remoteSigner":       cfg.RemoteSigner,
+		"nodeSigner":         cfg.NodeSigner,
 		"native":             cfg.Native,
 		"mevBoost":           cfg.MevBoost,
 	}
@@ -871,6 +891,7 @@ func (cfg *StaderConfig) GenerateEnvironmentVariables() map[string]string {
 	envVars["TX_FEE_CAP_IN_GWEI"] = fmt.Sprintf("%d", int64(txFeeCapInGwei))
 	config.AddParametersToEnvVars(cfg.StaderNode.GetParameters(), envVars)
 	config.AddParametersToEnvVars(cfg.GetParameters(), envVars)
+	config.AddParametersToEnvVars(cfg.RemoteSigner.GetParameters(), envVars)
 
 	// EC parameters
 	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local {
@@ -1146,6 +1167,11 @@ func (cfg *StaderConfig) Validate() []string {
 		}
 	}
 
+	// Check the guardian's watched operators
+	if _, err := cfg.StaderNode.GetWatchedOperators(); err != nil {
+		errors = append(errors, fmt.Sprintf("The guardian's watched operators are not valid: %s. Please enter a comma-separated list of operator addresses.", err.Error()))
+	}
+
 	return errors
 }
 
@@ -23,10 +23,12 @@ import (
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/go-homedir"
@@ -35,6 +37,9 @@ import (
 	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
 )
 
+// Returned when a downloaded cycle merkle proof file can't be decoded
+var ErrCorruptCycleCache = errors.New("cycle merkle proof file is corrupt")
+
 // Constants
 const (
 	stadernodeTag                      = shared.DockerAccount + "/stader-permissionless:v" + shared.StaderVersion
@@ -46,6 +51,19 @@ const (
 	GuardianFolder              string = "guardian"
 	SpRewardsMerkleProofsFolder string = "sp-rewards-merkle-proofs"
 	MerkleProofsFormat          string = "cycle-%s-%d.json"
+	QuarantinedProofsFolder     string = "quarantine"
+	QuarantinedProofsFormat     string = "cycle-%s-%d-%x.json"
+	GeneratedTreesFolder        string = "generated"
+	PresignFolder               string = "presign"
+	PresignLedgerFormat         string = "ledger-%s.json"
+	ExitMessagesFolder          string = "exit-messages"
+	ExitArchiveFormat           string = "exits-%s-%d.json"
+	HistoryFolder               string = "history"
+	EventsFolder                string = "events"
+	AutomationFolder            string = "automation"
+	AutomationLedgerFormat      string = "ledger-%s.json"
+	TxJournalFolder             string = "transactions"
+	OfflineBundleFormat         string = "offline-bundle-%s.json"
 	FeeRecipientFilename        string = "stader-fee-recipient.txt"
 	NativeFeeRecipientFilename  string = "stader-fee-recipient-env.txt"
 )
@@ -62,6 +80,8 @@ var devEncryptionKey string
 // --ignore-sync-check
 // Defaults
 const defaultProjectName string = "stader"
+const defaultPresignBatchSize uint64 = 50
+const defaultHistoryRetentionDays uint64 = 30
 
 // Configuration for the Stader node
 type StaderNodeConfig struct {
@@ -101,6 +121,15 @@ type StaderNodeConfig struct {
 	// The path of the data folder where everything is stored
 	SsvMigration config.Parameter `yaml:"ssvMigration,omitempty"`
 
+	// Number of validator keys the presign daemon signs and sends per backend request
+	PresignBatchSize config.Parameter `yaml:"presignBatchSize,omitempty"`
+
+	// Number of days of guardian metrics snapshots to keep
+	HistoryRetentionDays config.Parameter `yaml:"historyRetentionDays,omitempty"`
+
+	// Operators watched by the guardian instead of the node account
+	WatchedOperators config.Parameter `yaml:"watchedOperators,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -123,6 +152,12 @@ type StaderNodeConfig struct {
 	// The contract address of stader config
 	staderConfigAddress map[config.Network]string `yaml:"-"`
 
+	// The contract address of Multicall3
+	multicallAddress map[config.Network]string `yaml:"-"`
+
+	// The block the event indexer starts from, before any operator could onboard
+	eventIndexerStartBlock map[config.Network]uint64 `yaml:"-"`
+
 	// The base url of stader backend
 	baseStaderBackendUrl map[config.Network]string `yaml:"-"`
 
@@ -225,7 +260,7 @@ func NewStadernodeConfig(cfg *StaderConfig) *StaderNodeConfig {
 		ArchiveECUrl: config.Parameter{
 			ID:                   "archiveECUrl",
 			Name:                 "Archive-Mode EC URL",
-			Description:          "[orange]**For manual Merkle rewards tree generation only.**[white]\n\nGenerating the Merkle rewards tree files for past rewards intervals typically requires an Execution client with Archive mode enabled, which is usually disabled on your primary and fallback Execution clients to save disk space.\nIf you want to generate your own rewards tree files for intervals from a long time ago, you may enter the URL of an Execution client with Archive access here.\n\nFor a free light client with Archive access, you may use https://www.alchemy.com/supernode.",
+			Description:          "[orange]**For manual Socializing Pool rewards tree generation only.**[white]\n\nGenerating the Socializing Pool rewards tree files for past cycles typically requires an Execution client with Archive mode enabled, which is usually disabled on your primary and fallback Execution clients to save disk space.\nIf you want to generate your own rewards tree files with `stader-cli node generate-sp-tree` for cycles from a long time ago, you may enter the URL of an Execution client with Archive access here.\n\nFor a free light client with Archive access, you may use https://www.alchemy.com/supernode.",
 			Type:                 config.ParameterType_String,
 			Default:              map[config.Network]interface{}{config.Network_All: ""},
 			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
@@ -246,6 +281,42 @@ func NewStadernodeConfig(cfg *StaderConfig) *StaderNodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		PresignBatchSize: config.Parameter{
+			ID:                   "presignBatchSize",
+			Name:                 "Presign Batch Size",
+			Description:          "The number of validator exit messages the node daemon signs and sends to the Stader backend in a single request. Lower this if the backend rejects large batches.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(defaultPresignBatchSize)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Node},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		HistoryRetentionDays: config.Parameter{
+			ID:                   "historyRetentionDays",
+			Name:                 "History Retention",
+			Description:          "The number of days of metrics snapshots the guardian keeps for `stader-cli node history`. Set to 0 to stop recording snapshots.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(defaultHistoryRetentionDays)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WatchedOperators: config.Parameter{
+			ID:                   "watchedOperators",
+			Name:                 "Watched Operators",
+			Description:          "A comma-separated list of operator addresses for the guardian to monitor instead of the node wallet's account, so one monitoring stack can cover several operators. The guardian doesn't need a wallet when this is set, and each operator's metrics are labelled with its address.\n\nLeave this blank to monitor the node wallet's account.",
+			Type:                 config.ParameterType_String,
+			Default:              map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Guardian},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           true,
+			OverwriteOnUpgrade:   false,
+		},
+
 		beaconChainUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://beaconcha.in",
 			config.Network_Holesky: "https://holesky.beaconcha.in",
@@ -271,6 +342,16 @@ func NewStadernodeConfig(cfg *StaderConfig) *StaderNodeConfig {
 			config.Network_Mainnet: "0x4ABEF2263d5A5ED582FC9A9789a41D85b68d69DB",
 		},
 
+		multicallAddress: map[config.Network]string{
+			config.Network_Holesky: "0xcA11bde05977b3631167028862bE2a173976CA11",
+			config.Network_Mainnet: "0xcA11bde05977b3631167028862bE2a173976CA11",
+		},
+
+		eventIndexerStartBlock: map[config.Network]uint64{
+			config.Network_Holesky: 0,
+			config.Network_Mainnet: 17500000,
+		},
+
 		baseStaderBackendUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://ethx-offchain.staderlabs.com",
 			config.Network_Holesky: "https://ethx-offchain-preprod.staderlabs.com",
@@ -294,6 +375,9 @@ func (cfg *StaderNodeConfig) GetParameters() []*config.Parameter {
 		&cfg.TxFeeCap,
 		&cfg.ArchiveECUrl,
 		&cfg.SsvMigration,
+		&cfg.PresignBatchSize,
+		&cfg.HistoryRetentionDays,
+		&cfg.WatchedOperators,
 	}
 }
 
@@ -441,6 +525,14 @@ func (cfg *StaderNodeConfig) GetStaderConfigAddress() common.Address {
 	return common.HexToAddress(cfg.staderConfigAddress[cfg.Network.Value.(config.Network)])
 }
 
+func (cfg *StaderNodeConfig) GetMulticallAddress() common.Address {
+	return common.HexToAddress(cfg.multicallAddress[cfg.Network.Value.(config.Network)])
+}
+
+func (cfg *StaderNodeConfig) GetEventIndexerStartBlock() uint64 {
+	return cfg.eventIndexerStartBlock[cfg.Network.Value.(config.Network)]
+}
+
 func getDefaultDataDir(config *StaderConfig) string {
 	return filepath.Join(config.StaderDirectory, "data")
 }
@@ -469,6 +561,90 @@ func (cfg *StaderNodeConfig) GetSpRewardCyclePath(cycle int64, daemon bool) stri
 	return filepath.Join(cfg.DataPath.Value.(string), SpRewardsMerkleProofsFolder, fmt.Sprintf(MerkleProofsFormat, string(cfg.Network.Value.(config.Network)), cycle))
 }
 
+func (cfg *StaderNodeConfig) GetSpRewardCycleQuarantinePath(cycle int64, root [32]byte, daemon bool) string {
+	proofsFolder := filepath.Dir(cfg.GetSpRewardCyclePath(cycle, daemon))
+
+	return filepath.Join(proofsFolder, QuarantinedProofsFolder, fmt.Sprintf(QuarantinedProofsFormat, string(cfg.Network.Value.(config.Network)), cycle, root))
+}
+
+func (cfg *StaderNodeConfig) GetGeneratedSpRewardsTreePath(cycle int64, daemon bool) string {
+	proofsFolder := filepath.Dir(cfg.GetSpRewardCyclePath(cycle, daemon))
+
+	return filepath.Join(proofsFolder, GeneratedTreesFolder, fmt.Sprintf(MerkleProofsFormat, string(cfg.Network.Value.(config.Network)), cycle))
+}
+
+func (cfg *StaderNodeConfig) GetPresignBatchSize() uint64 {
+	batchSize, ok := cfg.PresignBatchSize.Value.(uint64)
+	if !ok || batchSize == 0 {
+		return defaultPresignBatchSize
+	}
+	return batchSize
+}
+
+func (cfg *StaderNodeConfig) GetPresignLedgerPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, PresignFolder, fmt.Sprintf(PresignLedgerFormat, string(cfg.Network.Value.(config.Network))))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), PresignFolder, fmt.Sprintf(PresignLedgerFormat, string(cfg.Network.Value.(config.Network))))
+}
+
+func (cfg *StaderNodeConfig) GetTxJournalFolder(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, TxJournalFolder, string(cfg.Network.Value.(config.Network)))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), TxJournalFolder, string(cfg.Network.Value.(config.Network)))
+}
+
+func (cfg *StaderNodeConfig) GetExitMessagesFolder(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, ExitMessagesFolder)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), ExitMessagesFolder)
+}
+
+func (cfg *StaderNodeConfig) GetExitArchivePath(timestamp int64, daemon bool) string {
+	return filepath.Join(cfg.GetExitMessagesFolder(daemon), fmt.Sprintf(ExitArchiveFormat, string(cfg.Network.Value.(config.Network)), timestamp))
+}
+
+func (cfg *StaderNodeConfig) GetHistoryFolder(daemon bool) string {
+	return filepath.Join(cfg.GetGuardianFolder(daemon), HistoryFolder, string(cfg.Network.Value.(config.Network)))
+}
+
+func (cfg *StaderNodeConfig) GetEventsDbPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, EventsFolder, string(cfg.Network.Value.(config.Network)))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), EventsFolder, string(cfg.Network.Value.(config.Network)))
+}
+
+func (cfg *StaderNodeConfig) GetAutomationLedgerPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, AutomationFolder, fmt.Sprintf(AutomationLedgerFormat, string(cfg.Network.Value.(config.Network))))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), AutomationFolder, fmt.Sprintf(AutomationLedgerFormat, string(cfg.Network.Value.(config.Network))))
+}
+
+func (cfg *StaderNodeConfig) GetOfflineBundlePath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, fmt.Sprintf(OfflineBundleFormat, string(cfg.Network.Value.(config.Network))))
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), fmt.Sprintf(OfflineBundleFormat, string(cfg.Network.Value.(config.Network))))
+}
+
+func (cfg *StaderNodeConfig) GetHistoryRetentionDays() uint64 {
+	retentionDays, ok := cfg.HistoryRetentionDays.Value.(uint64)
+	if !ok {
+		return defaultHistoryRetentionDays
+	}
+	return retentionDays
+}
+
 func (cfg *StaderNodeConfig) GetFeeRecipientFilePath() string {
 	if !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, "validators", FeeRecipientFilename)
@@ -558,12 +734,13 @@ func (cfg *StaderNodeConfig) ReadCycleCache(cycle int64) (stader_backend.CycleMe
 	if err != nil {
 		return stader_backend.CycleMerkleProofs{}, false, err
 	}
+	defer file.Close()
 
 	var cycleMerkleProof stader_backend.CycleMerkleProofs
 	decoder := json.NewDecoder(file)
 	err = decoder.Decode(&cycleMerkleProof)
 	if err != nil {
-		return stader_backend.CycleMerkleProofs{}, false, err
+		return stader_backend.CycleMerkleProofs{}, false, fmt.Errorf("%w: %s", ErrCorruptCycleCache, err.Error())
 	}
 
 	return cycleMerkleProof, true, nil
@@ -592,3 +769,28 @@ func getNetworkOptions() []config.ParameterOption {
 
 	return options
 }
+
+// Get the operators watched by the guardian, or nil if it watches the node account
+func (cfg *StaderNodeConfig) GetWatchedOperators() ([]common.Address, error) {
+	watchedOperators, _ := cfg.WatchedOperators.Value.(string)
+	operators := []common.Address{}
+	seen := map[common.Address]bool{}
+	for _, operator := range strings.Split(watchedOperators, ",") {
+		operator = strings.TrimSpace(operator)
+		if operator == "" {
+			continue
+		}
+		if !common.IsHexAddress(operator) {
+			return nil, fmt.Errorf("invalid watched operator address [%s]", operator)
+		}
+		address := common.HexToAddress(operator)
+		if !seen[address] {
+			seen[address] = true
+			operators = append(operators, address)
+		}
+	}
+	if len(operators) == 0 {
+		return nil, nil
+	}
+	return operators, nil
+}
@@ -35,6 +35,8 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/fatih/color"
 	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/txbundle"
+	"github.com/stader-labs/stader-node/shared/services/txjournal"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
 	"github.com/stader-labs/stader-node/shared/utils/log"
@@ -51,6 +53,8 @@ type ExecutionClientManager struct {
 	primaryReady    bool
 	fallbackReady   bool
 	ignoreSyncCheck bool
+	txJournal       *txjournal.Journal
+	txExporter      *txbundle.Exporter
 }
 
 // This is a signature for a wrapped ethclient.Client function
@@ -185,6 +189,9 @@ func (p *ExecutionClientManager) PendingNonceAt(ctx context.Context, account com
 	if err != nil {
 		return 0, err
 	}
+	if p.txExporter != nil && account == p.txExporter.From() {
+		return p.txExporter.NextNonce(result.(uint64))
+	}
 	return result.(uint64), err
 }
 
@@ -228,10 +235,24 @@ func (p *ExecutionClientManager) EstimateGas(ctx context.Context, call ethereum.
 }
 
 // SendTransaction injects the transaction into the pending pool for execution.
+// Sent transactions are added to the transaction journal, if one is set.
 func (p *ExecutionClientManager) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if p.txExporter != nil {
+		accountNonce, err := p.NonceAt(ctx, p.txExporter.From(), nil)
+		if err != nil {
+			return err
+		}
+		return p.txExporter.Add(tx, accountNonce)
+	}
+
 	_, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return nil, client.SendTransaction(ctx, tx)
 	})
+	if err == nil && p.txJournal != nil {
+		if recordErr := p.txJournal.Record(tx); recordErr != nil {
+			p.logger.Printlnf("WARNING: could not record transaction %s: %s", tx.Hash().Hex(), recordErr.Error())
+		}
+	}
 	return err
 }
 
@@ -519,6 +540,16 @@ func (p *ExecutionClientManager) runFunction(function ecFunction) (interface{},
 	return nil, fmt.Errorf("no Execution clients were ready")
 }
 
+// Record every transaction sent through the manager in the given journal
+func (p *ExecutionClientManager) SetTxJournal(journal *txjournal.Journal) {
+	p.txJournal = journal
+}
+
+// Add the transactions of an offline node account to a bundle instead of sending them
+func (p *ExecutionClientManager) SetTxExporter(exporter *txbundle.Exporter) {
+	p.txExporter = exporter
+}
+
 // Returns true if the error was a connection failure and a backup client is available
 func (p *ExecutionClientManager) isDisconnected(err error) bool {
 	return strings.Contains(err.Error(), "dial tcp")
@@ -37,6 +37,11 @@ import (
 
 func AssignMaxFeeAndLimit(gasInfo staderCore.GasInfo, staderClient *stader.Client, headless bool) error {
 
+	// A watched node account can't send transactions
+	if staderClient.IsWatchOnly() {
+		return fmt.Errorf("The node account is only being watched with --address, so it can't send transactions. Run the command again without --address to use the node wallet.")
+	}
+
 	cfg, isNew, err := staderClient.LoadConfig()
 	if err != nil {
 		return fmt.Errorf("Error getting Stader configuration: %w", err)
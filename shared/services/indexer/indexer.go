@@ -0,0 +1,292 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stader-labs/stader-node/shared/types/events"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+const (
+	// Blocks behind the head that are left unindexed, so a usual reorg never reaches the store
+	Confirmations uint64 = 64
+
+	// Largest block range requested in a single eth_getLogs call
+	DefaultChunkSize uint64 = 10000
+
+	// Ranges that fail are split in half down to this size before giving up
+	minChunkSize uint64 = 100
+)
+
+// A set of events to index from one or more contracts that share an ABI
+type Source struct {
+	Contract  string
+	Abi       *abi.ABI
+	Addresses []common.Address
+	Events    []string
+
+	// Values the indexed arguments must match, by position; a nil position matches anything
+	Topics [][]common.Hash
+
+	// Keeps only the decoded events that concern the operator, for arguments that aren't indexed; nil keeps them all
+	Filter func(event *events.Event) bool
+}
+
+// Incrementally copies the events of its sources into the store, up to the confirmed head
+type Indexer struct {
+	ChunkSize uint64
+
+	client     stader.ExecutionClient
+	store      *Store
+	sources    []Source
+	startBlock uint64
+}
+
+// Create an indexer; startBlock is where indexing begins when the store is empty
+func NewIndexer(client stader.ExecutionClient, store *Store, sources []Source, startBlock uint64) *Indexer {
+	return &Indexer{
+		ChunkSize:  DefaultChunkSize,
+		client:     client,
+		store:      store,
+		sources:    sources,
+		startBlock: startBlock,
+	}
+}
+
+// The topic an address has as an indexed argument
+func AddressTopic(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
+
+// Index the new confirmed blocks, at most maxChunks ranges of ChunkSize blocks (0 for no limit).
+// Returns true once the store has caught up with the confirmed head.
+func (ix *Indexer) Run(maxChunks int) (bool, error) {
+	nextBlock, err := ix.getNextBlock()
+	if err != nil {
+		return false, err
+	}
+
+	head, err := ix.client.BlockNumber(context.Background())
+	if err != nil {
+		return false, fmt.Errorf("error getting the latest block: %w", err)
+	}
+	if head < Confirmations {
+		return true, nil
+	}
+	target := head - Confirmations
+
+	for chunks := 0; nextBlock <= target; chunks++ {
+		if maxChunks > 0 && chunks >= maxChunks {
+			return false, nil
+		}
+		end := nextBlock + ix.ChunkSize - 1
+		if end > target {
+			end = target
+		}
+		if err := ix.indexRange(nextBlock, end); err != nil {
+			return false, err
+		}
+		nextBlock = end + 1
+	}
+	return true, nil
+}
+
+// Find the block to resume from. If the last checkpoint isn't canonical anymore,
+// roll the store back to the newest checkpoint that still is.
+func (ix *Indexer) getNextBlock() (uint64, error) {
+	checkpoints, err := ix.store.GetCheckpoints()
+	if err != nil {
+		return 0, err
+	}
+
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		checkpoint := checkpoints[i]
+		header, err := ix.client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(checkpoint.BlockNumber))
+		if err != nil {
+			return 0, fmt.Errorf("error getting block %d: %w", checkpoint.BlockNumber, err)
+		}
+		if header.Hash() != checkpoint.BlockHash {
+			continue
+		}
+		if i < len(checkpoints)-1 {
+			if err := ix.store.Rollback(&checkpoint); err != nil {
+				return 0, err
+			}
+		}
+		return checkpoint.BlockNumber + 1, nil
+	}
+
+	// Either nothing was indexed yet or every checkpoint was reorged out, so start over
+	if len(checkpoints) > 0 {
+		if err := ix.store.Rollback(nil); err != nil {
+			return 0, err
+		}
+	}
+	return ix.startBlock, nil
+}
+
+// Index the events of a block range and save them with a checkpoint at its end
+func (ix *Indexer) indexRange(fromBlock uint64, toBlock uint64) error {
+	found := []events.Event{}
+	for _, source := range ix.sources {
+		// A query without addresses would match every contract on the chain
+		if len(source.Addresses) == 0 {
+			continue
+		}
+		logs, err := ix.filterLogs(source, fromBlock, toBlock)
+		if err != nil {
+			return err
+		}
+		for _, log := range logs {
+			if log.Removed {
+				continue
+			}
+			event, err := decodeLog(source, log)
+			if err != nil {
+				return err
+			}
+			if source.Filter != nil && !source.Filter(&event) {
+				continue
+			}
+			found = append(found, event)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].BlockNumber != found[j].BlockNumber {
+			return found[i].BlockNumber < found[j].BlockNumber
+		}
+		return found[i].LogIndex < found[j].LogIndex
+	})
+
+	timestamps := map[uint64]int64{}
+	for i := range found {
+		blockNumber := found[i].BlockNumber
+		if _, exists := timestamps[blockNumber]; !exists {
+			header, err := ix.client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(blockNumber))
+			if err != nil {
+				return fmt.Errorf("error getting block %d: %w", blockNumber, err)
+			}
+			timestamps[blockNumber] = int64(header.Time)
+		}
+		found[i].Timestamp = timestamps[blockNumber]
+	}
+
+	header, err := ix.client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(toBlock))
+	if err != nil {
+		return fmt.Errorf("error getting block %d: %w", toBlock, err)
+	}
+	return ix.store.SaveEvents(found, events.Checkpoint{
+		BlockNumber: toBlock,
+		BlockHash:   header.Hash(),
+	})
+}
+
+// Get the logs of a source, splitting the range when the client rejects it as too large
+func (ix *Indexer) filterLogs(source Source, fromBlock uint64, toBlock uint64) ([]types.Log, error) {
+	eventIds := make([]common.Hash, len(source.Events))
+	for i, name := range source.Events {
+		event, exists := source.Abi.Events[name]
+		if !exists {
+			return nil, fmt.Errorf("the %s ABI has no %s event", source.Contract, name)
+		}
+		eventIds[i] = event.ID
+	}
+
+	logs, err := ix.client.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: source.Addresses,
+		Topics:    append([][]common.Hash{eventIds}, source.Topics...),
+	})
+	if err == nil {
+		return logs, nil
+	}
+	if toBlock-fromBlock+1 <= minChunkSize {
+		return nil, fmt.Errorf("error getting %s events between blocks %d and %d: %w", source.Contract, fromBlock, toBlock, err)
+	}
+
+	middle := fromBlock + (toBlock-fromBlock)/2
+	firstHalf, err := ix.filterLogs(source, fromBlock, middle)
+	if err != nil {
+		return nil, err
+	}
+	secondHalf, err := ix.filterLogs(source, middle+1, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	return append(firstHalf, secondHalf...), nil
+}
+
+// Decode a log into an event with its arguments as strings
+func decodeLog(source Source, log types.Log) (events.Event, error) {
+	if len(log.Topics) == 0 {
+		return events.Event{}, fmt.Errorf("%s log %d of tx %s has no topics", source.Contract, log.Index, log.TxHash.Hex())
+	}
+	abiEvent, err := source.Abi.EventByID(log.Topics[0])
+	if err != nil {
+		return events.Event{}, fmt.Errorf("error decoding %s log %d of tx %s: %w", source.Contract, log.Index, log.TxHash.Hex(), err)
+	}
+
+	values := map[string]interface{}{}
+	if len(log.Data) > 0 {
+		if err := source.Abi.UnpackIntoMap(values, abiEvent.Name, log.Data); err != nil {
+			return events.Event{}, fmt.Errorf("error decoding %s %s event of tx %s: %w", source.Contract, abiEvent.Name, log.TxHash.Hex(), err)
+		}
+	}
+	indexed := abi.Arguments{}
+	for _, input := range abiEvent.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+		return events.Event{}, fmt.Errorf("error decoding %s %s event topics of tx %s: %w", source.Contract, abiEvent.Name, log.TxHash.Hex(), err)
+	}
+
+	args := make(map[string]string, len(values))
+	for name, value := range values {
+		args[name] = formatArg(value)
+	}
+
+	return events.Event{
+		Contract:    source.Contract,
+		Name:        abiEvent.Name,
+		Address:     log.Address,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+		Args:        args,
+	}, nil
+}
+
+func formatArg(value interface{}) string {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case []byte:
+		return hexutil.Encode(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,208 @@
+package indexer
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/events"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/contracts"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+)
+
+// Build the event sources of an operator: the events of its node address, reward addresses, validators and vaults.
+// pastRewardAddresses are reward addresses the operator used before, so their claims keep being matched.
+func GetOperatorSources(c *cli.Context, nodeAddress common.Address, pastRewardAddresses []common.Address) ([]Source, error) {
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	sdc, err := services.GetSdCollateralContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+	orc, err := services.GetOperatorRewardsCollectorContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := services.GetSocializingPoolContract(c)
+	if err != nil {
+		return nil, err
+	}
+	prn, err := services.GetPenaltyTrackerContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorId, err := node.GetOperatorId(pnr, nodeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	operatorInfo, err := node.GetOperatorInfo(pnr, operatorId, nil)
+	if err != nil {
+		return nil, err
+	}
+	elVault, err := node.GetNodeElRewardAddress(pnr, 1, operatorId, nil)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := node.GetAllValidatorsInfoByOperator(pnr, nodeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pubkeys := map[string]bool{}
+	withdrawVaults := []common.Address{}
+	for _, validator := range validators {
+		pubkeys[hexutil.Encode(validator.Pubkey)] = true
+		withdrawVaults = append(withdrawVaults, validator.WithdrawVaultAddress)
+	}
+	elVaults := []common.Address{}
+	if !eth1.IsZeroAddress(elVault) {
+		elVaults = append(elVaults, elVault)
+	}
+
+	nodeTopic := []common.Hash{AddressTopic(nodeAddress)}
+	recipientTopics := []common.Hash{AddressTopic(nodeAddress), AddressTopic(operatorInfo.OperatorRewardAddress)}
+	for _, address := range pastRewardAddresses {
+		if address != operatorInfo.OperatorRewardAddress {
+			recipientTopics = append(recipientTopics, AddressTopic(address))
+		}
+	}
+	operatorIdString := operatorId.String()
+
+	withdrawVaultAbi, err := contracts.ValidatorWithdrawVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	elVaultAbi, err := contracts.NodeElRewardVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Source{
+		{
+			Contract:  "PermissionlessNodeRegistry",
+			Abi:       pnr.PermissionlessNodeRegistryContract.ABI,
+			Addresses: []common.Address{*pnr.PermissionlessNodeRegistryContract.Address},
+			Events:    []string{"OnboardedOperator", "AddedValidatorKey", "UpdatedOperatorName", "RewardAddressProposed", "OperatorRewardAddressUpdated"},
+			Topics:    [][]common.Hash{nodeTopic},
+		},
+		{
+			Contract:  "PermissionlessNodeRegistry",
+			Abi:       pnr.PermissionlessNodeRegistryContract.ABI,
+			Addresses: []common.Address{*pnr.PermissionlessNodeRegistryContract.Address},
+			Events:    []string{"ValidatorMarkedReadyToDeposit", "ValidatorMarkedAsFrontRunned", "ValidatorStatusMarkedAsInvalidSignature", "ValidatorWithdrawn", "UpdatedSocializingPoolState"},
+			Filter: func(event *events.Event) bool {
+				if event.Name == "UpdatedSocializingPoolState" {
+					return event.Args["operatorId"] == operatorIdString
+				}
+				return pubkeys[event.Args["pubkey"]]
+			},
+		},
+		{
+			Contract:  "SdCollateral",
+			Abi:       sdc.SdCollateralContract.ABI,
+			Addresses: []common.Address{*sdc.SdCollateralContract.Address},
+			Events:    []string{"SDDeposited", "SDWithdrawn", "SDSlashed", "UtilizedSDDeposited", "ReducedUtilizedPosition"},
+			Topics:    [][]common.Hash{nodeTopic},
+		},
+		{
+			Contract:  "SdCollateral",
+			Abi:       sdc.SdCollateralContract.ABI,
+			Addresses: []common.Address{*sdc.SdCollateralContract.Address},
+			Events:    []string{"UtilizedSDSlashed"},
+			Filter:    matchAddressArg("operator", nodeAddress),
+		},
+		{
+			Contract:  "SDUtilityPool",
+			Abi:       sdu.SDUtilityPoolContract.ABI,
+			Addresses: []common.Address{*sdu.SDUtilityPoolContract.Address},
+			Events:    []string{"Repaid", "RepaidUtilizedSDBalance", "ClearedUtilizerInterest", "LiquidationCall"},
+			Topics:    [][]common.Hash{nodeTopic},
+		},
+		{
+			Contract:  "SDUtilityPool",
+			Abi:       sdu.SDUtilityPoolContract.ABI,
+			Addresses: []common.Address{*sdu.SDUtilityPoolContract.Address},
+			Events:    []string{"SDUtilized"},
+			Filter:    matchAddressArg("utilizer", nodeAddress),
+		},
+		{
+			Contract:  "OperatorRewardsCollector",
+			Abi:       orc.OperatorRewardsCollectorContract.ABI,
+			Addresses: []common.Address{*orc.OperatorRewardsCollectorContract.Address},
+			Events:    []string{"Claimed"},
+			Topics:    [][]common.Hash{recipientTopics},
+		},
+		{
+			Contract:  "OperatorRewardsCollector",
+			Abi:       orc.OperatorRewardsCollectorContract.ABI,
+			Addresses: []common.Address{*orc.OperatorRewardsCollectorContract.Address},
+			Events:    []string{"DepositedFor"},
+			Topics:    [][]common.Hash{nil, nodeTopic},
+		},
+		{
+			Contract:  "SocializingPool",
+			Abi:       sp.SocializingPoolContract.ABI,
+			Addresses: []common.Address{*sp.SocializingPoolContract.Address},
+			Events:    []string{"OperatorRewardsClaimed"},
+			Topics:    [][]common.Hash{recipientTopics},
+		},
+		{
+			Contract:  "PenaltyTracker",
+			Abi:       prn.PenaltyContract.ABI,
+			Addresses: []common.Address{*prn.PenaltyContract.Address},
+			Events:    []string{"ForceExitValidator", "ValidatorMarkedAsSettled"},
+			Filter: func(event *events.Event) bool {
+				return pubkeys[event.Args["pubkey"]]
+			},
+		},
+		{
+			Contract:  "ValidatorWithdrawVault",
+			Abi:       withdrawVaultAbi,
+			Addresses: withdrawVaults,
+			Events:    []string{"ETHReceived", "DistributedRewards", "DistributeRewardFailed", "SettledFunds"},
+		},
+		{
+			Contract:  "NodeElRewardVault",
+			Abi:       elVaultAbi,
+			Addresses: elVaults,
+			Events:    []string{"ETHReceived", "Withdrawal"},
+		},
+	}, nil
+}
+
+// Get the reward addresses the operator has had, from its indexed registry events
+func GetPastRewardAddresses(indexedEvents []events.Event) []common.Address {
+	addresses := []common.Address{}
+	for _, event := range indexedEvents {
+		var address string
+		switch event.Name {
+		case "OnboardedOperator":
+			address = event.Args["nodeRewardAddress"]
+		case "OperatorRewardAddressUpdated":
+			address = event.Args["rewardAddress"]
+		default:
+			continue
+		}
+		if common.IsHexAddress(address) {
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+	}
+	return addresses
+}
+
+func matchAddressArg(name string, address common.Address) func(event *events.Event) bool {
+	return func(event *events.Event) bool {
+		return strings.EqualFold(event.Args[name], address.Hex())
+	}
+}
@@ -0,0 +1,185 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/stader-labs/stader-node/shared/types/events"
+)
+
+// Number of checkpoints kept to find a common ancestor after a reorg
+const maxCheckpoints = 32
+
+// Events are keyed by block number and log index so iterating the keys returns them in chain order
+var (
+	eventPrefix    = []byte("e/")
+	checkpointsKey = []byte("checkpoints")
+)
+
+// Returned when opening a read-only store that the daemon hasn't created yet
+var ErrStoreNotFound = errors.New("the event database doesn't exist yet, the node daemon creates it when it starts indexing")
+
+// The embedded database of indexed events.
+// Only one process can open it for writing, so the daemon holds it only while it indexes.
+type Store struct {
+	db *leveldb.DB
+}
+
+// Open the store at the given path; a read-only store doesn't create the database if it doesn't exist
+func OpenStore(path string, readOnly bool) (*Store, error) {
+	if readOnly {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrStoreNotFound, path)
+		}
+	}
+	db, err := leveldb.OpenFile(path, &opt.Options{ReadOnly: readOnly})
+	if err != nil {
+		return nil, fmt.Errorf("error opening the event database %s: %w", path, err)
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Get the saved checkpoints, oldest first
+func (s *Store) GetCheckpoints() ([]events.Checkpoint, error) {
+	value, err := s.db.Get(checkpointsKey, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return []events.Checkpoint{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoints: %w", err)
+	}
+	checkpoints := []events.Checkpoint{}
+	if err := json.Unmarshal(value, &checkpoints); err != nil {
+		return nil, fmt.Errorf("error decoding checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+// Get the last block that has been indexed, or 0 if nothing has been indexed yet
+func (s *Store) GetIndexedBlock() (uint64, error) {
+	checkpoints, err := s.GetCheckpoints()
+	if err != nil {
+		return 0, err
+	}
+	if len(checkpoints) == 0 {
+		return 0, nil
+	}
+	return checkpoints[len(checkpoints)-1].BlockNumber, nil
+}
+
+// Save the events of a range of blocks along with the checkpoint at its end, atomically
+func (s *Store) SaveEvents(newEvents []events.Event, checkpoint events.Checkpoint) error {
+	checkpoints, err := s.GetCheckpoints()
+	if err != nil {
+		return err
+	}
+	checkpoints = append(checkpoints, checkpoint)
+	if len(checkpoints) > maxCheckpoints {
+		checkpoints = checkpoints[len(checkpoints)-maxCheckpoints:]
+	}
+
+	batch := new(leveldb.Batch)
+	for _, event := range newEvents {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("error encoding %s event: %w", event.Name, err)
+		}
+		batch.Put(eventKey(event.BlockNumber, event.LogIndex), value)
+	}
+	value, err := json.Marshal(checkpoints)
+	if err != nil {
+		return fmt.Errorf("error encoding checkpoints: %w", err)
+	}
+	batch.Put(checkpointsKey, value)
+
+	if err := s.db.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return fmt.Errorf("error writing events: %w", err)
+	}
+	return nil
+}
+
+// Delete the events after the given checkpoint and the checkpoints that follow it.
+// A nil checkpoint clears the whole store.
+func (s *Store) Rollback(checkpoint *events.Checkpoint) error {
+	checkpoints, err := s.GetCheckpoints()
+	if err != nil {
+		return err
+	}
+
+	firstDeletedBlock := uint64(0)
+	kept := []events.Checkpoint{}
+	if checkpoint != nil {
+		firstDeletedBlock = checkpoint.BlockNumber + 1
+		for _, existing := range checkpoints {
+			if existing.BlockNumber <= checkpoint.BlockNumber {
+				kept = append(kept, existing)
+			}
+		}
+	}
+
+	batch := new(leveldb.Batch)
+	iterator := s.db.NewIterator(&util.Range{Start: eventKey(firstDeletedBlock, 0), Limit: util.BytesPrefix(eventPrefix).Limit}, nil)
+	for iterator.Next() {
+		batch.Delete(append([]byte{}, iterator.Key()...))
+	}
+	iterator.Release()
+	if err := iterator.Error(); err != nil {
+		return fmt.Errorf("error reading events: %w", err)
+	}
+
+	value, err := json.Marshal(kept)
+	if err != nil {
+		return fmt.Errorf("error encoding checkpoints: %w", err)
+	}
+	batch.Put(checkpointsKey, value)
+
+	if err := s.db.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return fmt.Errorf("error rolling back events: %w", err)
+	}
+	return nil
+}
+
+// Get the events between two blocks, inclusive, in chain order
+func (s *Store) GetEvents(fromBlock uint64, toBlock uint64) ([]events.Event, error) {
+	result := []events.Event{}
+	if toBlock < fromBlock {
+		return result, nil
+	}
+
+	limit := util.BytesPrefix(eventPrefix).Limit
+	if toBlock < ^uint64(0) {
+		limit = eventKey(toBlock+1, 0)
+	}
+	iterator := s.db.NewIterator(&util.Range{Start: eventKey(fromBlock, 0), Limit: limit}, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		var event events.Event
+		if err := json.Unmarshal(iterator.Value(), &event); err != nil {
+			return nil, fmt.Errorf("error decoding event: %w", err)
+		}
+		result = append(result, event)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("error reading events: %w", err)
+	}
+	return result, nil
+}
+
+func eventKey(blockNumber uint64, logIndex uint) []byte {
+	key := make([]byte, len(eventPrefix)+12)
+	copy(key, eventPrefix)
+	binary.BigEndian.PutUint64(key[len(eventPrefix):], blockNumber)
+	binary.BigEndian.PutUint32(key[len(eventPrefix)+8:], uint32(logIndex))
+	return key
+}
@@ -0,0 +1,216 @@
+package presign
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+type EntryStatus string
+
+const (
+	// The exit message was signed but the backend has not acknowledged it yet
+	StatusPending EntryStatus = "pending"
+	// The backend accepted the exit message, or reported the key as already registered
+	StatusAcknowledged EntryStatus = "acknowledged"
+	// The backend explicitly rejected the exit message
+	StatusRejected EntryStatus = "rejected"
+)
+
+// Ledger entry for a single validator key
+type Entry struct {
+	Pubkey             string      `json:"pubkey"`
+	ValidatorIndex     uint64      `json:"validatorIndex"`
+	ExitEpoch          uint64      `json:"exitEpoch"`
+	Status             EntryStatus `json:"status"`
+	Retries            uint64      `json:"retries"`
+	LastError          string      `json:"lastError,omitempty"`
+	SignedAt           int64       `json:"signedAt"`
+	LastAttemptAt      int64       `json:"lastAttemptAt"`
+	AcknowledgedAt     int64       `json:"acknowledgedAt,omitempty"`
+	EncryptedSignature string      `json:"encryptedSignature,omitempty"`
+}
+
+// Outcome of the last daemon pass, kept so backend outages can be diagnosed after the fact
+type PassInfo struct {
+	StartedAt    int64  `json:"startedAt"`
+	FinishedAt   int64  `json:"finishedAt"`
+	KeysChecked  uint64 `json:"keysChecked"`
+	KeysSigned   uint64 `json:"keysSigned"`
+	KeysSent     uint64 `json:"keysSent"`
+	BackendError string `json:"backendError,omitempty"`
+}
+
+type ledgerFile struct {
+	LastPass PassInfo          `json:"lastPass"`
+	Entries  map[string]*Entry `json:"entries"`
+}
+
+// On-disk record of the exit messages the presign daemon generated and sent to the Stader backend
+type Ledger struct {
+	path string
+	data ledgerFile
+	lock sync.Mutex
+}
+
+// Load the ledger at the given path; a missing file yields an empty ledger
+func LoadLedger(path string) (*Ledger, error) {
+	ledger := &Ledger{
+		path: path,
+		data: ledgerFile{
+			Entries: map[string]*Entry{},
+		},
+	}
+
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ledger, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read presign ledger %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, &ledger.data); err != nil {
+		return nil, fmt.Errorf("could not decode presign ledger %s: %w", path, err)
+	}
+	if ledger.data.Entries == nil {
+		ledger.data.Entries = map[string]*Entry{}
+	}
+
+	return ledger, nil
+}
+
+// Write the ledger to disk, replacing the previous file atomically
+func (l *Ledger) Save() error {
+	l.lock.Lock()
+	bytes, err := json.MarshalIndent(l.data, "", "  ")
+	l.lock.Unlock()
+	if err != nil {
+		return fmt.Errorf("could not encode presign ledger: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("could not create presign ledger folder: %w", err)
+	}
+	tmpPath := l.path + ".tmp"
+	if err := os.WriteFile(tmpPath, bytes, 0600); err != nil {
+		return fmt.Errorf("could not write presign ledger %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, l.path); err != nil {
+		return fmt.Errorf("could not replace presign ledger %s: %w", l.path, err)
+	}
+
+	return nil
+}
+
+// Get a copy of the entry for a pubkey
+func (l *Ledger) Get(pubkey string) (Entry, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, ok := l.data.Entries[pubkey]
+	if !ok {
+		return Entry{}, false
+	}
+	return *entry, true
+}
+
+// Get a copy of every entry, sorted by validator index
+func (l *Ledger) Entries() []Entry {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entries := make([]Entry, 0, len(l.data.Entries))
+	for _, entry := range l.data.Entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].ValidatorIndex != entries[j].ValidatorIndex {
+			return entries[i].ValidatorIndex < entries[j].ValidatorIndex
+		}
+		return entries[i].Pubkey < entries[j].Pubkey
+	})
+	return entries
+}
+
+func (l *Ledger) LastPass() PassInfo {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.data.LastPass
+}
+
+func (l *Ledger) SetLastPass(pass PassInfo) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.data.LastPass = pass
+}
+
+// Record a freshly signed exit message, which stays pending until the backend acknowledges it
+func (l *Ledger) RecordSigned(pubkey string, validatorIndex uint64, exitEpoch uint64, encryptedSignature string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry := l.getOrCreate(pubkey)
+	entry.ValidatorIndex = validatorIndex
+	entry.ExitEpoch = exitEpoch
+	entry.Status = StatusPending
+	entry.SignedAt = time.Now().Unix()
+	entry.EncryptedSignature = encryptedSignature
+	entry.LastError = ""
+}
+
+// Record that the backend holds an exit message for the key
+func (l *Ledger) RecordAcknowledged(pubkey string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now().Unix()
+	entry := l.getOrCreate(pubkey)
+	if entry.Status != StatusAcknowledged {
+		entry.AcknowledgedAt = now
+	}
+	entry.Status = StatusAcknowledged
+	entry.LastAttemptAt = now
+	entry.LastError = ""
+	// the backend has it, there is no reason to keep the payload around
+	entry.EncryptedSignature = ""
+}
+
+// Record a failed attempt to hand the exit message to the backend.
+// A rejected message is dropped so the next pass signs a fresh one; a transport failure keeps it for a resend.
+func (l *Ledger) RecordFailure(pubkey string, rejected bool, reason string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry := l.getOrCreate(pubkey)
+	entry.Retries++
+	entry.LastAttemptAt = time.Now().Unix()
+	entry.LastError = reason
+	if rejected {
+		entry.Status = StatusRejected
+		entry.EncryptedSignature = ""
+	}
+}
+
+// Check whether the ledger holds a message that can be resent without signing again
+func (l *Ledger) HasPendingMessage(pubkey string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry, ok := l.data.Entries[pubkey]
+	return ok && entry.Status == StatusPending && entry.EncryptedSignature != ""
+}
+
+func (l *Ledger) getOrCreate(pubkey string) *Entry {
+	entry, ok := l.data.Entries[pubkey]
+	if !ok {
+		entry = &Entry{Pubkey: pubkey}
+		l.data.Entries[pubkey] = entry
+	}
+	return entry
+}
@@ -63,6 +63,18 @@ func RequireNodePassword(c *cli.Context) error {
 }
 
 func RequireNodeWallet(c *cli.Context) error {
+	// The key of an offline node account is on another machine, so there is no wallet here
+	if c.GlobalString("offline") != "" {
+		return nil
+	}
+	// Nor is there one for a node account that is only being watched
+	if c.GlobalString("address") != "" {
+		return nil
+	}
+	// Neither is there one when an external signer holds the node account key
+	if usesExternalNodeSigner, err := getExternalNodeSignerSet(c); err != nil || usesExternalNodeSigner {
+		return err
+	}
 	if err := RequireNodePassword(c); err != nil {
 		return err
 	}
@@ -76,6 +88,33 @@ func RequireNodeWallet(c *cli.Context) error {
 	return nil
 }
 
+// Validator keys are derived from the node wallet's mnemonic, so it is needed even when the node account key is held elsewhere
+func RequireValidatorKeys(c *cli.Context) error {
+	if err := RequireNodePassword(c); err != nil {
+		return err
+	}
+	nodeWalletInitialized, err := getNodeWalletInitialized(c)
+	if err != nil {
+		return err
+	}
+	if !nodeWalletInitialized {
+		return errors.New("The validator keys are derived from the node wallet's mnemonic, which has not been set up on this machine. Please run './stader-cli wallet init' or './stader-cli wallet recover' and try again.")
+	}
+	return nil
+}
+
+// Signing with validator keys needs either a remote signer holding them or the node wallet's mnemonic
+func RequireValidatorSigner(c *cli.Context) error {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return err
+	}
+	if cfg.RemoteSigner.GetUrl() != "" {
+		return nil
+	}
+	return RequireValidatorKeys(c)
+}
+
 func RequireEthClientSynced(c *cli.Context) error {
 	ethClientSynced, err := waitEthClientSynced(c, false, EthClientSyncTimeout)
 	if err != nil {
@@ -147,6 +186,9 @@ func WaitNodePassword(c *cli.Context, verbose bool) error {
 }
 
 func WaitNodeWallet(c *cli.Context, verbose bool) error {
+	if usesExternalNodeSigner, err := getExternalNodeSignerSet(c); err != nil || usesExternalNodeSigner {
+		return err
+	}
 	if err := WaitNodePassword(c, verbose); err != nil {
 		return err
 	}
@@ -211,6 +253,15 @@ func getNodePasswordSet(c *cli.Context) (bool, error) {
 	return pm.IsPasswordSet(), nil
 }
 
+// Check if an external signer holds the node account key
+func getExternalNodeSignerSet(c *cli.Context) (bool, error) {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return false, err
+	}
+	return cfg.NodeSigner.GetUrl() != "", nil
+}
+
 // Check if the node wallet is initialized
 func getNodeWalletInitialized(c *cli.Context) (bool, error) {
 	w, err := GetWallet(c)
@@ -0,0 +1,92 @@
+package rewards
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+)
+
+// The rewards of a single operator for a cycle
+type OperatorCycleRewards struct {
+	Sd  *big.Int
+	Eth *big.Int
+}
+
+// Build the merkle tree of a cycle and return the proofs of every operator in the same format as the Stader backend.
+// Leaves are sorted by hash, pairs are hashed sorted and an odd node is promoted to the next layer unchanged.
+func BuildSpMerkleTree(cycle int64, operatorRewards map[common.Address]OperatorCycleRewards) (common.Hash, map[common.Address]stader_backend.CycleMerkleProofs, error) {
+	if len(operatorRewards) == 0 {
+		return common.Hash{}, nil, fmt.Errorf("no operators to build the tree for cycle %d", cycle)
+	}
+
+	type leafInfo struct {
+		operator common.Address
+		hash     [32]byte
+	}
+	leaves := []leafInfo{}
+	for operator, rewards := range operatorRewards {
+		leaves = append(leaves, leafInfo{
+			operator: operator,
+			hash:     socializing_pool.ComputeMerkleLeaf(operator, rewards.Sd, rewards.Eth),
+		})
+	}
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].hash[:], leaves[j].hash[:]) < 0
+	})
+
+	// build all of the layers, the last one only holding the root
+	layers := [][][32]byte{{}}
+	for _, leaf := range leaves {
+		layers[0] = append(layers[0], leaf.hash)
+	}
+	for len(layers[len(layers)-1]) > 1 {
+		current := layers[len(layers)-1]
+		next := [][32]byte{}
+		for i := 0; i < len(current); i += 2 {
+			if i+1 == len(current) {
+				next = append(next, current[i])
+				continue
+			}
+			next = append(next, hashPair(current[i], current[i+1]))
+		}
+		layers = append(layers, next)
+	}
+	root := common.Hash(layers[len(layers)-1][0])
+
+	proofs := map[common.Address]stader_backend.CycleMerkleProofs{}
+	for index, leaf := range leaves {
+		proof := []string{}
+		position := index
+		for _, layer := range layers[:len(layers)-1] {
+			sibling := position ^ 1
+			if sibling < len(layer) {
+				proof = append(proof, common.Hash(layer[sibling]).Hex())
+			}
+			position /= 2
+		}
+
+		rewards := operatorRewards[leaf.operator]
+		proofs[leaf.operator] = stader_backend.CycleMerkleProofs{
+			Root:  root.Hex(),
+			Eth:   rewards.Eth.String(),
+			Sd:    rewards.Sd.String(),
+			Proof: proof,
+			Cycle: cycle,
+		}
+	}
+
+	return root, proofs, nil
+}
+
+func hashPair(a [32]byte, b [32]byte) [32]byte {
+	if bytes.Compare(a[:], b[:]) <= 0 {
+		return crypto.Keccak256Hash(a[:], b[:])
+	}
+	return crypto.Keccak256Hash(b[:], a[:])
+}
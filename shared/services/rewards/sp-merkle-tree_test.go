@@ -0,0 +1,152 @@
+package rewards
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+)
+
+func bigString(t *testing.T, value string) *big.Int {
+	result, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		t.Fatalf("invalid number %s", value)
+	}
+	return result
+}
+
+// Five operators, so the odd leaf is promoted up two layers. The root and proofs were computed separately
+// from the leaf encoding with sorted leaves, sorted pairs and odd nodes promoted, as the backend builds its trees.
+var fixtureRoot = common.HexToHash("0x3932e84c99b9fdcacd5b5f3eaf2e92bf04102d7c45ff8963f9bf642793c2ca49")
+
+var fixtureOperators = []struct {
+	address common.Address
+	sd      string
+	eth     string
+	proof   []string
+}{
+	{
+		address: common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		sd:      "1500000000000000000000",
+		eth:     "120000000000000000",
+		proof: []string{
+			"0x023c5d0e15ab0f8b8f0bfc8646e975fe670c9da6319f4d33b506b7abacd9d860",
+			"0xcf84b02ca298f133e60b2e6f0723afdb5e11cab3d8e5c19d89cad96044af5085",
+			"0xef86914c6345fbec6995007649f43fa789a8375db964602e1a61e6bab57ca393",
+		},
+	},
+	{
+		address: common.HexToAddress("0x2000000000000000000000000000000000000002"),
+		sd:      "0",
+		eth:     "3400000000000000000",
+		proof: []string{
+			"0x0113beccc106c687fed45a45cc6e0b47688c20097d200e64761748f0a53d9cc3",
+			"0xcf84b02ca298f133e60b2e6f0723afdb5e11cab3d8e5c19d89cad96044af5085",
+			"0xef86914c6345fbec6995007649f43fa789a8375db964602e1a61e6bab57ca393",
+		},
+	},
+	{
+		address: common.HexToAddress("0x3000000000000000000000000000000000000003"),
+		sd:      "987654321000000000000",
+		eth:     "0",
+		proof: []string{
+			"0xae48436fc8ad1a9a14a5cff97d6c3505f530659168e893546ec5d38f465b1e46",
+			"0xc043e31d58400f3ac8f0210406728c446c170db83c686603b86eecda1d3d9a25",
+			"0xef86914c6345fbec6995007649f43fa789a8375db964602e1a61e6bab57ca393",
+		},
+	},
+	{
+		address: common.HexToAddress("0x4000000000000000000000000000000000000004"),
+		sd:      "25000000000000000000",
+		eth:     "560000000000000000",
+		proof: []string{
+			"0xc30505bb8cba39ec48357a47f776111421170a64b931f24ea4945520f56ec47c",
+		},
+	},
+	{
+		address: common.HexToAddress("0x5000000000000000000000000000000000000005"),
+		sd:      "1",
+		eth:     "1",
+		proof: []string{
+			"0x7dd08189916f8b484a61f2ac611a0ff6d6b2fd5b165c0f0f7d69475b83014d4f",
+			"0xc043e31d58400f3ac8f0210406728c446c170db83c686603b86eecda1d3d9a25",
+			"0xef86914c6345fbec6995007649f43fa789a8375db964602e1a61e6bab57ca393",
+		},
+	},
+}
+
+func TestBuildSpMerkleTree(t *testing.T) {
+	operatorRewards := map[common.Address]OperatorCycleRewards{}
+	for _, operator := range fixtureOperators {
+		operatorRewards[operator.address] = OperatorCycleRewards{
+			Sd:  bigString(t, operator.sd),
+			Eth: bigString(t, operator.eth),
+		}
+	}
+
+	root, proofs, err := BuildSpMerkleTree(12, operatorRewards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != fixtureRoot {
+		t.Fatalf("expected root %s, got %s", fixtureRoot.Hex(), root.Hex())
+	}
+	if len(proofs) != len(fixtureOperators) {
+		t.Fatalf("expected %d proofs, got %d", len(fixtureOperators), len(proofs))
+	}
+
+	for _, operator := range fixtureOperators {
+		proof, ok := proofs[operator.address]
+		if !ok {
+			t.Errorf("missing proof for %s", operator.address.Hex())
+			continue
+		}
+		if proof.Cycle != 12 || proof.Root != fixtureRoot.Hex() || proof.Sd != operator.sd || proof.Eth != operator.eth {
+			t.Errorf("unexpected proof fields for %s: %+v", operator.address.Hex(), proof)
+		}
+		if len(proof.Proof) != len(operator.proof) {
+			t.Errorf("expected %d proof nodes for %s, got %d", len(operator.proof), operator.address.Hex(), len(proof.Proof))
+			continue
+		}
+		for i, node := range operator.proof {
+			if proof.Proof[i] != node {
+				t.Errorf("proof node %d of %s: expected %s, got %s", i, operator.address.Hex(), node, proof.Proof[i])
+			}
+		}
+
+		// The proof must also verify the way the Socializing Pool contract checks a claim
+		leaf := socializing_pool.ComputeMerkleLeaf(operator.address, bigString(t, operator.sd), bigString(t, operator.eth))
+		nodes := make([][32]byte, len(proof.Proof))
+		for i, node := range proof.Proof {
+			nodes[i] = common.HexToHash(node)
+		}
+		if socializing_pool.ComputeMerkleRoot(leaf, nodes) != [32]byte(fixtureRoot) {
+			t.Errorf("proof of %s does not verify against the root", operator.address.Hex())
+		}
+	}
+}
+
+func TestBuildSpMerkleTreeSingleOperator(t *testing.T) {
+	operator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	root, proofs, err := BuildSpMerkleTree(3, map[common.Address]OperatorCycleRewards{
+		operator: {Sd: big.NewInt(10), Eth: big.NewInt(20)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaf := socializing_pool.ComputeMerkleLeaf(operator, big.NewInt(10), big.NewInt(20))
+	if root != common.Hash(leaf) {
+		t.Errorf("expected the root to be the only leaf, got %s", root.Hex())
+	}
+	if len(proofs[operator].Proof) != 0 {
+		t.Errorf("expected an empty proof, got %v", proofs[operator].Proof)
+	}
+}
+
+func TestBuildSpMerkleTreeNoOperators(t *testing.T) {
+	if _, _, err := BuildSpMerkleTree(3, map[common.Address]OperatorCycleRewards{}); err == nil {
+		t.Error("expected an error for a cycle without operators")
+	}
+}
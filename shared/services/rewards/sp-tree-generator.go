@@ -0,0 +1,360 @@
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stader-labs/stader-node/shared/services"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+	"github.com/urfave/cli"
+)
+
+// Config
+const (
+	permissionlessPoolId uint8 = 1
+
+	// Largest block range requested in a single eth_getLogs call
+	logChunkSize uint64 = 10000
+)
+
+// A Socializing Pool rewards tree rebuilt from chain data.
+// The split is an approximation of the backend's distribution rules, which aren't published,
+// so it can differ from the reported tree and only serves to sanity check the downloaded proofs.
+type SpRewardsTree struct {
+	Cycle              int64                                               `json:"cycle"`
+	StartBlock         uint64                                              `json:"startBlock"`
+	EndBlock           uint64                                              `json:"endBlock"`
+	Approximation      bool                                                `json:"approximation"`
+	Root               common.Hash                                         `json:"root"`
+	OnChainRoot        common.Hash                                         `json:"onChainRoot"`
+	OperatorEthRewards *big.Int                                            `json:"operatorEthRewards"`
+	OperatorSdRewards  *big.Int                                            `json:"operatorSdRewards"`
+	Proofs             map[common.Address]stader_backend.CycleMerkleProofs `json:"proofs"`
+}
+
+// A range of blocks, both ends included
+type blockWindow struct {
+	from uint64
+	to   uint64
+}
+
+// The Socializing Pool participation of an operator during a cycle
+type operatorParticipation struct {
+	address         common.Address
+	validatorBlocks *big.Int
+	sdWeight        *big.Int
+}
+
+// Rebuilds Socializing Pool rewards trees from archive EC data
+type SpTreeGenerator struct {
+	ec  stader.ExecutionClient
+	log *log.ColorLogger
+	pnr *stader.PermissionlessNodeRegistryContractManager
+	sdc *stader.SdCollateralContractManager
+	sp  *stader.SocializingPoolContractManager
+}
+
+// Create a new tree generator. The execution client should have archive access for cycles older than its pruning window
+func NewSpTreeGenerator(c *cli.Context, ec stader.ExecutionClient, logger *log.ColorLogger) (*SpTreeGenerator, error) {
+	pnrAddress, err := services.GetPermissionlessNodeRegistryAddress(c)
+	if err != nil {
+		return nil, err
+	}
+	sdcAddress, err := services.GetSdCollateralAddress(c)
+	if err != nil {
+		return nil, err
+	}
+	spAddress, err := services.GetSocializingPoolAddress(c)
+	if err != nil {
+		return nil, err
+	}
+
+	pnr, err := stader.NewPermissionlessNodeRegistry(ec, pnrAddress)
+	if err != nil {
+		return nil, err
+	}
+	sdc, err := stader.NewSdCollateralContract(ec, sdcAddress)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := stader.NewSocializingPool(ec, spAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SpTreeGenerator{
+		ec:  ec,
+		log: logger,
+		pnr: pnr,
+		sdc: sdc,
+		sp:  sp,
+	}, nil
+}
+
+// Rebuild an approximate rewards tree of a reported cycle.
+// The operator ETH and SD totals reported on chain for the cycle are split between the operators that were in the Socializing Pool:
+// ETH pro rata to the number of blocks each of their deposited validators spent in the pool during the cycle,
+// and SD pro rata to their reward eligible SD stake at the end of the cycle weighted by the number of blocks they spent in the pool.
+// These weights are not taken from the backend, so the root only matches the reported one when they happen to agree.
+func (g *SpTreeGenerator) GenerateTree(cycle int64) (*SpRewardsTree, error) {
+	cycleIndex := big.NewInt(cycle)
+
+	rewardsData, err := socializing_pool.GetCycleRewardsData(g.sp, cycleIndex, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get rewards data for cycle %d: %w", cycle, err)
+	}
+	if rewardsData.MerkleRoot == [32]byte{} {
+		return nil, fmt.Errorf("cycle %d has not been reported on chain yet", cycle)
+	}
+
+	cycleDetails, err := socializing_pool.GetRewardCycleDetails(g.sp, cycleIndex, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get details of cycle %d: %w", cycle, err)
+	}
+	startBlock := cycleDetails.StartBlock.Uint64()
+	// cycle 1 starts at block 1 in the socializing pool contract
+	if cycle == 1 {
+		initialBlock, err := socializing_pool.GetSocializingPoolInitialBlock(g.sp, nil)
+		if err != nil {
+			return nil, err
+		}
+		startBlock = initialBlock.Uint64()
+	}
+	endBlock := cycleDetails.EndBlock.Uint64()
+	if endBlock < startBlock {
+		return nil, fmt.Errorf("cycle %d ends at block %d before it starts at block %d", cycle, endBlock, startBlock)
+	}
+
+	g.logLine("Generating the tree of cycle %d (blocks %d to %d)", cycle, startBlock, endBlock)
+
+	start := time.Now()
+	participations, err := g.getOperatorParticipations(startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+	g.logLine("Found %d operators in the Socializing Pool during the cycle (total time: %s)", len(participations), time.Since(start))
+
+	totalValidatorBlocks := big.NewInt(0)
+	totalSdWeight := big.NewInt(0)
+	for _, participation := range participations {
+		totalValidatorBlocks.Add(totalValidatorBlocks, participation.validatorBlocks)
+		totalSdWeight.Add(totalSdWeight, participation.sdWeight)
+	}
+
+	operatorRewards := map[common.Address]OperatorCycleRewards{}
+	for _, participation := range participations {
+		operatorRewards[participation.address] = OperatorCycleRewards{
+			Eth: proRata(rewardsData.OperatorETHRewards, participation.validatorBlocks, totalValidatorBlocks),
+			Sd:  proRata(rewardsData.OperatorSDRewards, participation.sdWeight, totalSdWeight),
+		}
+	}
+
+	root, proofs, err := BuildSpMerkleTree(cycle, operatorRewards)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SpRewardsTree{
+		Cycle:              cycle,
+		StartBlock:         startBlock,
+		EndBlock:           endBlock,
+		Approximation:      true,
+		Root:               root,
+		OnChainRoot:        common.Hash(rewardsData.MerkleRoot),
+		OperatorEthRewards: rewardsData.OperatorETHRewards,
+		OperatorSdRewards:  rewardsData.OperatorSDRewards,
+		Proofs:             proofs,
+	}, nil
+}
+
+// Get the weights of every operator which spent part of the cycle in the Socializing Pool
+func (g *SpTreeGenerator) getOperatorParticipations(startBlock uint64, endBlock uint64) ([]operatorParticipation, error) {
+	endOpts := &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(endBlock)}
+
+	nextOperatorId, err := node.GetNextOperatorId(g.pnr, endOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	stateChanges, err := g.getSocializingPoolStateChanges(startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	participations := []operatorParticipation{}
+	for i := int64(1); i < nextOperatorId.Int64(); i++ {
+		operatorId := big.NewInt(i)
+		operatorInfo, err := node.GetOperatorInfo(g.pnr, operatorId, endOpts)
+		if err != nil {
+			return nil, fmt.Errorf("could not get info of operator %d: %w", i, err)
+		}
+
+		// The state at the start of the cycle is the one of the block before it
+		optedInAtStart := operatorInfo.OptedForSocializingPool
+		if len(stateChanges[uint64(i)]) > 0 && startBlock > 0 {
+			startInfo, err := node.GetOperatorInfo(g.pnr, operatorId, &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(startBlock - 1)})
+			if err != nil {
+				return nil, fmt.Errorf("could not get info of operator %d at block %d: %w", i, startBlock-1, err)
+			}
+			optedInAtStart = startInfo.OptedForSocializingPool
+		}
+		windows := getSocializingPoolWindows(optedInAtStart, stateChanges[uint64(i)], startBlock, endBlock)
+		if len(windows) == 0 {
+			continue
+		}
+
+		validators, err := node.GetAllValidatorsInfoByOperator(g.pnr, operatorInfo.OperatorAddress, endOpts)
+		if err != nil {
+			return nil, fmt.Errorf("could not get validators of operator %d: %w", i, err)
+		}
+
+		validatorBlocks := big.NewInt(0)
+		depositedValidators := int64(0)
+		for _, validator := range validators {
+			if validator.Status != types.ValidatorStatusDeposited && validator.Status != types.ValidatorStatusWithdrawn {
+				continue
+			}
+			if validator.Status == types.ValidatorStatusDeposited {
+				depositedValidators++
+			}
+
+			active := blockWindow{from: 0, to: endBlock}
+			if validator.DepositBlock != nil {
+				active.from = validator.DepositBlock.Uint64()
+			}
+			if validator.WithdrawnBlock != nil && validator.WithdrawnBlock.Sign() > 0 {
+				active.to = validator.WithdrawnBlock.Uint64() - 1
+			}
+			validatorBlocks.Add(validatorBlocks, new(big.Int).SetUint64(overlap(windows, active)))
+		}
+
+		sdBalance, err := sd_collateral.GetOperatorSdBalance(g.sdc, operatorInfo.OperatorAddress, endOpts)
+		if err != nil {
+			return nil, err
+		}
+		rewardEligibleSd, err := sd_collateral.RewardEligibleSD(g.sdc, permissionlessPoolId, big.NewInt(depositedValidators), endOpts)
+		if err != nil {
+			return nil, err
+		}
+		if sdBalance.Cmp(rewardEligibleSd) > 0 {
+			sdBalance = rewardEligibleSd
+		}
+		sdWeight := new(big.Int).Mul(sdBalance, new(big.Int).SetUint64(overlap(windows, blockWindow{from: startBlock, to: endBlock})))
+
+		participations = append(participations, operatorParticipation{
+			address:         operatorInfo.OperatorAddress,
+			validatorBlocks: validatorBlocks,
+			sdWeight:        sdWeight,
+		})
+	}
+
+	return participations, nil
+}
+
+// Get the Socializing Pool opt-ins and opt-outs of the cycle by operator ID, in block order
+func (g *SpTreeGenerator) getSocializingPoolStateChanges(startBlock uint64, endBlock uint64) (map[uint64][]socializingPoolStateChange, error) {
+	changes := map[uint64][]socializingPoolStateChange{}
+	for from := startBlock; from <= endBlock; from += logChunkSize {
+		to := from + logChunkSize - 1
+		if to > endBlock {
+			to = endBlock
+		}
+		iterator, err := g.pnr.PermissionlessNodeRegistry.FilterUpdatedSocializingPoolState(&bind.FilterOpts{Start: from, End: &to})
+		if err != nil {
+			return nil, fmt.Errorf("could not get Socializing Pool state changes of blocks %d to %d: %w", from, to, err)
+		}
+		for iterator.Next() {
+			event := iterator.Event
+			operatorId := event.OperatorId.Uint64()
+			changes[operatorId] = append(changes[operatorId], socializingPoolStateChange{
+				block:   event.Raw.BlockNumber,
+				optedIn: event.OptedForSocializingPool,
+			})
+		}
+		err = iterator.Error()
+		iterator.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read Socializing Pool state changes of blocks %d to %d: %w", from, to, err)
+		}
+	}
+
+	for _, operatorChanges := range changes {
+		sort.SliceStable(operatorChanges, func(i, j int) bool {
+			return operatorChanges[i].block < operatorChanges[j].block
+		})
+	}
+	return changes, nil
+}
+
+// An operator joining or leaving the Socializing Pool
+type socializingPoolStateChange struct {
+	block   uint64
+	optedIn bool
+}
+
+// Get the ranges of blocks of the cycle the operator spent in the Socializing Pool. An operator is in the pool from
+// the block it opts in, and out of it from the block it opts out.
+func getSocializingPoolWindows(optedInAtStart bool, changes []socializingPoolStateChange, startBlock uint64, endBlock uint64) []blockWindow {
+	windows := []blockWindow{}
+	inSp := optedInAtStart
+	from := startBlock
+	for _, change := range changes {
+		if change.block < startBlock || change.block > endBlock || change.optedIn == inSp {
+			continue
+		}
+		if change.optedIn {
+			from = change.block
+		} else if change.block > from {
+			windows = append(windows, blockWindow{from: from, to: change.block - 1})
+		}
+		inSp = change.optedIn
+	}
+	if inSp {
+		windows = append(windows, blockWindow{from: from, to: endBlock})
+	}
+	return windows
+}
+
+// Count the blocks of the windows that are also in the given range
+func overlap(windows []blockWindow, active blockWindow) uint64 {
+	count := uint64(0)
+	for _, window := range windows {
+		from := window.from
+		if active.from > from {
+			from = active.from
+		}
+		to := window.to
+		if active.to < to {
+			to = active.to
+		}
+		if to >= from {
+			count += to - from + 1
+		}
+	}
+	return count
+}
+
+// Logs a line if the logger is specified
+func (g *SpTreeGenerator) logLine(format string, v ...interface{}) {
+	if g.log != nil {
+		g.log.Printlnf(format, v...)
+	}
+}
+
+func proRata(total *big.Int, weight *big.Int, totalWeight *big.Int) *big.Int {
+	if totalWeight.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	share := new(big.Int).Mul(total, weight)
+	return share.Div(share, totalWeight)
+}
@@ -0,0 +1,112 @@
+package rewards
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestProRata(t *testing.T) {
+	tests := []struct {
+		name        string
+		total       *big.Int
+		weight      *big.Int
+		totalWeight *big.Int
+		expected    *big.Int
+	}{
+		{"only operator", big.NewInt(1000), big.NewInt(7), big.NewInt(7), big.NewInt(1000)},
+		{"even split", big.NewInt(1000), big.NewInt(1), big.NewInt(4), big.NewInt(250)},
+		{"rounded down", big.NewInt(1000), big.NewInt(1), big.NewInt(3), big.NewInt(333)},
+		{"no weight", big.NewInt(1000), big.NewInt(0), big.NewInt(3), big.NewInt(0)},
+		{"no total weight", big.NewInt(1000), big.NewInt(0), big.NewInt(0), big.NewInt(0)},
+		{"no rewards", big.NewInt(0), big.NewInt(2), big.NewInt(3), big.NewInt(0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if share := proRata(test.total, test.weight, test.totalWeight); share.Cmp(test.expected) != 0 {
+				t.Errorf("expected a share of %s, got %s", test.expected, share)
+			}
+		})
+	}
+}
+
+func TestProRataNeverExceedsTotal(t *testing.T) {
+	// Validator blocks of three operators over a cycle
+	total := new(big.Int).Mul(big.NewInt(123456789), big.NewInt(1e12))
+	weights := []*big.Int{big.NewInt(50400), big.NewInt(100799), big.NewInt(3)}
+	totalWeight := big.NewInt(0)
+	for _, weight := range weights {
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	sum := big.NewInt(0)
+	for _, weight := range weights {
+		sum.Add(sum, proRata(total, weight, totalWeight))
+	}
+
+	// Rounding leaves less than one wei per operator in the pool
+	dust := new(big.Int).Sub(total, sum)
+	if dust.Sign() < 0 || dust.Cmp(big.NewInt(int64(len(weights)))) >= 0 {
+		t.Errorf("expected the shares to add up to the total within %d wei, got %s of %s", len(weights), sum, total)
+	}
+	if total.Cmp(new(big.Int).Mul(big.NewInt(123456789), big.NewInt(1e12))) != 0 {
+		t.Error("the total should not be changed")
+	}
+}
+
+func TestGetSocializingPoolWindows(t *testing.T) {
+	tests := []struct {
+		name           string
+		optedInAtStart bool
+		changes        []socializingPoolStateChange
+		expected       []blockWindow
+	}{
+		{"in for the whole cycle", true, nil, []blockWindow{{100, 199}}},
+		{"out for the whole cycle", false, nil, []blockWindow{}},
+		{"opted in", false, []socializingPoolStateChange{{150, true}}, []blockWindow{{150, 199}}},
+		{"opted out", true, []socializingPoolStateChange{{150, false}}, []blockWindow{{100, 149}}},
+		{"opted out and back in", true, []socializingPoolStateChange{{120, false}, {180, true}}, []blockWindow{{100, 119}, {180, 199}}},
+		{"opted in and back out", false, []socializingPoolStateChange{{120, true}, {180, false}}, []blockWindow{{120, 179}}},
+		{"toggled three times", false, []socializingPoolStateChange{{110, true}, {130, false}, {170, true}}, []blockWindow{{110, 129}, {170, 199}}},
+		{"opted out on the first block", true, []socializingPoolStateChange{{100, false}}, []blockWindow{}},
+		{"repeated state", true, []socializingPoolStateChange{{120, true}, {150, false}}, []blockWindow{{100, 149}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			windows := getSocializingPoolWindows(test.optedInAtStart, test.changes, 100, 199)
+			if len(windows) != len(test.expected) {
+				t.Fatalf("expected windows %v, got %v", test.expected, windows)
+			}
+			for i := range windows {
+				if windows[i] != test.expected[i] {
+					t.Errorf("expected windows %v, got %v", test.expected, windows)
+				}
+			}
+		})
+	}
+}
+
+func TestOverlap(t *testing.T) {
+	windows := []blockWindow{{100, 119}, {180, 199}}
+
+	tests := []struct {
+		name     string
+		active   blockWindow
+		expected uint64
+	}{
+		{"whole cycle", blockWindow{0, 199}, 40},
+		{"deposited mid cycle", blockWindow{110, 199}, 30},
+		{"withdrawn mid cycle", blockWindow{0, 184}, 25},
+		{"between windows", blockWindow{130, 170}, 0},
+		{"single block", blockWindow{119, 119}, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if count := overlap(windows, test.active); count != test.expected {
+				t.Errorf("expected %d blocks, got %d", test.expected, count)
+			}
+		})
+	}
+}
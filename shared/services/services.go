@@ -26,6 +26,7 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
 
 	"github.com/docker/docker/client"
@@ -35,6 +36,9 @@ import (
 
 	"github.com/stader-labs/stader-node/shared/services/config"
 	"github.com/stader-labs/stader-node/shared/services/passwords"
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	"github.com/stader-labs/stader-node/shared/services/txbundle"
+	"github.com/stader-labs/stader-node/shared/services/txjournal"
 	"github.com/stader-labs/stader-node/shared/services/wallet"
 	lhkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/lighthouse"
 
@@ -42,6 +46,7 @@ import (
 	nmkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/teku"
+	w3skeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/web3signer"
 	staderUtils "github.com/stader-labs/stader-node/shared/utils/stdr"
 )
 
@@ -93,6 +98,22 @@ func GetWallet(c *cli.Context) (*wallet.Wallet, error) {
 	return getWallet(c, cfg, pm)
 }
 
+// Get the signer for validator keys: the remote signer if one is configured, otherwise the node wallet
+func GetValidatorSigner(c *cli.Context) (signer.Signer, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	if remoteSignerUrl := cfg.RemoteSigner.GetUrl(); remoteSignerUrl != "" {
+		return signer.NewWeb3Signer(remoteSignerUrl), nil
+	}
+	w, err := getWallet(c, cfg, getPasswordManager(cfg))
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewWalletSigner(w), nil
+}
+
 func GetEthClient(c *cli.Context) (*ExecutionClientManager, error) {
 	cfg, err := getConfig(c)
 	if err != nil {
@@ -105,6 +126,35 @@ func GetEthClient(c *cli.Context) (*ExecutionClientManager, error) {
 	return ec, nil
 }
 
+// Get the journal of the transactions sent by the node wallet
+func GetTxJournal(c *cli.Context) (*txjournal.Journal, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return txjournal.NewJournal(cfg.StaderNode.GetTxJournalFolder(true)), nil
+}
+
+// Get a client for the archive EC if one is configured, otherwise the regular EC manager
+func GetArchiveEthClient(c *cli.Context) (stader.ExecutionClient, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveEcUrl, _ := cfg.StaderNode.ArchiveECUrl.Value.(string)
+	if archiveEcUrl == "" {
+		return getEthClient(c, cfg)
+	}
+
+	ec, err := ethclient.Dial(archiveEcUrl)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the archive EC at %s: %w", archiveEcUrl, err)
+	}
+
+	return ec, nil
+}
+
 func GetStaderConfigContract(c *cli.Context) (*stader.StaderConfigContractManager, error) {
 	cfg, err := getConfig(c)
 	if err != nil {
@@ -516,18 +566,24 @@ func getWallet(c *cli.Context, cfg *config.StaderConfig, pm *passwords.PasswordM
 
 		validatorPath := os.ExpandEnv(cfg.StaderNode.GetValidatorKeychainPath())
 
-		// Keystores in validator
-		lighthouseKeystore := lhkeystore.NewKeystore(validatorPath, pm)
-		nimbusKeystore := nmkeystore.NewKeystore(validatorPath, pm)
-		prysmKeystore := prkeystore.NewKeystore(validatorPath, pm)
-		tekuKeystore := tkkeystore.NewKeystore(validatorPath, pm)
-		lodestarKeystore := lokeystore.NewKeystore(validatorPath, pm)
-
-		nodeWallet.AddKeystore("lighthouse", lighthouseKeystore)
-		nodeWallet.AddKeystore("nimbus", nimbusKeystore)
-		nodeWallet.AddKeystore("prysm", prysmKeystore)
-		nodeWallet.AddKeystore("teku", tekuKeystore)
-		nodeWallet.AddKeystore("lodestar", lodestarKeystore)
+		if remoteSignerUrl := cfg.RemoteSigner.GetUrl(); remoteSignerUrl != "" {
+			// Validator keys go to the remote signer, which the validator client signs through
+			importKeys, _ := cfg.RemoteSigner.ImportKeys.Value.(bool)
+			nodeWallet.AddKeystore("web3signer", w3skeystore.NewKeystore(validatorPath, signer.NewWeb3Signer(remoteSignerUrl), importKeys))
+		} else {
+			// Keystores in validator
+			lighthouseKeystore := lhkeystore.NewKeystore(validatorPath, pm)
+			nimbusKeystore := nmkeystore.NewKeystore(validatorPath, pm)
+			prysmKeystore := prkeystore.NewKeystore(validatorPath, pm)
+			tekuKeystore := tkkeystore.NewKeystore(validatorPath, pm)
+			lodestarKeystore := lokeystore.NewKeystore(validatorPath, pm)
+
+			nodeWallet.AddKeystore("lighthouse", lighthouseKeystore)
+			nodeWallet.AddKeystore("nimbus", nimbusKeystore)
+			nodeWallet.AddKeystore("prysm", prysmKeystore)
+			nodeWallet.AddKeystore("teku", tekuKeystore)
+			nodeWallet.AddKeystore("lodestar", lodestarKeystore)
+		}
 
 		presignPath := os.ExpandEnv(cfg.StaderNode.GetPresignKeychainPath())
 
@@ -543,6 +599,38 @@ func getWallet(c *cli.Context, cfg *config.StaderConfig, pm *passwords.PasswordM
 		nodeWallet.AddPresignKeystore("prysm", prysmPresignKeystore)
 		nodeWallet.AddPresignKeystore("teku", tekuPresignKeystore)
 		nodeWallet.AddPresignKeystore("lodestar", lodestarPresignKeystore)
+
+		// Have the external signer sign for the node account if one is configured
+		if nodeSignerUrl := cfg.NodeSigner.GetUrl(); nodeSignerUrl != "" {
+			var nodeAddress *common.Address
+			if addressString, _ := cfg.NodeSigner.Address.Value.(string); addressString != "" {
+				if !common.IsHexAddress(addressString) {
+					err = fmt.Errorf("invalid external signer node address '%s'", addressString)
+					return
+				}
+				address := common.HexToAddress(addressString)
+				nodeAddress = &address
+			}
+			nodeWallet.SetExternalNodeSigner(nodeSignerUrl, nodeAddress)
+		}
+
+		// Use the offline node account when the CLI is exporting transactions for it
+		if offlineAddress := c.GlobalString("offline"); offlineAddress != "" {
+			if !common.IsHexAddress(offlineAddress) {
+				err = fmt.Errorf("invalid offline node address '%s'", offlineAddress)
+				return
+			}
+			nodeWallet.SetOfflineNodeAccount(common.HexToAddress(offlineAddress))
+		}
+
+		// Watch the given node account without its keys
+		if watchAddress := c.GlobalString("address"); watchAddress != "" {
+			if !common.IsHexAddress(watchAddress) {
+				err = fmt.Errorf("invalid watched node address '%s'", watchAddress)
+				return
+			}
+			nodeWallet.SetWatchOnlyNodeAccount(common.HexToAddress(watchAddress))
+		}
 	})
 	return nodeWallet, err
 }
@@ -560,6 +648,10 @@ func getEthClient(c *cli.Context, cfg *config.StaderConfig) (*ExecutionClientMan
 			if c.GlobalBool("force-fallbacks") {
 				ecManager.primaryReady = false
 			}
+			ecManager.SetTxJournal(txjournal.NewJournal(cfg.StaderNode.GetTxJournalFolder(true)))
+			if offlineAddress := c.GlobalString("offline"); offlineAddress != "" {
+				ecManager.SetTxExporter(txbundle.NewExporter(cfg.StaderNode.GetOfflineBundlePath(true), big.NewInt(int64(cfg.StaderNode.GetChainID())), common.HexToAddress(offlineAddress)))
+			}
 		}
 	})
 	return ecManager, err
@@ -0,0 +1,75 @@
+package signer
+
+import (
+	"fmt"
+
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Signs in-process with validator keys held in memory
+type LocalSigner struct {
+	getKey func(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
+}
+
+// Create a signer for the validator keys of the node wallet
+func NewWalletSigner(w *wallet.Wallet) *LocalSigner {
+	return &LocalSigner{
+		getKey: w.GetValidatorKeyByPubkey,
+	}
+}
+
+// Create a signer for the given validator keys only
+func NewKeySigner(keys ...*eth2types.BLSPrivateKey) *LocalSigner {
+	keysByPubkey := map[types.ValidatorPubkey]*eth2types.BLSPrivateKey{}
+	for _, key := range keys {
+		keysByPubkey[types.BytesToValidatorPubkey(key.PublicKey().Marshal())] = key
+	}
+	return &LocalSigner{
+		getKey: func(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+			key, ok := keysByPubkey[pubkey]
+			if !ok {
+				return nil, fmt.Errorf("validator %s key not found", pubkey.Hex())
+			}
+			return key, nil
+		},
+	}
+}
+
+// Check which of the given validators the signer holds keys for
+func (s *LocalSigner) HasKeys(pubkeys []types.ValidatorPubkey) (map[types.ValidatorPubkey]bool, error) {
+	hasKeys := map[types.ValidatorPubkey]bool{}
+	for _, pubkey := range pubkeys {
+		_, err := s.getKey(pubkey)
+		hasKeys[pubkey] = err == nil
+	}
+	return hasKeys, nil
+}
+
+// Sign the deposit of a validator
+func (s *LocalSigner) SignDeposit(request DepositRequest) (types.ValidatorSignature, error) {
+	signingRoot, err := request.SigningRoot()
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	return s.sign(request.Pubkey, signingRoot)
+}
+
+// Sign the voluntary exit of a validator
+func (s *LocalSigner) SignVoluntaryExit(request VoluntaryExitRequest) (types.ValidatorSignature, error) {
+	signingRoot, err := request.SigningRoot()
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	return s.sign(request.Pubkey, signingRoot)
+}
+
+func (s *LocalSigner) sign(pubkey types.ValidatorPubkey, signingRoot [32]byte) (types.ValidatorSignature, error) {
+	key, err := s.getKey(pubkey)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	return types.BytesToValidatorSignature(key.Sign(signingRoot[:]).Marshal()), nil
+}
@@ -0,0 +1,112 @@
+package signer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/stader-labs/stader-node/shared/types/eth2"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Signs messages with validator keys, either with the keys of the node wallet or through a remote signer
+type Signer interface {
+	HasKeys(pubkeys []types.ValidatorPubkey) (map[types.ValidatorPubkey]bool, error)
+	SignDeposit(request DepositRequest) (types.ValidatorSignature, error)
+	SignVoluntaryExit(request VoluntaryExitRequest) (types.ValidatorSignature, error)
+}
+
+// The fork a message is signed for
+type ForkInfo struct {
+	Version               []byte
+	GenesisValidatorsRoot []byte
+}
+
+// A deposit of a validator to sign
+type DepositRequest struct {
+	Pubkey                types.ValidatorPubkey
+	WithdrawalCredentials common.Hash
+	Amount                uint64
+	GenesisForkVersion    []byte
+}
+
+// A voluntary exit of a validator to sign
+type VoluntaryExitRequest struct {
+	Pubkey         types.ValidatorPubkey
+	ValidatorIndex uint64
+	Epoch          uint64
+	ForkInfo       ForkInfo
+}
+
+// Get the root a deposit signature is made over
+func (r DepositRequest) SigningRoot() ([32]byte, error) {
+	dd := eth2.DepositDataNoSignature{
+		PublicKey:             r.Pubkey.Bytes(),
+		WithdrawalCredentials: r.WithdrawalCredentials[:],
+		Amount:                r.Amount,
+	}
+	or, err := dd.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not get deposit data root: %w", err)
+	}
+
+	// Deposits are valid on every fork, so they are signed without a genesis validators root
+	return getSigningRoot(or, eth2types.Domain(eth2types.DomainDeposit, r.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot))
+}
+
+// Get the root a voluntary exit signature is made over
+func (r VoluntaryExitRequest) SigningRoot() ([32]byte, error) {
+	exitMessage := eth2.VoluntaryExit{
+		Epoch:          r.Epoch,
+		ValidatorIndex: r.ValidatorIndex,
+	}
+	or, err := exitMessage.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not get voluntary exit root: %w", err)
+	}
+	return getSigningRoot(or, eth2types.Domain(eth2types.DomainVoluntaryExit, r.ForkInfo.Version, r.ForkInfo.GenesisValidatorsRoot))
+}
+
+func getSigningRoot(objectRoot [32]byte, domain []byte) ([32]byte, error) {
+	sr := eth2.SigningRoot{
+		ObjectRoot: objectRoot[:],
+		Domain:     domain,
+	}
+	srHash, err := sr.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not get signing root: %w", err)
+	}
+	return srHash, nil
+}
+
+// Check a signature was made by a validator over a signing root
+func VerifySignature(pubkey types.ValidatorPubkey, signingRoot [32]byte, signature types.ValidatorSignature) error {
+	if err := initializeBLS(); err != nil {
+		return fmt.Errorf("could not initialize BLS library: %w", err)
+	}
+	blsPubkey, err := eth2types.BLSPublicKeyFromBytes(pubkey.Bytes())
+	if err != nil {
+		return fmt.Errorf("invalid validator pubkey %s: %w", pubkey.Hex(), err)
+	}
+	blsSignature, err := eth2types.BLSSignatureFromBytes(signature.Bytes())
+	if err != nil {
+		return fmt.Errorf("invalid signature for validator %s: %w", pubkey.Hex(), err)
+	}
+	if !blsSignature.Verify(signingRoot[:], blsPubkey) {
+		return fmt.Errorf("signature for validator %s does not match its pubkey", pubkey.Hex())
+	}
+	return nil
+}
+
+// Initialize BLS support
+var initBLS sync.Once
+
+func initializeBLS() error {
+	var err error
+	initBLS.Do(func() {
+		err = eth2types.InitBLS()
+	})
+	return err
+}
@@ -0,0 +1,242 @@
+// Package signertest provides a local stand-in for a Web3Signer-compatible remote signer, for tests.
+package signertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// A remote signer serving the eth2 signing and key manager APIs over HTTP with keys held in memory.
+// Like Web3Signer, it works out the signing root from the message itself and refuses requests whose signingRoot differs.
+type Server struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	keys      map[types.ValidatorPubkey]*eth2types.BLSPrivateKey
+	decryptor *eth2ks.Encryptor
+}
+
+// Start a stand-in remote signer holding the given keys; close it when done
+func NewServer(keys ...*eth2types.BLSPrivateKey) *Server {
+	s := &Server{
+		keys:      map[types.ValidatorPubkey]*eth2types.BLSPrivateKey{},
+		decryptor: eth2ks.New(),
+	}
+	for _, key := range keys {
+		s.AddKey(key)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(signer.Web3SignerUpcheckPath, s.upcheck)
+	mux.HandleFunc(signer.Web3SignerPublicKeysPath, s.getPublicKeys)
+	mux.HandleFunc(strings.TrimSuffix(signer.Web3SignerSignPath, "%s"), s.sign)
+	mux.HandleFunc(signer.Web3SignerKeystoresPath, s.importKeystores)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Add a key to the signer
+func (s *Server) AddKey(key *eth2types.BLSPrivateKey) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keys[types.BytesToValidatorPubkey(key.PublicKey().Marshal())] = key
+}
+
+// Check whether the signer holds the key of a validator
+func (s *Server) HasKey(pubkey types.ValidatorPubkey) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.keys[pubkey]
+	return ok
+}
+
+func (s *Server) getKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	key, ok := s.keys[pubkey]
+	return key, ok
+}
+
+func (s *Server) upcheck(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte("OK"))
+}
+
+func (s *Server) getPublicKeys(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	pubkeys := []string{}
+	for pubkey := range s.keys {
+		pubkeys = append(pubkeys, hexutil.Encode(pubkey.Bytes()))
+	}
+	s.lock.Unlock()
+	writeJson(w, pubkeys)
+}
+
+func (s *Server) sign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	pubkey, err := types.HexToValidatorPubkey(strings.TrimPrefix(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], "0x"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	key, ok := s.getKey(pubkey)
+	if !ok {
+		http.Error(w, "public key not found", http.StatusNotFound)
+		return
+	}
+
+	var request signer.Web3SignerSignRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	signingRoot, err := getSigningRoot(pubkey, request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if request.SigningRoot != "" && request.SigningRoot != hexutil.Encode(signingRoot[:]) {
+		http.Error(w, "signing root does not match the message", http.StatusBadRequest)
+		return
+	}
+
+	writeJson(w, signer.Web3SignerSignResponse{
+		Signature: hexutil.Encode(key.Sign(signingRoot[:]).Marshal()),
+	})
+}
+
+// Work out the signing root of a message the way the remote signer does
+func getSigningRoot(pubkey types.ValidatorPubkey, request signer.Web3SignerSignRequest) ([32]byte, error) {
+	switch request.Type {
+	case signer.Web3SignerDepositType:
+		if request.Deposit == nil {
+			return [32]byte{}, fmt.Errorf("missing deposit")
+		}
+		if request.Deposit.Pubkey != hexutil.Encode(pubkey.Bytes()) {
+			return [32]byte{}, fmt.Errorf("deposit is for another validator")
+		}
+		withdrawalCredentials, err := hexutil.Decode(request.Deposit.WithdrawalCredentials)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		amount, err := strconv.ParseUint(request.Deposit.Amount, 10, 64)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		genesisForkVersion, err := hexutil.Decode(request.Deposit.GenesisForkVersion)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		return signer.DepositRequest{
+			Pubkey:                pubkey,
+			WithdrawalCredentials: common.BytesToHash(withdrawalCredentials),
+			Amount:                amount,
+			GenesisForkVersion:    genesisForkVersion,
+		}.SigningRoot()
+
+	case signer.Web3SignerVoluntaryExitType:
+		if request.VoluntaryExit == nil || request.ForkInfo == nil {
+			return [32]byte{}, fmt.Errorf("missing voluntary exit or fork info")
+		}
+		epoch, err := strconv.ParseUint(request.VoluntaryExit.Epoch, 10, 64)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		validatorIndex, err := strconv.ParseUint(request.VoluntaryExit.ValidatorIndex, 10, 64)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		forkEpoch, err := strconv.ParseUint(request.ForkInfo.Fork.Epoch, 10, 64)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		version := request.ForkInfo.Fork.CurrentVersion
+		if epoch < forkEpoch {
+			version = request.ForkInfo.Fork.PreviousVersion
+		}
+		forkVersion, err := hexutil.Decode(version)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		genesisValidatorsRoot, err := hexutil.Decode(request.ForkInfo.GenesisValidatorsRoot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		return signer.VoluntaryExitRequest{
+			Pubkey:         pubkey,
+			ValidatorIndex: validatorIndex,
+			Epoch:          epoch,
+			ForkInfo: signer.ForkInfo{
+				Version:               forkVersion,
+				GenesisValidatorsRoot: genesisValidatorsRoot,
+			},
+		}.SigningRoot()
+
+	default:
+		return [32]byte{}, fmt.Errorf("unsupported signing type %s", request.Type)
+	}
+}
+
+func (s *Server) importKeystores(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var request signer.Web3SignerImportRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(request.Keystores) != len(request.Passwords) {
+		http.Error(w, "keystores and passwords differ in length", http.StatusBadRequest)
+		return
+	}
+
+	response := signer.Web3SignerImportResponse{}
+	for i, keystore := range request.Keystores {
+		response.Data = append(response.Data, s.importKeystore(keystore, request.Passwords[i]))
+	}
+	writeJson(w, response)
+}
+
+func (s *Server) importKeystore(keystore string, password string) signer.Web3SignerImportStatus {
+	var encrypted struct {
+		Crypto map[string]interface{} `json:"crypto"`
+	}
+	if err := json.Unmarshal([]byte(keystore), &encrypted); err != nil {
+		return signer.Web3SignerImportStatus{Status: "error", Message: err.Error()}
+	}
+	secret, err := s.decryptor.Decrypt(encrypted.Crypto, password)
+	if err != nil {
+		return signer.Web3SignerImportStatus{Status: "error", Message: err.Error()}
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(secret)
+	if err != nil {
+		return signer.Web3SignerImportStatus{Status: "error", Message: err.Error()}
+	}
+	if s.HasKey(types.BytesToValidatorPubkey(key.PublicKey().Marshal())) {
+		return signer.Web3SignerImportStatus{Status: "duplicate"}
+	}
+	s.AddKey(key)
+	return signer.Web3SignerImportStatus{Status: "imported"}
+}
+
+func writeJson(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,289 @@
+package signer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const (
+	Web3SignerUpcheckPath    = "/upcheck"
+	Web3SignerPublicKeysPath = "/api/v1/eth2/publicKeys"
+	Web3SignerSignPath       = "/api/v1/eth2/sign/%s"
+	Web3SignerKeystoresPath  = "/eth/v1/keystores"
+
+	Web3SignerDepositType       = "DEPOSIT"
+	Web3SignerVoluntaryExitType = "VOLUNTARY_EXIT"
+
+	web3SignerContentType = "application/json"
+	web3SignerTimeout     = 30 * time.Second
+)
+
+// Request to sign a message
+type Web3SignerSignRequest struct {
+	Type          string                   `json:"type"`
+	SigningRoot   string                   `json:"signingRoot"`
+	ForkInfo      *Web3SignerForkInfo      `json:"fork_info,omitempty"`
+	Deposit       *Web3SignerDeposit       `json:"deposit,omitempty"`
+	VoluntaryExit *Web3SignerVoluntaryExit `json:"voluntary_exit,omitempty"`
+}
+type Web3SignerForkInfo struct {
+	Fork                  Web3SignerFork `json:"fork"`
+	GenesisValidatorsRoot string         `json:"genesis_validators_root"`
+}
+type Web3SignerFork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+type Web3SignerDeposit struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	GenesisForkVersion    string `json:"genesis_fork_version"`
+}
+type Web3SignerVoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+type Web3SignerSignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// Request to import keystores through the key manager API
+type Web3SignerImportRequest struct {
+	Keystores []string `json:"keystores"`
+	Passwords []string `json:"passwords"`
+}
+type Web3SignerImportResponse struct {
+	Data []Web3SignerImportStatus `json:"data"`
+}
+type Web3SignerImportStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Signs through a remote signer implementing the Web3Signer eth2 signing and key manager APIs
+type Web3Signer struct {
+	url    string
+	client *http.Client
+}
+
+// Create a client for the remote signer at the given URL
+func NewWeb3Signer(url string) *Web3Signer {
+	return &Web3Signer{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: web3SignerTimeout},
+	}
+}
+
+// Get the URL of the remote signer
+func (s *Web3Signer) GetUrl() string {
+	return s.url
+}
+
+// Check the remote signer is up
+func (s *Web3Signer) Upcheck() error {
+	_, status, err := s.getRequest(Web3SignerUpcheckPath)
+	if err != nil {
+		return fmt.Errorf("could not reach the remote signer at %s: %w", s.url, err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("the remote signer at %s is not ready: HTTP status %d", s.url, status)
+	}
+	return nil
+}
+
+// Get the validator pubkeys the remote signer holds
+func (s *Web3Signer) GetPublicKeys() ([]types.ValidatorPubkey, error) {
+	body, status, err := s.getRequest(Web3SignerPublicKeysPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the public keys of the remote signer: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("could not get the public keys of the remote signer: HTTP status %d; response body: '%s'", status, string(body))
+	}
+
+	var pubkeyStrings []string
+	if err := json.Unmarshal(body, &pubkeyStrings); err != nil {
+		return nil, fmt.Errorf("could not decode the public keys of the remote signer: %w", err)
+	}
+	pubkeys := make([]types.ValidatorPubkey, len(pubkeyStrings))
+	for i, pubkeyString := range pubkeyStrings {
+		pubkeys[i], err = types.HexToValidatorPubkey(strings.TrimPrefix(pubkeyString, "0x"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pubkeys, nil
+}
+
+// Check which of the given validators the remote signer holds keys for
+func (s *Web3Signer) HasKeys(pubkeys []types.ValidatorPubkey) (map[types.ValidatorPubkey]bool, error) {
+	signerPubkeys, err := s.GetPublicKeys()
+	if err != nil {
+		return nil, err
+	}
+	held := map[types.ValidatorPubkey]bool{}
+	for _, pubkey := range signerPubkeys {
+		held[pubkey] = true
+	}
+
+	hasKeys := map[types.ValidatorPubkey]bool{}
+	for _, pubkey := range pubkeys {
+		hasKeys[pubkey] = held[pubkey]
+	}
+	return hasKeys, nil
+}
+
+// Import an EIP-2335 keystore into the remote signer; keys it already holds are left as they are
+func (s *Web3Signer) ImportKeystore(keystore []byte, password string) error {
+	body, status, err := s.postRequest(Web3SignerKeystoresPath, Web3SignerImportRequest{
+		Keystores: []string{string(keystore)},
+		Passwords: []string{password},
+	})
+	if err != nil {
+		return fmt.Errorf("could not import the keystore into the remote signer: %w", err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("could not import the keystore into the remote signer: HTTP status %d; response body: '%s'", status, string(body))
+	}
+
+	var response Web3SignerImportResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("could not decode the import response of the remote signer: %w", err)
+	}
+	if len(response.Data) != 1 {
+		return fmt.Errorf("the remote signer returned %d import results for 1 keystore", len(response.Data))
+	}
+	switch response.Data[0].Status {
+	case "imported", "duplicate":
+		return nil
+	default:
+		return fmt.Errorf("the remote signer could not import the keystore: %s %s", response.Data[0].Status, response.Data[0].Message)
+	}
+}
+
+// Sign the deposit of a validator
+func (s *Web3Signer) SignDeposit(request DepositRequest) (types.ValidatorSignature, error) {
+	signingRoot, err := request.SigningRoot()
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	return s.sign(request.Pubkey, signingRoot, Web3SignerSignRequest{
+		Type:        Web3SignerDepositType,
+		SigningRoot: hexutil.Encode(signingRoot[:]),
+		Deposit: &Web3SignerDeposit{
+			Pubkey:                hexutil.Encode(request.Pubkey.Bytes()),
+			WithdrawalCredentials: hexutil.Encode(request.WithdrawalCredentials[:]),
+			Amount:                strconv.FormatUint(request.Amount, 10),
+			GenesisForkVersion:    hexutil.Encode(request.GenesisForkVersion),
+		},
+	})
+}
+
+// Sign the voluntary exit of a validator
+func (s *Web3Signer) SignVoluntaryExit(request VoluntaryExitRequest) (types.ValidatorSignature, error) {
+	signingRoot, err := request.SigningRoot()
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	// The remote signer picks the fork version by epoch, so both versions are set to the one the exit is signed for
+	version := hexutil.Encode(request.ForkInfo.Version)
+	return s.sign(request.Pubkey, signingRoot, Web3SignerSignRequest{
+		Type:        Web3SignerVoluntaryExitType,
+		SigningRoot: hexutil.Encode(signingRoot[:]),
+		ForkInfo: &Web3SignerForkInfo{
+			Fork: Web3SignerFork{
+				PreviousVersion: version,
+				CurrentVersion:  version,
+				Epoch:           "0",
+			},
+			GenesisValidatorsRoot: hexutil.Encode(request.ForkInfo.GenesisValidatorsRoot),
+		},
+		VoluntaryExit: &Web3SignerVoluntaryExit{
+			Epoch:          strconv.FormatUint(request.Epoch, 10),
+			ValidatorIndex: strconv.FormatUint(request.ValidatorIndex, 10),
+		},
+	})
+}
+
+// Request a signature and check it was made by the validator over the expected root
+func (s *Web3Signer) sign(pubkey types.ValidatorPubkey, signingRoot [32]byte, request Web3SignerSignRequest) (types.ValidatorSignature, error) {
+	body, status, err := s.postRequest(fmt.Sprintf(Web3SignerSignPath, hexutil.Encode(pubkey.Bytes())), request)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("could not request a %s signature for validator %s: %w", request.Type, pubkey.Hex(), err)
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return types.ValidatorSignature{}, fmt.Errorf("the remote signer doesn't hold the key of validator %s", pubkey.Hex())
+	default:
+		return types.ValidatorSignature{}, fmt.Errorf("could not get a %s signature for validator %s: HTTP status %d; response body: '%s'", request.Type, pubkey.Hex(), status, string(body))
+	}
+
+	var response Web3SignerSignResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("could not decode the %s signature for validator %s: %w", request.Type, pubkey.Hex(), err)
+	}
+	signature, err := types.HexToValidatorSignature(strings.TrimPrefix(response.Signature, "0x"))
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	if err := VerifySignature(pubkey, signingRoot, signature); err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("the remote signer returned an invalid %s signature: %w", request.Type, err)
+	}
+	return signature, nil
+}
+
+// Make a GET request to the remote signer
+func (s *Web3Signer) getRequest(requestPath string) ([]byte, int, error) {
+	request, err := http.NewRequest(http.MethodGet, s.url+requestPath, nil)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request.Header.Set("Accept", web3SignerContentType)
+	return s.do(request)
+}
+
+// Make a POST request to the remote signer
+func (s *Web3Signer) postRequest(requestPath string, requestBody interface{}) ([]byte, int, error) {
+	requestBodyBytes, err := json.Marshal(requestBody)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request, err := http.NewRequest(http.MethodPost, s.url+requestPath, bytes.NewReader(requestBodyBytes))
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request.Header.Set("Content-Type", web3SignerContentType)
+	request.Header.Set("Accept", web3SignerContentType)
+	return s.do(request)
+}
+
+func (s *Web3Signer) do(request *http.Request) ([]byte, int, error) {
+	response, err := s.client.Do(request)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	return body, response.StatusCode, nil
+}
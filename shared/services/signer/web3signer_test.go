@@ -0,0 +1,158 @@
+package signer_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	"github.com/stader-labs/stader-node/shared/services/signer/signertest"
+	w3skeystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore/web3signer"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+func newKey(t *testing.T) (*eth2types.BLSPrivateKey, types.ValidatorPubkey) {
+	t.Helper()
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatal(err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+}
+
+func TestWeb3SignerMatchesLocalSigner(t *testing.T) {
+	key, pubkey := newKey(t)
+	server := signertest.NewServer(key)
+	defer server.Close()
+
+	remote := signer.NewWeb3Signer(server.URL)
+	local := signer.NewKeySigner(key)
+
+	deposit := signer.DepositRequest{
+		Pubkey:                pubkey,
+		WithdrawalCredentials: common.HexToHash("0x010000000000000000000000b2e8d1b5e8f2d3f1a1c5d7e9f0a2b4c6d8e0f1a3"),
+		Amount:                1000000000,
+		GenesisForkVersion:    hexutil.MustDecode("0x01017000"),
+	}
+	remoteSignature, err := remote.SignDeposit(deposit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	localSignature, err := local.SignDeposit(deposit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remoteSignature != localSignature {
+		t.Errorf("remote deposit signature %s, local %s", remoteSignature.Hex(), localSignature.Hex())
+	}
+
+	exit := signer.VoluntaryExitRequest{
+		Pubkey:         pubkey,
+		ValidatorIndex: 1234,
+		Epoch:          56789,
+		ForkInfo: signer.ForkInfo{
+			Version:               hexutil.MustDecode("0x04017000"),
+			GenesisValidatorsRoot: common.HexToHash("0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1").Bytes(),
+		},
+	}
+	remoteSignature, err = remote.SignVoluntaryExit(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	localSignature, err = local.SignVoluntaryExit(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remoteSignature != localSignature {
+		t.Errorf("remote exit signature %s, local %s", remoteSignature.Hex(), localSignature.Hex())
+	}
+}
+
+func TestWeb3SignerUnknownKey(t *testing.T) {
+	server := signertest.NewServer()
+	defer server.Close()
+
+	_, pubkey := newKey(t)
+	_, err := signer.NewWeb3Signer(server.URL).SignVoluntaryExit(signer.VoluntaryExitRequest{
+		Pubkey: pubkey,
+		ForkInfo: signer.ForkInfo{
+			Version:               hexutil.MustDecode("0x04017000"),
+			GenesisValidatorsRoot: make([]byte, 32),
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "doesn't hold the key") {
+		t.Errorf("expected a missing key error, got %v", err)
+	}
+}
+
+func TestWeb3SignerKeystore(t *testing.T) {
+	server := signertest.NewServer()
+	defer server.Close()
+
+	key, pubkey := newKey(t)
+	remote := signer.NewWeb3Signer(server.URL)
+	if err := remote.Upcheck(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Storing the key imports it into the signer and lists it for Lighthouse, once
+	keystorePath := t.TempDir()
+	ks := w3skeystore.NewKeystore(keystorePath, remote, true)
+	for i := 0; i < 2; i++ {
+		if err := ks.StoreValidatorKey(key, "m/12381/3600/0/0/0"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pubkeys, err := remote.GetPublicKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubkeys) != 1 || pubkeys[0] != pubkey {
+		t.Errorf("expected the signer to hold %s, got %v", pubkey.Hex(), pubkeys)
+	}
+
+	definitions, err := os.ReadFile(filepath.Join(keystorePath, w3skeystore.KeystoreDir, w3skeystore.ValidatorsDir, w3skeystore.DefinitionsFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(string(definitions), pubkey.Hex()) != 1 || !strings.Contains(string(definitions), server.URL) {
+		t.Errorf("unexpected validator definitions:\n%s", definitions)
+	}
+
+	if _, err := remote.SignDeposit(signer.DepositRequest{
+		Pubkey:             pubkey,
+		Amount:             31000000000,
+		GenesisForkVersion: hexutil.MustDecode("0x01017000"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignerHasKeys(t *testing.T) {
+	key, pubkey := newKey(t)
+	_, otherPubkey := newKey(t)
+	server := signertest.NewServer(key)
+	defer server.Close()
+
+	for name, validatorSigner := range map[string]signer.Signer{
+		"remote": signer.NewWeb3Signer(server.URL),
+		"local":  signer.NewKeySigner(key),
+	} {
+		hasKeys, err := validatorSigner.HasKeys([]types.ValidatorPubkey{pubkey, otherPubkey})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hasKeys[pubkey] || hasKeys[otherPubkey] {
+			t.Errorf("%s signer should only hold %s, got %v", name, pubkey.Hex(), hasKeys)
+		}
+	}
+}
@@ -21,6 +21,7 @@ package stader
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -30,6 +31,10 @@ import (
 
 // Wait for a transaction
 func (c *Client) WaitForTransaction(txHash common.Hash) (api.APIResponse, error) {
+	// Transactions of an offline node account are only sent once they are signed, so stop the command here
+	if c.IsOffline() {
+		return api.APIResponse{}, errors.New("The transaction was added to the offline bundle instead of being sent. Sign it with `stader-cli node bundle sign` on the machine with the node wallet, then send it with `stader-cli node bundle send`.")
+	}
 	responseBytes, err := c.callAPI(fmt.Sprintf("wait %s", txHash.String()))
 	if err != nil {
 		return api.APIResponse{}, fmt.Errorf("Error waiting for tx: %w", err)
@@ -44,6 +44,7 @@ import (
 
 	"github.com/alessio/shellescape"
 	"github.com/blang/semver/v4"
+	"github.com/ethereum/go-ethereum/common"
 	externalip "github.com/glendc/go-external-ip"
 	"github.com/mitchellh/go-homedir"
 
@@ -80,6 +81,9 @@ const (
 	nethermindPruneStarterCommand string = "dotnet /setup/NethermindPruneStarter/NethermindPruneStarter.dll"
 	nethermindAdminUrl            string = "http://127.0.0.1:7434"
 
+	SlashingProtectionDir    string = "slashing-protection"
+	slashingProtectionScript string = "slashing-protection.sh"
+
 	DebugColor = color.FgYellow
 )
 
@@ -115,17 +119,40 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	offlineAddress     string
+	watchAddress       string
 }
 
 // Create new Stader client from CLI context
 func NewClientFromCtx(c *cli.Context) (*Client, error) {
-	return NewClient(c.GlobalString("config-path"),
+	client, err := NewClient(c.GlobalString("config-path"),
 		c.GlobalString("daemon-path"),
 		c.GlobalFloat64("maxFee"),
 		c.GlobalFloat64("maxPrioFee"),
 		c.GlobalUint64("gasLimit"),
 		c.GlobalString("nonce"),
 		c.GlobalBool("debug"))
+	if err != nil {
+		return nil, err
+	}
+
+	if offlineAddress := c.GlobalString("offline"); offlineAddress != "" {
+		if !common.IsHexAddress(offlineAddress) {
+			return nil, fmt.Errorf("Invalid offline node address: %s", offlineAddress)
+		}
+		client.offlineAddress = common.HexToAddress(offlineAddress).Hex()
+	}
+
+	if watchAddress := c.GlobalString("address"); watchAddress != "" {
+		if !common.IsHexAddress(watchAddress) {
+			return nil, fmt.Errorf("Invalid node address: %s", watchAddress)
+		}
+		if client.offlineAddress != "" {
+			return nil, fmt.Errorf("--address and --offline can't be used together")
+		}
+		client.watchAddress = common.HexToAddress(watchAddress).Hex()
+	}
+	return client, nil
 }
 
 // Create new Stader client
@@ -962,6 +989,36 @@ func (c *Client) GetDirSizeViaEcMigrator(container string, targetDir string, ima
 	return dirSize, nil
 }
 
+// Exports or imports the slashing protection history of a validator client in a one-off container of the given image.
+// The file is relative to the slashing protection folder in the validators folder.
+func (c *Client) RunSlashingProtectionTool(cfg *config.StaderConfig, client cfgtypes.ConsensusClient, image string, operation string, file string) error {
+	envVars := cfg.GenerateEnvironmentVariables()
+	validatorsPath, err := homedir.Expand(cfg.StaderNode.GetValidatorKeychainPathInCLI())
+	if err != nil {
+		return fmt.Errorf("error loading validators folder path: %w", err)
+	}
+	scriptsPath, err := homedir.Expand(filepath.Join(cfg.StaderDirectory, "scripts"))
+	if err != nil {
+		return fmt.Errorf("error loading scripts folder path: %w", err)
+	}
+
+	cmd := fmt.Sprintf(
+		"docker run --rm --user root --network %s_net -v %s:/validators -v %s:/setup:ro -e CC_CLIENT=%s -e NETWORK=%s -e CC_API_ENDPOINT=%s -e REMOTE_SIGNER_URL=%s --entrypoint sh %s /setup/%s %s %s",
+		shellescape.Quote(envVars["COMPOSE_PROJECT_NAME"]),
+		shellescape.Quote(validatorsPath),
+		shellescape.Quote(scriptsPath),
+		shellescape.Quote(string(client)),
+		shellescape.Quote(envVars["NETWORK"]),
+		shellescape.Quote(envVars["CC_API_ENDPOINT"]),
+		shellescape.Quote(envVars["REMOTE_SIGNER_URL"]),
+		shellescape.Quote(image),
+		slashingProtectionScript,
+		operation,
+		shellescape.Quote(filepath.Join("/validators", SlashingProtectionDir, file)),
+	)
+	return c.printOutput(cmd)
+}
+
 // Deletes the node wallet and all validator keys, and restarts the Docker containers
 func (c *Client) PurgeAllKeys(composeFiles []string) error {
 	// Get the command to run with root privileges
@@ -1588,15 +1645,17 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getOfflineOpts(), c.getWatchOpts(), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getOfflineOpts(),
+			c.getWatchOpts(),
 			args)
 	}
 
@@ -1621,13 +1680,13 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getOfflineOpts(), c.getWatchOpts(), args)
 	} else {
 		envArgs := ""
 		for key, value := range envVars {
 			envArgs += fmt.Sprintf("%s=%s ", key, shellescape.Quote(value))
 		}
-		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s %s %s api %s",
 			envArgs,
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
@@ -1635,6 +1694,8 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getOfflineOpts(),
+			c.getWatchOpts(),
 			args)
 	}
 
@@ -1717,6 +1778,32 @@ func (c *Client) getGasOpts() string {
 	return opts
 }
 
+// Check if transactions are added to the offline bundle instead of being sent
+func (c *Client) IsOffline() bool {
+	return c.offlineAddress != ""
+}
+
+func (c *Client) getOfflineOpts() string {
+	offline := ""
+	if c.offlineAddress != "" {
+		offline = fmt.Sprintf("--offline %s", c.offlineAddress)
+	}
+	return offline
+}
+
+// Check if the node account is only being watched, so write commands are refused
+func (c *Client) IsWatchOnly() bool {
+	return c.watchAddress != ""
+}
+
+func (c *Client) getWatchOpts() string {
+	watch := ""
+	if c.watchAddress != "" {
+		watch = fmt.Sprintf("--address %s", c.watchAddress)
+	}
+	return watch
+}
+
 func (c *Client) getCustomNonce() string {
 	// Set the custom nonce
 	nonce := ""
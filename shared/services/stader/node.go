@@ -20,10 +20,12 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package stader
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	string_utils "github.com/stader-labs/stader-node/shared/utils/string-utils"
@@ -230,6 +232,78 @@ func (c *Client) CanNodeDeposit(amountBasedWei, amountUtilityWei, numValidators
 	return response, nil
 }
 
+func (c *Client) CanExitValidators(validatorPubKeys []types.ValidatorPubkey) (api.CanExitValidatorsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("validator can-exit-validators %s", joinValidatorPubKeys(validatorPubKeys)))
+	if err != nil {
+		return api.CanExitValidatorsResponse{}, fmt.Errorf("could not get can-exit-validators status: %w", err)
+	}
+	var response api.CanExitValidatorsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanExitValidatorsResponse{}, fmt.Errorf("could not decode can-exit-validators response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanExitValidatorsResponse{}, fmt.Errorf("could not get can-exit-validators status: %s", response.Error)
+	}
+	return response, nil
+}
+
+func (c *Client) ExitValidators(validatorPubKeys []types.ValidatorPubkey) (api.ExitValidatorsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("validator exit-validators %s", joinValidatorPubKeys(validatorPubKeys)))
+	if err != nil {
+		return api.ExitValidatorsResponse{}, fmt.Errorf("could not get exit-validators status: %w", err)
+	}
+	var response api.ExitValidatorsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExitValidatorsResponse{}, fmt.Errorf("could not decode exit-validators response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExitValidatorsResponse{}, fmt.Errorf("could not get exit-validators status: %s", response.Error)
+	}
+	return response, nil
+}
+
+func (c *Client) ExportExits(exitEpoch uint64, validatorPubKeys []types.ValidatorPubkey, password string) (api.ExportExitsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("validator export-exits %d %s", exitEpoch, joinValidatorPubKeys(validatorPubKeys)), password)
+	if err != nil {
+		return api.ExportExitsResponse{}, fmt.Errorf("could not export exit messages: %w", err)
+	}
+	var response api.ExportExitsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportExitsResponse{}, fmt.Errorf("could not decode export-exits response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExportExitsResponse{}, fmt.Errorf("could not export exit messages: %s", response.Error)
+	}
+	return response, nil
+}
+
+func (c *Client) PresignStatus() (api.PresignStatusResponse, error) {
+	responseBytes, err := c.callAPI("validator presign-status")
+	if err != nil {
+		return api.PresignStatusResponse{}, fmt.Errorf("could not get presign-status: %w", err)
+	}
+	var response api.PresignStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PresignStatusResponse{}, fmt.Errorf("could not decode presign-status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PresignStatusResponse{}, fmt.Errorf("could not get presign-status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Join pubkeys into a single API argument, an empty list selects all the operator's validators
+func joinValidatorPubKeys(validatorPubKeys []types.ValidatorPubkey) string {
+	if len(validatorPubKeys) == 0 {
+		return "all"
+	}
+	pubKeys := make([]string, 0, len(validatorPubKeys))
+	for _, validatorPubKey := range validatorPubKeys {
+		pubKeys = append(pubKeys, validatorPubKey.String())
+	}
+	return strings.Join(pubKeys, ",")
+}
+
 func (c *Client) CanExitValidator(validatorPubKey types.ValidatorPubkey) (api.CanExitValidatorResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("validator can-exit-validator %s", validatorPubKey))
 	if err != nil {
@@ -356,6 +430,40 @@ func (c *Client) SignMessage(message string) (api.NodeSignResponse, error) {
 	return response, nil
 }
 
+// Use the node private key to sign a serialized transaction
+func (c *Client) SignTransaction(serializedTx []byte) (api.NodeSignResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node sign %s", hex.EncodeToString(serializedTx)))
+	if err != nil {
+		return api.NodeSignResponse{}, fmt.Errorf("could not sign transaction: %w", err)
+	}
+
+	var response api.NodeSignResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSignResponse{}, fmt.Errorf("could not decode node sign response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSignResponse{}, fmt.Errorf("could not sign transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Send a serialized transaction that was signed with the node private key on another machine
+func (c *Client) SendSignedTransaction(serializedTx []byte) (api.NodeSendSignedTxResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node send-signed-tx %s", hex.EncodeToString(serializedTx)))
+	if err != nil {
+		return api.NodeSendSignedTxResponse{}, fmt.Errorf("could not send signed transaction: %w", err)
+	}
+
+	var response api.NodeSendSignedTxResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSendSignedTxResponse{}, fmt.Errorf("could not decode node send-signed-tx response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSendSignedTxResponse{}, fmt.Errorf("could not send signed transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
 func (c *Client) CanSendElRewards() (api.CanSendElRewardsResponse, error) {
 	responseBytes, err := c.callAPI("node can-send-el-rewards")
 	if err != nil {
@@ -452,6 +560,38 @@ func (c *Client) SendClRewards(validatorPubKey types.ValidatorPubkey) (api.SendC
 	return response, nil
 }
 
+func (c *Client) CanSweepClRewards(minimum *big.Int) (api.CanSweepClRewardsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("validator can-sweep-cl-rewards %s", minimum.String()))
+	if err != nil {
+		return api.CanSweepClRewardsResponse{}, fmt.Errorf("could not get validator can-sweep-cl-rewards response: %w", err)
+	}
+	var response api.CanSweepClRewardsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSweepClRewardsResponse{}, fmt.Errorf("could not decode validator can-sweep-cl-rewards response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSweepClRewardsResponse{}, fmt.Errorf("could not get validator can-sweep-cl-rewards response: %s", response.Error)
+	}
+
+	return response, nil
+}
+
+func (c *Client) SweepClRewards(minimum *big.Int) (api.SweepClRewardsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("validator sweep-cl-rewards %s", minimum.String()))
+	if err != nil {
+		return api.SweepClRewardsResponse{}, fmt.Errorf("could not get validator sweep-cl-rewards response: %w", err)
+	}
+	var response api.SweepClRewardsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SweepClRewardsResponse{}, fmt.Errorf("could not decode validator sweep-cl-rewards response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SweepClRewardsResponse{}, fmt.Errorf("could not get validator sweep-cl-rewards response: %s", response.Error)
+	}
+
+	return response, nil
+}
+
 func (c *Client) CanWithdrawSd(amount *big.Int) (api.CanWithdrawSdResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node can-withdraw-sd %s", amount.String()))
 	if err != nil {
@@ -533,6 +673,67 @@ func (c *Client) GetDetailedCyclesInfo(cycles []*big.Int) (api.CyclesDetailedInf
 	return response, nil
 }
 
+// Rebuild the socializing pool rewards tree of a cycle from chain data
+func (c *Client) GenerateSpTree(cycle int64) (api.GenerateSpTreeResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node generate-sp-tree %d", cycle))
+	if err != nil {
+		return api.GenerateSpTreeResponse{}, fmt.Errorf("could not generate socializing pool tree: %w", err)
+	}
+	var response api.GenerateSpTreeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GenerateSpTreeResponse{}, fmt.Errorf("could not decode generate socializing pool tree response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GenerateSpTreeResponse{}, fmt.Errorf("could not generate socializing pool tree: %s", response.Error)
+	}
+	return response, nil
+}
+
+func (c *Client) NodeHistory(days uint64, interval uint64) (api.NodeHistoryResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node history %d %d", days, interval))
+	if err != nil {
+		return api.NodeHistoryResponse{}, fmt.Errorf("could not get node history: %w", err)
+	}
+	var response api.NodeHistoryResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeHistoryResponse{}, fmt.Errorf("could not decode node history response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeHistoryResponse{}, fmt.Errorf("could not get node history: %s", response.Error)
+	}
+	return response, nil
+}
+
+func (c *Client) NodeEvents(fromBlock uint64, toBlock uint64) (api.NodeEventsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node events %d %d", fromBlock, toBlock))
+	if err != nil {
+		return api.NodeEventsResponse{}, fmt.Errorf("could not get node events: %w", err)
+	}
+	var response api.NodeEventsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeEventsResponse{}, fmt.Errorf("could not decode node events response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeEventsResponse{}, fmt.Errorf("could not get node events: %s", response.Error)
+	}
+	return response, nil
+}
+
+func (c *Client) NodeReport(fromTime int64, toTime int64) (api.NodeReportResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node report %d %d", fromTime, toTime))
+	if err != nil {
+		return api.NodeReportResponse{}, fmt.Errorf("could not get node report: %w", err)
+	}
+	var response api.NodeReportResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeReportResponse{}, fmt.Errorf("could not decode node report response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeReportResponse{}, fmt.Errorf("could not get node report: %s", response.Error)
+	}
+	return response, nil
+}
+
 func (c *Client) CanClaimSpRewards() (api.CanClaimSpRewardsResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node can-claim-sp-rewards"))
 	if err != nil {
@@ -646,6 +847,42 @@ func (c *Client) SetRewardAddress(operatorRewardAddress common.Address) (api.Set
 	return response, nil
 }
 
+func (c *Client) GetConfirmRewardAddressTx() (api.ConfirmRewardAddressTxResponse, error) {
+	responseBytes, err := c.callAPI("node get-confirm-reward-address-tx")
+	if err != nil {
+		return api.ConfirmRewardAddressTxResponse{}, fmt.Errorf("could not get confirm-reward-address-tx response: %w", err)
+	}
+
+	var response api.ConfirmRewardAddressTxResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ConfirmRewardAddressTxResponse{}, fmt.Errorf("could not decode confirm-reward-address-tx response: %w", err)
+	}
+
+	if response.Error != "" {
+		return api.ConfirmRewardAddressTxResponse{}, fmt.Errorf("could not get confirm-reward-address-tx response: %s", response.Error)
+	}
+
+	return response, nil
+}
+
+func (c *Client) GetRewardAddressChangeStatus(fromBlock uint64) (api.RewardAddressChangeStatusResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node get-reward-address-change-status %d", fromBlock))
+	if err != nil {
+		return api.RewardAddressChangeStatusResponse{}, fmt.Errorf("could not get reward-address-change-status response: %w", err)
+	}
+
+	var response api.RewardAddressChangeStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RewardAddressChangeStatusResponse{}, fmt.Errorf("could not decode reward-address-change-status response: %w", err)
+	}
+
+	if response.Error != "" {
+		return api.RewardAddressChangeStatusResponse{}, fmt.Errorf("could not get reward-address-change-status response: %s", response.Error)
+	}
+
+	return response, nil
+}
+
 func (c *Client) RepaySd(amountWei *big.Int) (api.NodeRepaySDResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node repay-sd %s", amountWei.String()))
 	if err != nil {
@@ -735,3 +972,66 @@ func (c *Client) GetSDStatus(numValidators *big.Int) (api.GetSdStatusResponse, e
 
 	return response, nil
 }
+
+func (c *Client) GetSdRisk() (api.SdRiskResponse, error) {
+	responseBytes, err := c.callAPI("node sd-risk")
+	if err != nil {
+		return api.SdRiskResponse{}, fmt.Errorf("could not get SD risk: %w", err)
+	}
+	var response api.SdRiskResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdRiskResponse{}, fmt.Errorf("could not decode node SD risk response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdRiskResponse{}, fmt.Errorf("could not get SD risk: %s", response.Error)
+	}
+	return response, nil
+}
+
+func (c *Client) GetTxList() (api.TxListResponse, error) {
+	responseBytes, err := c.callAPI("node tx-list")
+	if err != nil {
+		return api.TxListResponse{}, fmt.Errorf("could not get node tx-list response: %w", err)
+	}
+	var response api.TxListResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TxListResponse{}, fmt.Errorf("could not decode node tx-list response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TxListResponse{}, fmt.Errorf("could not get node tx-list response: %s", response.Error)
+	}
+
+	return response, nil
+}
+
+func (c *Client) CanReplaceTx(hash common.Hash, cancel bool) (api.CanReplaceTxResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node can-replace-tx %s %t", hash.Hex(), cancel))
+	if err != nil {
+		return api.CanReplaceTxResponse{}, fmt.Errorf("could not get node can-replace-tx response: %w", err)
+	}
+	var response api.CanReplaceTxResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanReplaceTxResponse{}, fmt.Errorf("could not decode node can-replace-tx response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanReplaceTxResponse{}, fmt.Errorf("could not get node can-replace-tx response: %s", response.Error)
+	}
+
+	return response, nil
+}
+
+func (c *Client) ReplaceTx(hash common.Hash, cancel bool) (api.ReplaceTxResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node replace-tx %s %t", hash.Hex(), cancel))
+	if err != nil {
+		return api.ReplaceTxResponse{}, fmt.Errorf("could not get node replace-tx response: %w", err)
+	}
+	var response api.ReplaceTxResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ReplaceTxResponse{}, fmt.Errorf("could not decode node replace-tx response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ReplaceTxResponse{}, fmt.Errorf("could not get node replace-tx response: %s", response.Error)
+	}
+
+	return response, nil
+}
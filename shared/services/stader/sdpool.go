@@ -0,0 +1,201 @@
+package stader
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/stader-labs/stader-node/shared/types/api"
+)
+
+// Get the SD Utility Pool delegation status of the node wallet
+func (c *Client) SdPoolStatus() (api.SdPoolStatusResponse, error) {
+	responseBytes, err := c.callAPI("sd-pool status")
+	if err != nil {
+		return api.SdPoolStatusResponse{}, fmt.Errorf("could not get SD Utility Pool status: %w", err)
+	}
+	var response api.SdPoolStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdPoolStatusResponse{}, fmt.Errorf("could not decode SD Utility Pool status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdPoolStatusResponse{}, fmt.Errorf("could not get SD Utility Pool status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the SD the SD Utility Pool may spend from the node wallet
+func (c *Client) SdPoolSdAllowance() (api.SdAllowanceResponse, error) {
+	responseBytes, err := c.callAPI("sd-pool sd-allowance")
+	if err != nil {
+		return api.SdAllowanceResponse{}, fmt.Errorf("could not get SD allowance of the SD Utility Pool: %w", err)
+	}
+	var response api.SdAllowanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdAllowanceResponse{}, fmt.Errorf("could not decode SD allowance response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdAllowanceResponse{}, fmt.Errorf("could not get SD allowance of the SD Utility Pool: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Estimate the gas of approving the SD Utility Pool to spend SD from the node wallet
+func (c *Client) SdPoolSdApprovalGas(amountWei *big.Int) (api.SdApproveGasResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool get-sd-approval-gas %s", amountWei.String()))
+	if err != nil {
+		return api.SdApproveGasResponse{}, fmt.Errorf("could not get SD approval gas for the SD Utility Pool: %w", err)
+	}
+	var response api.SdApproveGasResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdApproveGasResponse{}, fmt.Errorf("could not decode SD approval gas response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdApproveGasResponse{}, fmt.Errorf("could not get SD approval gas for the SD Utility Pool: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Approve the SD Utility Pool to spend SD from the node wallet
+func (c *Client) SdPoolSdApprove(amountWei *big.Int) (api.SdApproveResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool approve-sd %s", amountWei.String()))
+	if err != nil {
+		return api.SdApproveResponse{}, fmt.Errorf("could not approve SD for the SD Utility Pool: %w", err)
+	}
+	var response api.SdApproveResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdApproveResponse{}, fmt.Errorf("could not decode SD approve response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdApproveResponse{}, fmt.Errorf("could not approve SD for the SD Utility Pool: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether the node wallet can delegate SD to the SD Utility Pool
+func (c *Client) CanSdPoolDelegate(amountWei *big.Int) (api.CanSdPoolDelegateResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool can-delegate %s", amountWei.String()))
+	if err != nil {
+		return api.CanSdPoolDelegateResponse{}, fmt.Errorf("could not check if SD can be delegated: %w", err)
+	}
+	var response api.CanSdPoolDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSdPoolDelegateResponse{}, fmt.Errorf("could not decode can delegate SD response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSdPoolDelegateResponse{}, fmt.Errorf("could not check if SD can be delegated: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Delegate SD to the SD Utility Pool
+func (c *Client) SdPoolDelegate(amountWei *big.Int) (api.SdPoolDelegateResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool delegate %s", amountWei.String()))
+	if err != nil {
+		return api.SdPoolDelegateResponse{}, fmt.Errorf("could not delegate SD: %w", err)
+	}
+	var response api.SdPoolDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdPoolDelegateResponse{}, fmt.Errorf("could not decode delegate SD response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdPoolDelegateResponse{}, fmt.Errorf("could not delegate SD: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a withdrawal of delegated SD can be requested; a zero amount withdraws everything
+func (c *Client) CanSdPoolRequestWithdraw(amountWei *big.Int) (api.CanSdPoolRequestWithdrawResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool can-request-withdraw %s", amountWei.String()))
+	if err != nil {
+		return api.CanSdPoolRequestWithdrawResponse{}, fmt.Errorf("could not check if a withdrawal can be requested: %w", err)
+	}
+	var response api.CanSdPoolRequestWithdrawResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSdPoolRequestWithdrawResponse{}, fmt.Errorf("could not decode can request withdraw response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSdPoolRequestWithdrawResponse{}, fmt.Errorf("could not check if a withdrawal can be requested: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Request a withdrawal of delegated SD; a zero amount withdraws everything
+func (c *Client) SdPoolRequestWithdraw(amountWei *big.Int) (api.SdPoolRequestWithdrawResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool request-withdraw %s", amountWei.String()))
+	if err != nil {
+		return api.SdPoolRequestWithdrawResponse{}, fmt.Errorf("could not request withdrawal: %w", err)
+	}
+	var response api.SdPoolRequestWithdrawResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdPoolRequestWithdrawResponse{}, fmt.Errorf("could not decode request withdraw response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdPoolRequestWithdrawResponse{}, fmt.Errorf("could not request withdrawal: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether there are withdraw requests ready to be finalized
+func (c *Client) CanSdPoolFinalize() (api.CanSdPoolFinalizeResponse, error) {
+	responseBytes, err := c.callAPI("sd-pool can-finalize")
+	if err != nil {
+		return api.CanSdPoolFinalizeResponse{}, fmt.Errorf("could not check if withdraw requests can be finalized: %w", err)
+	}
+	var response api.CanSdPoolFinalizeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSdPoolFinalizeResponse{}, fmt.Errorf("could not decode can finalize response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSdPoolFinalizeResponse{}, fmt.Errorf("could not check if withdraw requests can be finalized: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Finalize the withdraw requests that are ready
+func (c *Client) SdPoolFinalize() (api.SdPoolFinalizeResponse, error) {
+	responseBytes, err := c.callAPI("sd-pool finalize")
+	if err != nil {
+		return api.SdPoolFinalizeResponse{}, fmt.Errorf("could not finalize withdraw requests: %w", err)
+	}
+	var response api.SdPoolFinalizeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdPoolFinalizeResponse{}, fmt.Errorf("could not decode finalize response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdPoolFinalizeResponse{}, fmt.Errorf("could not finalize withdraw requests: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether a finalized withdraw request can be claimed
+func (c *Client) CanSdPoolClaim(requestId *big.Int) (api.CanSdPoolClaimResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool can-claim %s", requestId.String()))
+	if err != nil {
+		return api.CanSdPoolClaimResponse{}, fmt.Errorf("could not check if the withdraw request can be claimed: %w", err)
+	}
+	var response api.CanSdPoolClaimResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanSdPoolClaimResponse{}, fmt.Errorf("could not decode can claim response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanSdPoolClaimResponse{}, fmt.Errorf("could not check if the withdraw request can be claimed: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Claim the SD of a finalized withdraw request
+func (c *Client) SdPoolClaim(requestId *big.Int) (api.SdPoolClaimResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("sd-pool claim %s", requestId.String()))
+	if err != nil {
+		return api.SdPoolClaimResponse{}, fmt.Errorf("could not claim withdraw request: %w", err)
+	}
+	var response api.SdPoolClaimResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SdPoolClaimResponse{}, fmt.Errorf("could not decode claim response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SdPoolClaimResponse{}, fmt.Errorf("could not claim withdraw request: %s", response.Error)
+	}
+	return response, nil
+}
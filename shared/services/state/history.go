@@ -0,0 +1,176 @@
+package state
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/types/history"
+)
+
+// Snapshots are appended as JSON lines to one file per UTC day, so retention only needs to delete whole files
+const (
+	snapshotFilePrefix = "snapshots-"
+	snapshotFileSuffix = ".jsonl"
+	snapshotDayFormat  = "2006-01-02"
+)
+
+// Take a compact snapshot of the metrics
+func (m *MetricsCache) Snapshot(timestamp time.Time) history.MetricsSnapshot {
+	details := m.StaderNetworkDetails
+	return history.MetricsSnapshot{
+		Timestamp:        timestamp.Unix(),
+		ElBlockNumber:    m.ElBlockNumber,
+		BeaconSlotNumber: m.BeaconSlotNumber,
+
+		UnclaimedClRewards:                   details.UnclaimedClRewards,
+		UnclaimedNonSocializingPoolElRewards: details.UnclaimedNonSocializingPoolElRewards,
+		UnclaimedSocializingPoolElRewards:    details.UnclaimedSocializingPoolElRewards,
+		UnclaimedSocializingPoolSdRewards:    details.UnclaimedSocializingPoolSDRewards,
+		ClaimedSocializingPoolElRewards:      details.ClaimedSocializingPoolElRewards,
+		ClaimedSocializingPoolSdRewards:      details.ClaimedSocializingPoolSdRewards,
+		ClaimVaultBalance:                    details.ClaimVaultBalance,
+
+		SdPrice:               details.SdPrice,
+		OperatorStakedSd:      details.OperatorStakedSd,
+		OperatorSdSelfBond:    details.OperatorSDSelfBond,
+		OperatorSdUtilized:    details.OperatorSDUtilized,
+		OperatorEthCollateral: details.OperatorEthCollateral,
+		SdCollateralPct:       details.SdCollateralPct,
+		HealthFactor:          details.HealthFactor,
+
+		ActiveValidators:            bigToInt64(details.ActiveValidators),
+		StaderQueuedValidators:      bigToInt64(details.StaderQueuedValidators),
+		BeaconChainQueuedValidators: bigToInt64(details.BeaconChainQueuedValidators),
+		ExitingValidators:           bigToInt64(details.ExitingValidators),
+		SlashedValidators:           bigToInt64(details.SlashedValidators),
+		WithdrawnValidators:         bigToInt64(details.WithdrawnValidators),
+		FundsSettledValidators:      bigToInt64(details.FundsSettledValidators),
+	}
+}
+
+// Append a snapshot to the history folder and delete the days older than the retention
+func SaveSnapshot(folder string, snapshot history.MetricsSnapshot, retentionDays uint64) error {
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return fmt.Errorf("error creating history folder %s: %w", folder, err)
+	}
+
+	line, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("error encoding snapshot: %w", err)
+	}
+	day := time.Unix(snapshot.Timestamp, 0).UTC()
+	path := filepath.Join(folder, snapshotFilePrefix+day.Format(snapshotDayFormat)+snapshotFileSuffix)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening history file %s: %w", path, err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("error writing history file %s: %w", path, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error writing history file %s: %w", path, err)
+	}
+
+	// Retention
+	oldestDay := day.Truncate(24*time.Hour).AddDate(0, 0, -int(retentionDays))
+	days, err := getSnapshotDays(folder)
+	if err != nil {
+		return err
+	}
+	for _, fileDay := range days {
+		if fileDay.Before(oldestDay) {
+			if err := os.Remove(filepath.Join(folder, snapshotFilePrefix+fileDay.Format(snapshotDayFormat)+snapshotFileSuffix)); err != nil {
+				return fmt.Errorf("error deleting old history: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Read the snapshots taken since the given time, oldest first
+func ReadSnapshots(folder string, since time.Time) ([]history.MetricsSnapshot, error) {
+	days, err := getSnapshotDays(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	sinceDay := since.UTC().Truncate(24 * time.Hour)
+	snapshots := []history.MetricsSnapshot{}
+	for _, day := range days {
+		if day.Before(sinceDay) {
+			continue
+		}
+		path := filepath.Join(folder, snapshotFilePrefix+day.Format(snapshotDayFormat)+snapshotFileSuffix)
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("error opening history file %s: %w", path, err)
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var snapshot history.MetricsSnapshot
+			// A line cut short by a crash is skipped instead of failing the whole history
+			if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+				continue
+			}
+			if snapshot.Timestamp >= since.Unix() {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading history file %s: %w", path, err)
+		}
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Timestamp < snapshots[j].Timestamp
+	})
+	return snapshots, nil
+}
+
+// Get the days that have a snapshot file, oldest first
+func getSnapshotDays(folder string) ([]time.Time, error) {
+	entries, err := os.ReadDir(folder)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []time.Time{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading history folder %s: %w", folder, err)
+	}
+
+	days := []time.Time{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, snapshotFilePrefix) || !strings.HasSuffix(name, snapshotFileSuffix) {
+			continue
+		}
+		day, err := time.Parse(snapshotDayFormat, strings.TrimSuffix(strings.TrimPrefix(name, snapshotFilePrefix), snapshotFileSuffix))
+		if err != nil {
+			continue
+		}
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+	return days, nil
+}
+
+func bigToInt64(value *big.Int) int64 {
+	if value == nil {
+		return 0
+	}
+	return value.Int64()
+}
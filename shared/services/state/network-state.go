@@ -8,6 +8,7 @@ import (
 	"github.com/stader-labs/stader-node/shared/services"
 	"github.com/stader-labs/stader-node/shared/utils/stdr"
 	"github.com/stader-labs/stader-node/stader-lib/contracts"
+	"github.com/stader-labs/stader-node/stader-lib/multicall"
 	"github.com/stader-labs/stader-node/stader-lib/sdutility"
 	"github.com/urfave/cli"
 
@@ -15,21 +16,14 @@ import (
 	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
 
 	"github.com/stader-labs/stader-node/shared/utils/eth2"
-	penalty_tracker "github.com/stader-labs/stader-node/stader-lib/penalty-tracker"
-	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
-	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
-	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
-	stake_pool_manager "github.com/stader-labs/stader-node/stader-lib/stake-pool-manager"
 
 	"github.com/stader-labs/stader-node/shared/services/beacon"
 	"github.com/stader-labs/stader-node/shared/services/config"
 	"github.com/stader-labs/stader-node/shared/utils/log"
-	"github.com/stader-labs/stader-node/stader-lib/node"
-	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
 	"github.com/stader-labs/stader-node/stader-lib/stader"
-	"github.com/stader-labs/stader-node/stader-lib/tokens"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -114,6 +108,8 @@ type MetricDetails struct {
 	UnclaimedSocializingPoolElRewards float64
 	// done
 	UnclaimedSocializingPoolSDRewards float64
+	// The first cycle with rewards left to claim, 0 if there is none
+	OldestUnclaimedSocializingPoolCycle int64
 	// done
 	NextSocializingPoolRewardCycle types.RewardCycleDetails
 	// done
@@ -257,6 +253,11 @@ func CreateMetricsCache(
 		return nil, err
 	}
 
+	orc, err := services.GetOperatorRewardsCollectorContract(c)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get the execution block for the given slot
 	beaconBlock, exists, err := bc.GetBeaconBlock(fmt.Sprintf("%d", slotNumber))
 	if err != nil {
@@ -281,55 +282,93 @@ func CreateMetricsCache(
 
 	start := time.Now()
 
-	// fetch all validator pub keys
-	operatorId, err := node.GetOperatorId(prn, nodeAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-	operatorElRewardAddress, err := node.GetNodeElRewardAddress(prn, 1, operatorId, nil)
-	if err != nil {
-		return nil, err
-	}
-	elRewardAddressBalance, err := tokens.GetEthBalance(prn.Client, operatorElRewardAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-	operatorElRewards, err := pool_utils.CalculateRewardShare(putils, 1, elRewardAddressBalance, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	operatorSdCollateral, err := sd_collateral.GetOperatorSdBalance(sdc, nodeAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	totalValidatorKeys, err := node.GetTotalValidatorKeys(prn, operatorId, nil)
-	if err != nil {
-		return nil, err
-	}
-	poolThreshold, err := sd_collateral.GetPoolThreshold(sdc, 1, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	operatorSdCollateralInEth, err := sd_collateral.ConvertSdToEth(sdc, operatorSdCollateral, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	operatorNonTerminalKeys, err := node.GetTotalNonTerminalValidatorKeys(prn, nodeAddress, totalValidatorKeys, nil)
-	if err != nil {
+	// Every read is pinned to the EL block of the slot and batched through Multicall3,
+	// so the metrics are a consistent snapshot that only takes a few round trips
+	opts := &bind.CallOpts{
+		BlockNumber: new(big.Int).SetUint64(elBlockNumber),
+	}
+	mc, err := multicall.NewMultiCaller(ec, cfg.GetMulticallAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	// Reads that only depend on the node address
+	oneEth := big.NewInt(1000000000000000000)
+	var operatorId *big.Int
+	var operatorSdCollateral *big.Int
+	var poolThreshold types.PoolThresholdInfo
+	var nextRewardCycleDetails types.RewardCycleDetails
+	var rewardsThreshold *big.Int
+	var sdPrice *big.Int
+	var ethPrice *big.Int
+	var totalOperators *big.Int
+	var totalValidators *big.Int
+	var totalActiveValidators *big.Int
+	var prnEthBalanceInWei *big.Int
+	var totalSdCollateral *big.Int
+	var ethxSupply *big.Int
+	var totalStakedAssets *big.Int
+	var sdUtilized *big.Int
+	var totalPosition *big.Int
+	var utilityPoolBalance *big.Int
+	var sdRequestedForWithdraw *big.Int
+	var accumulatedProtocolFee *big.Int
+	var totalSDUtilized *big.Int
+	var cTokenTotalSupply *big.Int
+	var latestExchangeRate *big.Int
+	var userData contracts.UserData
+	var liquidIndex *big.Int
+	var operatorClaimVaultBalance *big.Int
+	mc.AddCall(prn.PermissionlessNodeRegistryContract, &operatorId, "operatorIDByAddress", nodeAddress)
+	mc.AddCall(prn.PermissionlessNodeRegistryContract, &totalOperators, "nextOperatorId")
+	mc.AddCall(prn.PermissionlessNodeRegistryContract, &totalValidators, "nextValidatorId")
+	mc.AddCall(prn.PermissionlessNodeRegistryContract, &totalActiveValidators, "getTotalActiveValidatorCount")
+	mc.AddEthBalance(&prnEthBalanceInWei, prnAddress)
+	mc.AddCall(sdc.SdCollateralContract, &operatorSdCollateral, "operatorSDBalance", nodeAddress)
+	mc.AddCall(sdc.SdCollateralContract, &sdUtilized, "operatorUtilizedSDBalance", nodeAddress)
+	mc.AddCall(sdc.SdCollateralContract, &poolThreshold, "poolThresholdbyPoolId", uint8(1))
+	mc.AddCall(sdc.SdCollateralContract, &sdPrice, "convertETHToSD", oneEth)
+	mc.AddCall(sdc.SdCollateralContract, &ethPrice, "convertSDToETH", oneEth)
+	mc.AddCall(sp.SocializingPoolContract, &nextRewardCycleDetails, "getRewardDetails")
+	mc.AddCall(sdcfg.StaderConfigContract, &rewardsThreshold, "getRewardsThreshold")
+	mc.AddCall(sdt.Erc20TokenContract, &totalSdCollateral, "balanceOf", sdcAddress)
+	mc.AddCall(ethx.Erc20TokenContract, &ethxSupply, "totalSupply")
+	mc.AddCall(spm.StakePoolManagerContract, &totalStakedAssets, "totalAssets")
+	mc.AddCall(sdu.SDUtilityPoolContract, &totalPosition, "getUtilizerLatestBalance", nodeAddress)
+	mc.AddCall(sdu.SDUtilityPoolContract, &utilityPoolBalance, "getPoolAvailableSDBalance")
+	mc.AddCall(sdu.SDUtilityPoolContract, &sdRequestedForWithdraw, "sdRequestedForWithdraw")
+	mc.AddCall(sdu.SDUtilityPoolContract, &accumulatedProtocolFee, "accumulatedProtocolFee")
+	mc.AddCall(sdu.SDUtilityPoolContract, &totalSDUtilized, "totalUtilizedSD")
+	mc.AddCall(sdu.SDUtilityPoolContract, &cTokenTotalSupply, "cTokenTotalSupply")
+	mc.AddCall(sdu.SDUtilityPoolContract, &latestExchangeRate, "getLatestExchangeRate")
+	mc.AddCall(sdu.SDUtilityPoolContract, &userData, "getUserData", nodeAddress)
+	mc.AddCall(sdu.SDUtilityPoolContract, &liquidIndex, "liquidationIndexByOperator", nodeAddress)
+	mc.AddCall(orc.OperatorRewardsCollectorContract, &operatorClaimVaultBalance, "getBalance", nodeAddress)
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	// Reads that depend on the operator
+	var operatorElRewardAddress common.Address
+	var totalValidatorKeys *big.Int
+	var operatorSdCollateralInEth *big.Int
+	mc.AddCall(prn.PermissionlessNodeRegistryContract, &operatorElRewardAddress, "nodeELRewardVaultByOperatorId", operatorId)
+	mc.AddCall(prn.PermissionlessNodeRegistryContract, &totalValidatorKeys, "getOperatorTotalKeys", operatorId)
+	mc.AddCall(sdc.SdCollateralContract, &operatorSdCollateralInEth, "convertSDToETH", operatorSdCollateral)
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	var operatorNonTerminalKeys uint64
+	var elRewardAddressBalance *big.Int
+	mc.AddCall(prn.PermissionlessNodeRegistryContract, &operatorNonTerminalKeys, "getOperatorTotalNonTerminalKeys", nodeAddress, big.NewInt(0), totalValidatorKeys)
+	mc.AddEthBalance(&elRewardAddressBalance, operatorElRewardAddress)
+	if err := mc.Execute(opts); err != nil {
 		return nil, err
 	}
 	operatorEthCollateral := float64(4 * operatorNonTerminalKeys)
 
-	nextRewardCycleDetails, err := socializing_pool.GetRewardDetails(sp, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	validatorInfoMap, pubkeys, err := stdr.GetAllValidatorsRegisteredWithOperator(prn, operatorId, nodeAddress, nil)
+	validatorInfoMap, pubkeys, err := stdr.GetAllValidatorsRegisteredWithOperator(prn, operatorId, nodeAddress, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -354,13 +393,10 @@ func CreateMetricsCache(
 	if err != nil {
 		return nil, err
 	}
-	for _, pubKey := range pubkeys {
-		totalValidatorPenalty, err := penalty_tracker.GetCumulativeValidatorPenalty(pt, pubKey, nil)
-		if err != nil {
-			return nil, err
-		}
-		cumulativePenalty.Add(cumulativePenalty, totalValidatorPenalty)
 
+	// The withdraw vaults of the validators whose CL rewards can be claimed
+	withdrawVaults := []common.Address{}
+	for _, pubKey := range pubkeys {
 		validatorContractInfo, ok := validatorInfoMap[pubKey]
 		if !ok {
 			state.logLine("pub key is not found in validatorInfoMap: %s\n", pubKey)
@@ -413,24 +449,40 @@ func CreateMetricsCache(
 			activeValidators.Add(activeValidators, big.NewInt(1))
 		}
 
-		validatorWithdrawVault := validatorInfoMap[pubKey].WithdrawVaultAddress
-		withdrawVaultBalance, err := tokens.GetEthBalance(prn.Client, validatorWithdrawVault, nil)
-		if err != nil {
-			return nil, err
-		}
-		withdrawVaultRewardShares, err := pool_utils.CalculateRewardShare(putils, 1, withdrawVaultBalance, nil)
-		if err != nil {
-			return nil, err
-		}
-		rewardsThreshold, err := stader_config.GetRewardsThreshold(sdcfg, nil)
-		if err != nil {
-			return nil, err
-		}
-		if withdrawVaultRewardShares.OperatorShare.Cmp(rewardsThreshold) > 0 {
+		withdrawVaults = append(withdrawVaults, validatorContractInfo.WithdrawVaultAddress)
+	}
+
+	// Penalties, vault balances and the operator's EL reward share
+	var operatorElRewards types.RewardShare
+	validatorPenalties := make([]*big.Int, len(pubkeys))
+	withdrawVaultBalances := make([]*big.Int, len(withdrawVaults))
+	mc.AddCall(putils.PoolUtilsContract, &operatorElRewards, "calculateRewardShare", uint8(1), elRewardAddressBalance)
+	for i, pubKey := range pubkeys {
+		mc.AddCall(pt.PenaltyContract, &validatorPenalties[i], "totalPenaltyAmount", pubKey.Bytes())
+	}
+	for i, withdrawVault := range withdrawVaults {
+		mc.AddEthBalance(&withdrawVaultBalances[i], withdrawVault)
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+	for _, validatorPenalty := range validatorPenalties {
+		cumulativePenalty.Add(cumulativePenalty, validatorPenalty)
+	}
+
+	withdrawVaultRewardShares := make([]types.RewardShare, len(withdrawVaults))
+	for i, withdrawVaultBalance := range withdrawVaultBalances {
+		mc.AddCall(putils.PoolUtilsContract, &withdrawVaultRewardShares[i], "calculateRewardShare", uint8(1), withdrawVaultBalance)
+	}
+	if err := mc.Execute(opts); err != nil {
+		return nil, err
+	}
+	for _, withdrawVaultRewardShare := range withdrawVaultRewardShares {
+		// Balances above the threshold are validator withdrawals, not rewards
+		if withdrawVaultRewardShare.OperatorShare.Cmp(rewardsThreshold) > 0 {
 			continue
-		} else {
-			totalClRewards.Add(totalClRewards, withdrawVaultRewardShares.OperatorShare)
 		}
+		totalClRewards.Add(totalClRewards, withdrawVaultRewardShare.OperatorShare)
 	}
 
 	state.ValidatorDetails = statusMap
@@ -441,7 +493,7 @@ func CreateMetricsCache(
 
 	start = time.Now()
 
-	rewardClaimData, err := getClaimedAndUnclaimedSocializingSdAndEth(cfg, sp, nodeAddress)
+	rewardClaimData, err := getClaimedAndUnclaimedSocializingSdAndEth(cfg, mc, sp, nodeAddress, nextRewardCycleDetails.CurrentIndex, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -454,95 +506,12 @@ func CreateMetricsCache(
 
 	metricsDetails := MetricDetails{}
 
-	sdPrice, err := sd_collateral.ConvertEthToSd(sdc, big.NewInt(1000000000000000000), nil)
-	if err != nil {
-		return nil, err
-	}
-	ethPrice, err := sd_collateral.ConvertSdToEth(sdc, big.NewInt(1000000000000000000), nil)
-	if err != nil {
-		return nil, err
-	}
-	totalOperators, err := node.GetNextOperatorId(prn, nil)
-	if err != nil {
-		return nil, err
-	}
-	totalValidators, err := node.GetNextValidatorId(prn, nil)
-	if err != nil {
-		return nil, err
-	}
-	totalActiveValidators, err := node.GetTotalActiveValidators(prn, nil)
-	if err != nil {
-		return nil, err
-	}
-	prnEthBalanceInWei, err := tokens.GetEthBalance(prn.Client, prnAddress, nil)
-	if err != nil {
-		return nil, err
-	}
 	prnEthBalance := eth.WeiToEth(prnEthBalanceInWei)
 	totalQueuedValidators := prnEthBalance / 3
-	totalSdCollateral, err := tokens.BalanceOf(sdt, sdcAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-	permissionlessPoolThreshold, err := sd_collateral.GetPoolThreshold(sdc, 1, nil)
-	if err != nil {
-		return nil, err
-	}
-	ethxSupply, err := tokens.TotalSupply(ethx, nil)
-	if err != nil {
-		return nil, err
-	}
-	totalStakedAssets, err := stake_pool_manager.GetTotalAssets(spm, nil)
-	if err != nil {
-		return nil, err
-	}
+	sdUtilityPoolBalance := sdutility.CalculatePoolAvailableSDBalance(utilityPoolBalance, sdRequestedForWithdraw, accumulatedProtocolFee)
+	sDUtilizationTVL := new(big.Int).Sub(cTokenTotalSupply, latestExchangeRate)
 
-	sdUtilized, err := sd_collateral.GetOperatorUtilizedSDBalance(sdc, nodeAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	totalPosition, err := sdutility.GetUtilizerLatestBalance(sdu, nodeAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	utilityPoolBalance, err := sdutility.GetPoolAvailableSDBalance(sdu, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	totalSDUtilized, err := sdutility.GetTotalUtilized(sdu, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	sDUtilizationTVL, err := sdutility.GetTotalTVL(sdu, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	userData, err := sdutility.GetUserData(sdu, nodeAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	liquidIndex, err := sdutility.LiquidationIndexByOperator(sdu, nodeAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	orc, err := services.GetOperatorRewardsCollectorContract(c)
-	if err != nil {
-		return nil, err
-	}
-
-	operatorClaimVaultBalance, err := node.GetOperatorRewardsCollectorBalance(orc, nodeAddress, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	minThreshold := math.RoundDown(eth.WeiToEth(permissionlessPoolThreshold.MinThreshold), 2)
+	minThreshold := math.RoundDown(eth.WeiToEth(poolThreshold.MinThreshold), 2)
 	sdPriceFormatted := math.RoundDown(eth.WeiToEth(sdPrice), 2)
 	collateralRatioInSd := minThreshold * sdPriceFormatted
 
@@ -559,7 +528,7 @@ func CreateMetricsCache(
 	metricsDetails.TotalEthxSupply = math.RoundDown(eth.WeiToEth(ethxSupply), 10)
 	metricsDetails.TotalStakedEthByUsers = totalStakedAssets
 	metricsDetails.TotalStakedEthByNos = big.NewInt(0).Mul(totalValidators, big.NewInt(4))
-	metricsDetails.CollateralRatio = math.RoundDown(eth.WeiToEth(permissionlessPoolThreshold.MinThreshold), 2)
+	metricsDetails.CollateralRatio = math.RoundDown(eth.WeiToEth(poolThreshold.MinThreshold), 2)
 	metricsDetails.CollateralRatioInSd = collateralRatioInSd
 
 	metricsDetails.MinEthThreshold = math.RoundDown(eth.WeiToEth(poolThreshold.MinThreshold), 4)
@@ -590,13 +559,14 @@ func CreateMetricsCache(
 
 	metricsDetails.UnclaimedSocializingPoolElRewards = math.RoundDown(eth.WeiToEth(rewardClaimData.unclaimedEth), SixDecimalRound)
 	metricsDetails.UnclaimedSocializingPoolSDRewards = math.RoundDown(eth.WeiToEth(rewardClaimData.unclaimedSd), SixDecimalRound)
+	metricsDetails.OldestUnclaimedSocializingPoolCycle = rewardClaimData.oldestUnclaimedCycle
 
 	// amount NO utilized, not include fee
 	metricsDetails.OperatorSDUtilized = math.RoundDown(eth.WeiToEth(sdUtilized), SixDecimalRound)
 
 	metricsDetails.OperatorSDInterest = math.RoundDown(eth.WeiToEth(userData.TotalInterestSD), SixDecimalRound)
 
-	metricsDetails.SdUtilityPoolBalance = math.RoundDown(eth.WeiToEth(utilityPoolBalance), SixDecimalRound)
+	metricsDetails.SdUtilityPoolBalance = math.RoundDown(eth.WeiToEth(sdUtilityPoolBalance), SixDecimalRound)
 	//
 	operatorStakedSd := eth.WeiToEth(operatorSdCollateral) + metricsDetails.OperatorSDUtilized
 	requireCollateral := collateralRatioInSd * float64(operatorNonTerminalKeys)
@@ -640,19 +610,24 @@ func (s *MetricsCache) logLine(format string, v ...interface{}) {
 
 func getClaimedAndUnclaimedSocializingSdAndEth(
 	cfg *config.StaderNodeConfig,
+	mc *multicall.MultiCaller,
 	sp *stader.SocializingPoolContractManager,
 	nodeAccount common.Address,
+	currentIndex *big.Int,
+	opts *bind.CallOpts,
 ) (struct {
-	unclaimedEth *big.Int
-	unclaimedSd  *big.Int
-	claimedEth   *big.Int
-	claimedSd    *big.Int
+	unclaimedEth         *big.Int
+	unclaimedSd          *big.Int
+	claimedEth           *big.Int
+	claimedSd            *big.Int
+	oldestUnclaimedCycle int64
 }, error) {
 	outstruct := struct {
-		unclaimedEth *big.Int
-		unclaimedSd  *big.Int
-		claimedEth   *big.Int
-		claimedSd    *big.Int
+		unclaimedEth         *big.Int
+		unclaimedSd          *big.Int
+		claimedEth           *big.Int
+		claimedSd            *big.Int
+		oldestUnclaimedCycle int64
 	}{
 		unclaimedEth: big.NewInt(0),
 		unclaimedSd:  big.NewInt(0),
@@ -665,8 +640,16 @@ func getClaimedAndUnclaimedSocializingSdAndEth(
 	outstruct.claimedEth = big.NewInt(0)
 	outstruct.claimedSd = big.NewInt(0)
 
-	rewardDetails, err := socializing_pool.GetRewardDetails(sp, nil)
-	if err != nil {
+	// Check the claims of every ended cycle in one batch
+	cycleCount := currentIndex.Int64() - 1
+	if cycleCount < 0 {
+		cycleCount = 0
+	}
+	claimedCycles := make([]bool, cycleCount)
+	for i := int64(1); i <= cycleCount; i++ {
+		mc.AddCall(sp.SocializingPoolContract, &claimedCycles[i-1], "claimedRewards", nodeAccount, big.NewInt(i))
+	}
+	if err := mc.Execute(opts); err != nil {
 		return outstruct, err
 	}
 
@@ -674,7 +657,7 @@ func getClaimedAndUnclaimedSocializingSdAndEth(
 	unclaimedSd := big.NewInt(0)
 	claimedEth := big.NewInt(0)
 	claimedSd := big.NewInt(0)
-	for i := int64(1); i < rewardDetails.CurrentIndex.Int64(); i++ {
+	for i := int64(1); i <= cycleCount; i++ {
 		cycleMerkleProof, exists, err := cfg.ReadCycleCache(i)
 		if err != nil {
 			return outstruct, err
@@ -682,12 +665,8 @@ func getClaimedAndUnclaimedSocializingSdAndEth(
 		if !exists {
 			continue
 		}
-		claimed, err := socializing_pool.HasClaimedRewards(sp, nodeAccount, big.NewInt(i), nil)
-		if err != nil {
-			return outstruct, err
-		}
 
-		if claimed {
+		if claimedCycles[i-1] {
 			ethClaimed, ok := big.NewInt(0).SetString(cycleMerkleProof.Eth, 10)
 			if !ok {
 				return outstruct, fmt.Errorf("failed to parse eth claimed: %s", cycleMerkleProof.Eth)
@@ -709,6 +688,9 @@ func getClaimedAndUnclaimedSocializingSdAndEth(
 			}
 			unclaimedEth.Add(unclaimedEth, ethUnclaimed)
 			unclaimedSd.Add(unclaimedSd, sdUnclaimed)
+			if outstruct.oldestUnclaimedCycle == 0 && (ethUnclaimed.Sign() > 0 || sdUnclaimed.Sign() > 0) {
+				outstruct.oldestUnclaimedCycle = i
+			}
 		}
 	}
 
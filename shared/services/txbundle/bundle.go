@@ -0,0 +1,256 @@
+package txbundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stader-labs/stader-node/shared/services/txjournal"
+)
+
+// A transaction of a bundle, with what it does spelled out for whoever signs it
+type Transaction struct {
+	Intent         string          `json:"intent"`
+	Nonce          uint64          `json:"nonce"`
+	To             *common.Address `json:"to"`
+	Value          *big.Int        `json:"value"`
+	Data           hexutil.Bytes   `json:"data"`
+	GasLimit       uint64          `json:"gasLimit"`
+	MaxFee         *big.Int        `json:"maxFee"`
+	MaxPriorityFee *big.Int        `json:"maxPriorityFee"`
+	Unsigned       hexutil.Bytes   `json:"unsigned"`
+	Signed         hexutil.Bytes   `json:"signed,omitempty"`
+}
+
+// Transactions of the node account waiting to be signed on an offline machine and sent by the online node
+type Bundle struct {
+	ChainID      *big.Int       `json:"chainId"`
+	From         common.Address `json:"from"`
+	Transactions []Transaction  `json:"transactions"`
+}
+
+// Read a bundle from disk
+func Load(path string) (*Bundle, bool, error) {
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("could not read transaction bundle %s: %w", path, err)
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(bytes, &bundle); err != nil {
+		return nil, false, fmt.Errorf("could not decode transaction bundle %s: %w", path, err)
+	}
+	if bundle.ChainID == nil {
+		return nil, false, fmt.Errorf("transaction bundle %s has no chain ID", path)
+	}
+	return &bundle, true, nil
+}
+
+// Write the bundle to disk, replacing the previous version of it
+func (b *Bundle) Save(path string) error {
+	bytes, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode transaction bundle: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("could not create transaction bundle folder: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bytes, 0644); err != nil {
+		return fmt.Errorf("could not write transaction bundle %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("could not replace transaction bundle %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Add an unsigned transaction of the bundle's account. Transactions with a nonce the chain has already used can't be
+// sent anymore so they are dropped, and a transaction replaces the one with the same nonce.
+func (b *Bundle) Add(tx *types.Transaction, accountNonce uint64) error {
+	if tx.Type() != types.DynamicFeeTxType {
+		return fmt.Errorf("only EIP-1559 transactions can be bundled, not type %d", tx.Type())
+	}
+
+	// Pin the chain ID so the offline signer refuses to sign for another network
+	unsignedTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   b.ChainID,
+		Nonce:     tx.Nonce(),
+		GasTipCap: tx.GasTipCap(),
+		GasFeeCap: tx.GasFeeCap(),
+		Gas:       tx.Gas(),
+		To:        tx.To(),
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+	})
+	unsigned, err := unsignedTx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not encode the unsigned transaction: %w", err)
+	}
+
+	transactions := []Transaction{}
+	for _, existing := range b.Transactions {
+		if existing.Nonce >= accountNonce && existing.Nonce != tx.Nonce() {
+			transactions = append(transactions, existing)
+		}
+	}
+	transactions = append(transactions, Transaction{
+		Intent:         txjournal.GetIntent(b.From, tx.To(), tx.Value(), tx.Data()),
+		Nonce:          tx.Nonce(),
+		To:             tx.To(),
+		Value:          tx.Value(),
+		Data:           tx.Data(),
+		GasLimit:       tx.Gas(),
+		MaxFee:         tx.GasFeeCap(),
+		MaxPriorityFee: tx.GasTipCap(),
+		Unsigned:       unsigned,
+	})
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].Nonce < transactions[j].Nonce
+	})
+	b.Transactions = transactions
+	return nil
+}
+
+// Get the nonce for the next transaction, after the ones already in the bundle
+func (b *Bundle) NextNonce(accountNonce uint64) uint64 {
+	nonce := accountNonce
+	for _, tx := range b.Transactions {
+		if tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+	return nonce
+}
+
+// Check whether every transaction has been signed
+func (b *Bundle) IsSigned() bool {
+	for _, tx := range b.Transactions {
+		if len(tx.Signed) == 0 {
+			return false
+		}
+	}
+	return len(b.Transactions) > 0
+}
+
+// Decode the unsigned transaction that gets signed and check the fields shown to the signer describe it.
+// The bundle comes from the online machine, so only what is decoded here can be trusted.
+func (b *Bundle) GetUnsigned(index int) (*types.Transaction, error) {
+	bundled := b.Transactions[index]
+
+	var unsignedTx types.Transaction
+	if err := unsignedTx.UnmarshalBinary(bundled.Unsigned); err != nil {
+		return nil, fmt.Errorf("could not decode unsigned transaction %d: %w", bundled.Nonce, err)
+	}
+	if unsignedTx.Type() != types.DynamicFeeTxType {
+		return nil, fmt.Errorf("unsigned transaction %d is type %d instead of an EIP-1559 transaction", bundled.Nonce, unsignedTx.Type())
+	}
+	if unsignedTx.ChainId().Cmp(b.ChainID) != 0 {
+		return nil, fmt.Errorf("unsigned transaction %d is for chain %s instead of chain %s", bundled.Nonce, unsignedTx.ChainId(), b.ChainID)
+	}
+	if unsignedTx.Nonce() != bundled.Nonce {
+		return nil, fmt.Errorf("unsigned transaction %d has nonce %d", bundled.Nonce, unsignedTx.Nonce())
+	}
+
+	mismatches := []string{}
+	if !sameAddress(unsignedTx.To(), bundled.To) {
+		mismatches = append(mismatches, "to")
+	}
+	if !sameAmount(unsignedTx.Value(), bundled.Value) {
+		mismatches = append(mismatches, "value")
+	}
+	if !bytes.Equal(unsignedTx.Data(), bundled.Data) {
+		mismatches = append(mismatches, "data")
+	}
+	if unsignedTx.Gas() != bundled.GasLimit {
+		mismatches = append(mismatches, "gas limit")
+	}
+	if !sameAmount(unsignedTx.GasFeeCap(), bundled.MaxFee) {
+		mismatches = append(mismatches, "max fee")
+	}
+	if !sameAmount(unsignedTx.GasTipCap(), bundled.MaxPriorityFee) {
+		mismatches = append(mismatches, "max priority fee")
+	}
+	if txjournal.GetIntent(b.From, unsignedTx.To(), unsignedTx.Value(), unsignedTx.Data()) != bundled.Intent {
+		mismatches = append(mismatches, "intent")
+	}
+	if len(mismatches) > 0 {
+		return nil, fmt.Errorf("the %s of transaction %d don't match the unsigned transaction", strings.Join(mismatches, ", "), bundled.Nonce)
+	}
+
+	return &unsignedTx, nil
+}
+
+// Store the signed version of a transaction after checking it is the same transaction, signed by the bundle's account
+func (b *Bundle) SetSigned(index int, signed []byte) error {
+	b.Transactions[index].Signed = signed
+	if _, err := b.GetSigned(index); err != nil {
+		b.Transactions[index].Signed = nil
+		return err
+	}
+	return nil
+}
+
+// Get the signed version of a transaction, checking it is the same transaction, signed by the bundle's account
+func (b *Bundle) GetSigned(index int) (*types.Transaction, error) {
+	bundled := b.Transactions[index]
+	if len(bundled.Signed) == 0 {
+		return nil, fmt.Errorf("transaction %d of the bundle has not been signed", bundled.Nonce)
+	}
+
+	unsignedTx, err := b.GetUnsigned(index)
+	if err != nil {
+		return nil, err
+	}
+	var signedTx types.Transaction
+	if err := signedTx.UnmarshalBinary(bundled.Signed); err != nil {
+		return nil, fmt.Errorf("could not decode signed transaction %d: %w", bundled.Nonce, err)
+	}
+
+	signer := types.LatestSignerForChainID(b.ChainID)
+	if signer.Hash(unsignedTx) != signer.Hash(&signedTx) {
+		return nil, fmt.Errorf("signed transaction %d is not the transaction of the bundle", bundled.Nonce)
+	}
+	from, err := types.Sender(signer, &signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the signer of transaction %d: %w", bundled.Nonce, err)
+	}
+	if from != b.From {
+		return nil, fmt.Errorf("transaction %d was signed by %s instead of the node account %s", bundled.Nonce, from.Hex(), b.From.Hex())
+	}
+
+	return &signedTx, nil
+}
+
+func sameAddress(a *common.Address, b *common.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// A missing amount is the same as zero
+func sameAmount(a *big.Int, b *big.Int) bool {
+	if a == nil {
+		a = common.Big0
+	}
+	if b == nil {
+		b = common.Big0
+	}
+	return a.Cmp(b) == 0
+}
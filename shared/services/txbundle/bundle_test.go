@@ -0,0 +1,150 @@
+package txbundle
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	testChainID   = big.NewInt(1)
+	testRecipient = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testAttacker  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+func newTestTx(chainID *big.Int, nonce uint64, to common.Address, value *big.Int) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(2e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       21000,
+		To:        &to,
+		Value:     value,
+	})
+}
+
+func newTestBundle(t *testing.T, from common.Address) *Bundle {
+	bundle := &Bundle{ChainID: testChainID, From: from}
+	if err := bundle.Add(newTestTx(testChainID, 5, testRecipient, big.NewInt(1e15)), 5); err != nil {
+		t.Fatal(err)
+	}
+	return bundle
+}
+
+func encode(t *testing.T, tx *types.Transaction) []byte {
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestGetUnsigned(t *testing.T) {
+	from := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	tests := []struct {
+		name   string
+		tamper func(bundle *Bundle)
+		err    string
+	}{
+		{"untouched", func(bundle *Bundle) {}, ""},
+		{"swapped transaction", func(bundle *Bundle) {
+			bundle.Transactions[0].Unsigned = encode(t, newTestTx(testChainID, 5, testAttacker, big.NewInt(1e18)))
+		}, "to, value, intent"},
+		{"edited intent", func(bundle *Bundle) {
+			bundle.Transactions[0].Intent = "claim(operator: 0x3000000000000000000000000000000000000003)"
+		}, "intent"},
+		{"edited fees", func(bundle *Bundle) {
+			bundle.Transactions[0].MaxFee = big.NewInt(1e9)
+			bundle.Transactions[0].GasLimit = 100000
+		}, "gas limit, max fee"},
+		{"edited data", func(bundle *Bundle) {
+			bundle.Transactions[0].Data = []byte{1}
+		}, "data"},
+		{"another chain", func(bundle *Bundle) {
+			bundle.Transactions[0].Unsigned = encode(t, newTestTx(big.NewInt(5), 5, testRecipient, big.NewInt(1e15)))
+		}, "chain 5"},
+		{"another nonce", func(bundle *Bundle) {
+			bundle.Transactions[0].Unsigned = encode(t, newTestTx(testChainID, 6, testRecipient, big.NewInt(1e15)))
+		}, "nonce 6"},
+		{"legacy transaction", func(bundle *Bundle) {
+			bundle.Transactions[0].Unsigned = encode(t, types.NewTransaction(5, testRecipient, big.NewInt(1e15), 21000, big.NewInt(30e9), nil))
+		}, "type 0"},
+		{"garbage", func(bundle *Bundle) {
+			bundle.Transactions[0].Unsigned = []byte{0x02, 0x01}
+		}, "could not decode"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bundle := newTestBundle(t, from)
+			test.tamper(bundle)
+
+			tx, err := bundle.GetUnsigned(0)
+			if test.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *tx.To() != testRecipient || tx.Value().Cmp(big.NewInt(1e15)) != 0 || tx.Nonce() != 5 {
+					t.Errorf("unexpected transaction: to %s, value %s, nonce %d", tx.To().Hex(), tx.Value(), tx.Nonce())
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected the tampered bundle to be refused")
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error about %q, got %s", test.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestSetSigned(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := types.LatestSignerForChainID(testChainID)
+
+	sign := func(key *ecdsa.PrivateKey, tx *types.Transaction) []byte {
+		signedTx, err := types.SignTx(tx, signer, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encode(t, signedTx)
+	}
+
+	bundle := newTestBundle(t, crypto.PubkeyToAddress(key.PublicKey))
+	unsignedTx, err := bundle.GetUnsigned(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := bundle.SetSigned(0, sign(otherKey, unsignedTx)); err == nil {
+		t.Error("expected a transaction signed by another account to be refused")
+	}
+	if err := bundle.SetSigned(0, sign(key, newTestTx(testChainID, 5, testAttacker, big.NewInt(1e15)))); err == nil {
+		t.Error("expected a signature of another transaction to be refused")
+	}
+	if bundle.IsSigned() {
+		t.Fatal("refused signatures should not be stored")
+	}
+
+	if err := bundle.SetSigned(0, sign(key, unsignedTx)); err != nil {
+		t.Fatal(err)
+	}
+	if !bundle.IsSigned() {
+		t.Error("expected the bundle to be signed")
+	}
+}
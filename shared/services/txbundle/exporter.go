@@ -0,0 +1,75 @@
+package txbundle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Adds the transactions of an offline node account to its bundle on disk instead of sending them
+type Exporter struct {
+	path    string
+	chainID *big.Int
+	from    common.Address
+}
+
+// Create an exporter for the bundle at the given path
+func NewExporter(path string, chainID *big.Int, from common.Address) *Exporter {
+	return &Exporter{
+		path:    path,
+		chainID: chainID,
+		from:    from,
+	}
+}
+
+// Get the account whose transactions are exported
+func (e *Exporter) From() common.Address {
+	return e.from
+}
+
+// Get the path of the bundle
+func (e *Exporter) Path() string {
+	return e.path
+}
+
+// Get the nonce for the next transaction of the account, after the ones already in the bundle
+func (e *Exporter) NextNonce(accountNonce uint64) (uint64, error) {
+	bundle, err := e.load()
+	if err != nil {
+		return 0, err
+	}
+	return bundle.NextNonce(accountNonce), nil
+}
+
+// Add a transaction to the bundle
+func (e *Exporter) Add(tx *types.Transaction, accountNonce uint64) error {
+	bundle, err := e.load()
+	if err != nil {
+		return err
+	}
+	if err := bundle.Add(tx, accountNonce); err != nil {
+		return err
+	}
+	return bundle.Save(e.path)
+}
+
+// Load the bundle, or start a new one if there isn't one yet
+func (e *Exporter) load() (*Bundle, error) {
+	bundle, exists, err := Load(e.path)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return &Bundle{
+			ChainID:      e.chainID,
+			From:         e.from,
+			Transactions: []Transaction{},
+		}, nil
+	}
+	if bundle.From != e.from || bundle.ChainID.Cmp(e.chainID) != 0 {
+		return nil, fmt.Errorf("the transaction bundle at %s is for %s on chain %s; please send or remove it before exporting transactions for %s", e.path, bundle.From.Hex(), bundle.ChainID.String(), e.from.Hex())
+	}
+	return bundle, nil
+}
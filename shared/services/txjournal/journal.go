@@ -0,0 +1,151 @@
+package txjournal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stader-labs/stader-node/shared/types/transactions"
+)
+
+// Final transactions older than this are removed when the journal is pruned
+const entryRetention = 30 * 24 * time.Hour
+
+// The journal keeps one file per transaction, so the API processes and the daemon can write to it side by side
+type Journal struct {
+	folder string
+}
+
+// Create a journal in the given folder; the folder is created on the first write
+func NewJournal(folder string) *Journal {
+	return &Journal{
+		folder: folder,
+	}
+}
+
+// Add a transaction that was just sent
+func (j *Journal) Record(tx *types.Transaction) error {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Errorf("could not get the sender of transaction %s: %w", tx.Hash().Hex(), err)
+	}
+
+	return j.Save(transactions.Transaction{
+		Hash:           tx.Hash(),
+		From:           from,
+		To:             tx.To(),
+		Nonce:          tx.Nonce(),
+		Value:          tx.Value(),
+		Data:           tx.Data(),
+		GasLimit:       tx.Gas(),
+		MaxFee:         tx.GasFeeCap(),
+		MaxPriorityFee: tx.GasTipCap(),
+		Purpose:        GetPurpose(from, tx.To(), tx.Value(), tx.Data()),
+		SentTime:       time.Now().Unix(),
+		Status:         transactions.StatusPending,
+	})
+}
+
+// Write an entry to disk, replacing the previous version of it
+func (j *Journal) Save(entry transactions.Transaction) error {
+	bytes, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode transaction %s: %w", entry.Hash.Hex(), err)
+	}
+	if err := os.MkdirAll(j.folder, 0755); err != nil {
+		return fmt.Errorf("could not create transaction journal folder: %w", err)
+	}
+
+	path := j.getPath(entry.Hash)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bytes, 0600); err != nil {
+		return fmt.Errorf("could not write transaction %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("could not replace transaction %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Get a single transaction
+func (j *Journal) Get(hash common.Hash) (transactions.Transaction, bool, error) {
+	bytes, err := os.ReadFile(j.getPath(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		return transactions.Transaction{}, false, nil
+	}
+	if err != nil {
+		return transactions.Transaction{}, false, fmt.Errorf("could not read transaction %s: %w", hash.Hex(), err)
+	}
+
+	var entry transactions.Transaction
+	if err := json.Unmarshal(bytes, &entry); err != nil {
+		return transactions.Transaction{}, false, fmt.Errorf("could not decode transaction %s: %w", hash.Hex(), err)
+	}
+	return entry, true, nil
+}
+
+// Get every transaction, ordered by nonce and then by the time it was sent
+func (j *Journal) Entries() ([]transactions.Transaction, error) {
+	files, err := os.ReadDir(j.folder)
+	if errors.Is(err, os.ErrNotExist) {
+		return []transactions.Transaction{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read transaction journal folder: %w", err)
+	}
+
+	entries := []transactions.Transaction{}
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		entry, exists, err := j.Get(common.HexToHash(strings.TrimSuffix(name, ".json")))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(a, b int) bool {
+		if entries[a].Nonce != entries[b].Nonce {
+			return entries[a].Nonce < entries[b].Nonce
+		}
+		return entries[a].SentTime < entries[b].SentTime
+	})
+	return entries, nil
+}
+
+// Remove final transactions past the retention period
+func (j *Journal) Prune() error {
+	entries, err := j.Entries()
+	if err != nil {
+		return err
+	}
+
+	cutoff := time.Now().Add(-entryRetention).Unix()
+	for _, entry := range entries {
+		if entry.IsPending() || entry.SentTime >= cutoff {
+			continue
+		}
+		if err := os.Remove(j.getPath(entry.Hash)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not remove transaction %s: %w", entry.Hash.Hex(), err)
+		}
+	}
+
+	return nil
+}
+
+func (j *Journal) getPath(hash common.Hash) string {
+	return filepath.Join(j.folder, fmt.Sprintf("%s.json", hash.Hex()))
+}
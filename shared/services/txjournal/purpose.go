@@ -0,0 +1,136 @@
+package txjournal
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stader-labs/stader-node/stader-lib/contracts"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Methods of the Stader contracts by selector
+var methods map[[4]byte]abi.Method
+var methodsOnce sync.Once
+
+// Describe what a transaction does from its calldata
+func GetPurpose(from common.Address, to *common.Address, value *big.Int, data []byte) string {
+	if to == nil {
+		return "contract creation"
+	}
+	if len(data) == 0 {
+		if *to == from && (value == nil || value.Sign() == 0) {
+			return "cancel"
+		}
+		return "send ETH"
+	}
+	if len(data) < 4 {
+		return "unknown"
+	}
+
+	if method, exists := getMethod(data); exists {
+		return method.RawName
+	}
+	return fmt.Sprintf("unknown (0x%x)", data[:4])
+}
+
+// Describe a transaction along with the arguments of its contract call, so it can be checked before it is signed
+func GetIntent(from common.Address, to *common.Address, value *big.Int, data []byte) string {
+	intent := GetPurpose(from, to, value, data)
+	if method, exists := getMethod(data); exists {
+		if args, err := method.Inputs.Unpack(data[4:]); err == nil {
+			formattedArgs := make([]string, len(args))
+			for i, arg := range args {
+				formattedArgs[i] = fmt.Sprintf("%s: %s", method.Inputs[i].Name, formatArg(reflect.ValueOf(arg)))
+			}
+			intent = fmt.Sprintf("%s(%s)", method.RawName, strings.Join(formattedArgs, ", "))
+		}
+	}
+	if to != nil {
+		intent += fmt.Sprintf(" to %s", to.Hex())
+	}
+	if value != nil && value.Sign() > 0 {
+		intent += fmt.Sprintf(" sending %s", eth.DisplayAmountInUnits(value, "eth"))
+	}
+	return intent
+}
+
+func getMethod(data []byte) (abi.Method, bool) {
+	if len(data) < 4 {
+		return abi.Method{}, false
+	}
+	methodsOnce.Do(loadMethods)
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	method, exists := methods[selector]
+	return method, exists
+}
+
+// Print byte arrays as hex and everything else as Go would, going into slices so proofs stay readable
+func formatArg(value reflect.Value) string {
+	switch arg := value.Interface().(type) {
+	case common.Address:
+		return arg.Hex()
+	case *big.Int:
+		return arg.String()
+	case []byte:
+		return hexutil.Encode(arg)
+	}
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bytes), value)
+			return hexutil.Encode(bytes)
+		}
+		items := make([]string, value.Len())
+		for i := range items {
+			items[i] = formatArg(value.Index(i))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(items, " "))
+	}
+	return fmt.Sprintf("%v", value.Interface())
+}
+
+func loadMethods() {
+	methods = map[[4]byte]abi.Method{}
+	for _, metaData := range []*bind.MetaData{
+		contracts.Erc20MetaData,
+		contracts.NodeElRewardVaultMetaData,
+		contracts.OperatorRewardsCollectorMetaData,
+		contracts.PenaltyTrackerMetaData,
+		contracts.PermissionlessNodeRegistryMetaData,
+		contracts.PermissionlessPoolMetaData,
+		contracts.PoolUtilsMetaData,
+		contracts.SdCollateralMetaData,
+		contracts.SDUtilityPoolMetaData,
+		contracts.SocializingPoolMetaData,
+		contracts.StaderConfigMetaData,
+		contracts.StakePoolManagerMetaData,
+		contracts.ValidatorWithdrawVaultMetaData,
+		contracts.VaultFactoryMetaData,
+	} {
+		parsed, err := metaData.GetAbi()
+		if err != nil {
+			continue
+		}
+		addMethods(parsed)
+	}
+}
+
+func addMethods(parsed *abi.ABI) {
+	for _, method := range parsed.Methods {
+		if method.IsConstant() {
+			continue
+		}
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		methods[selector] = method
+	}
+}
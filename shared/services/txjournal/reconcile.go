@@ -0,0 +1,110 @@
+package txjournal
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stader-labs/stader-node/shared/types/transactions"
+)
+
+// The calls needed to find out what happened to a transaction
+type ReceiptClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// Update the status of the pending transactions from their receipts. A transaction without a receipt whose nonce
+// was used by the chain is marked replaced if another recorded transaction with its nonce was mined, or dropped otherwise.
+// Returns every transaction after the update.
+func (j *Journal) Reconcile(ec ReceiptClient) ([]transactions.Transaction, error) {
+	entries, err := j.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the transactions that have a receipt first, so the nonces they used are known
+	minedNonces := map[common.Address]map[uint64]bool{}
+	for i := range entries {
+		entry := &entries[i]
+		if !entry.IsPending() {
+			if entry.Status == transactions.StatusConfirmed || entry.Status == transactions.StatusFailed {
+				markNonce(minedNonces, entry.From, entry.Nonce)
+			}
+			continue
+		}
+
+		mined, err := j.applyReceipt(ec, entry)
+		if err != nil {
+			return nil, err
+		}
+		if mined {
+			markNonce(minedNonces, entry.From, entry.Nonce)
+		}
+	}
+
+	accountNonces := map[common.Address]uint64{}
+	for i := range entries {
+		entry := &entries[i]
+		if !entry.IsPending() {
+			continue
+		}
+
+		accountNonce, exists := accountNonces[entry.From]
+		if !exists {
+			accountNonce, err = ec.NonceAt(context.Background(), entry.From, nil)
+			if err != nil {
+				return nil, err
+			}
+			accountNonces[entry.From] = accountNonce
+		}
+		if entry.Nonce >= accountNonce {
+			continue
+		}
+
+		// It may have been mined after its receipt was checked
+		mined, err := j.applyReceipt(ec, entry)
+		if err != nil {
+			return nil, err
+		}
+		if mined {
+			continue
+		}
+		entry.Status = transactions.StatusDropped
+		if minedNonces[entry.From][entry.Nonce] {
+			entry.Status = transactions.StatusReplaced
+		}
+		if err := j.Save(*entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+// Mark a transaction as confirmed or failed if it has a receipt
+func (j *Journal) applyReceipt(ec ReceiptClient, entry *transactions.Transaction) (bool, error) {
+	receipt, err := ec.TransactionReceipt(context.Background(), entry.Hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	entry.Status = transactions.StatusConfirmed
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		entry.Status = transactions.StatusFailed
+	}
+	entry.BlockNumber = receipt.BlockNumber.Uint64()
+	return true, j.Save(*entry)
+}
+
+func markNonce(nonces map[common.Address]map[uint64]bool, account common.Address, nonce uint64) {
+	if nonces[account] == nil {
+		nonces[account] = map[uint64]bool{}
+	}
+	nonces[account][nonce] = true
+}
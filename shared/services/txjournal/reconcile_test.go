@@ -0,0 +1,184 @@
+package txjournal
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stader-labs/stader-node/shared/types/transactions"
+)
+
+var (
+	testAccount      = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testOtherAccount = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+// Serves the receipts of mined transactions and the nonce of each account
+type fakeReceiptClient struct {
+	receipts map[common.Hash]*types.Receipt
+	nonces   map[common.Address]uint64
+
+	// Receipts that only show up when asked a second time, as if mined in between
+	lateReceipts map[common.Hash]*types.Receipt
+	nonceCalls   int
+}
+
+func (f *fakeReceiptClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, exists := f.receipts[txHash]; exists {
+		return receipt, nil
+	}
+	if receipt, exists := f.lateReceipts[txHash]; exists {
+		f.receipts[txHash] = receipt
+	}
+	return nil, ethereum.NotFound
+}
+
+func (f *fakeReceiptClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	f.nonceCalls++
+	return f.nonces[account], nil
+}
+
+func testEntry(id byte, from common.Address, nonce uint64, status string) transactions.Transaction {
+	return transactions.Transaction{
+		Hash:     common.BytesToHash([]byte{id}),
+		From:     from,
+		Nonce:    nonce,
+		SentTime: int64(id),
+		Status:   status,
+	}
+}
+
+func receipt(status uint64, block int64) *types.Receipt {
+	return &types.Receipt{Status: status, BlockNumber: big.NewInt(block)}
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name          string
+		entries       []transactions.Transaction
+		client        *fakeReceiptClient
+		expected      map[byte]string
+		expectedBlock map[byte]uint64
+	}{
+		{
+			name:          "confirmed",
+			entries:       []transactions.Transaction{testEntry(1, testAccount, 5, transactions.StatusPending)},
+			client:        &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{common.BytesToHash([]byte{1}): receipt(types.ReceiptStatusSuccessful, 100)}, nonces: map[common.Address]uint64{testAccount: 6}},
+			expected:      map[byte]string{1: transactions.StatusConfirmed},
+			expectedBlock: map[byte]uint64{1: 100},
+		},
+		{
+			name:          "failed",
+			entries:       []transactions.Transaction{testEntry(1, testAccount, 5, transactions.StatusPending)},
+			client:        &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{common.BytesToHash([]byte{1}): receipt(types.ReceiptStatusFailed, 101)}, nonces: map[common.Address]uint64{testAccount: 6}},
+			expected:      map[byte]string{1: transactions.StatusFailed},
+			expectedBlock: map[byte]uint64{1: 101},
+		},
+		{
+			name: "replaced by a recorded transaction",
+			entries: []transactions.Transaction{
+				testEntry(1, testAccount, 5, transactions.StatusPending),
+				testEntry(2, testAccount, 5, transactions.StatusPending),
+			},
+			client:        &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{common.BytesToHash([]byte{2}): receipt(types.ReceiptStatusSuccessful, 102)}, nonces: map[common.Address]uint64{testAccount: 6}},
+			expected:      map[byte]string{1: transactions.StatusReplaced, 2: transactions.StatusConfirmed},
+			expectedBlock: map[byte]uint64{2: 102},
+		},
+		{
+			name: "replaced by a transaction confirmed earlier",
+			entries: []transactions.Transaction{
+				testEntry(1, testAccount, 5, transactions.StatusConfirmed),
+				testEntry(2, testAccount, 5, transactions.StatusPending),
+			},
+			client:   &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{testAccount: 6}},
+			expected: map[byte]string{1: transactions.StatusConfirmed, 2: transactions.StatusReplaced},
+		},
+		{
+			name:     "dropped",
+			entries:  []transactions.Transaction{testEntry(1, testAccount, 5, transactions.StatusPending)},
+			client:   &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{testAccount: 6}},
+			expected: map[byte]string{1: transactions.StatusDropped},
+		},
+		{
+			name:     "still pending",
+			entries:  []transactions.Transaction{testEntry(1, testAccount, 5, transactions.StatusPending)},
+			client:   &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{testAccount: 5}},
+			expected: map[byte]string{1: transactions.StatusPending},
+		},
+		{
+			name:          "mined while reconciling",
+			entries:       []transactions.Transaction{testEntry(1, testAccount, 5, transactions.StatusPending)},
+			client:        &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{}, lateReceipts: map[common.Hash]*types.Receipt{common.BytesToHash([]byte{1}): receipt(types.ReceiptStatusSuccessful, 103)}, nonces: map[common.Address]uint64{testAccount: 6}},
+			expected:      map[byte]string{1: transactions.StatusConfirmed},
+			expectedBlock: map[byte]uint64{1: 103},
+		},
+		{
+			name: "nonces are per account",
+			entries: []transactions.Transaction{
+				testEntry(1, testAccount, 5, transactions.StatusConfirmed),
+				testEntry(2, testOtherAccount, 5, transactions.StatusPending),
+			},
+			client:   &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{testAccount: 6, testOtherAccount: 6}},
+			expected: map[byte]string{1: transactions.StatusConfirmed, 2: transactions.StatusDropped},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			journal := NewJournal(t.TempDir())
+			for _, entry := range test.entries {
+				if err := journal.Save(entry); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			entries, err := journal.Reconcile(test.client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != len(test.entries) {
+				t.Fatalf("expected %d transactions, got %d", len(test.entries), len(entries))
+			}
+			for id, status := range test.expected {
+				hash := common.BytesToHash([]byte{id})
+
+				// The update must be written to the journal as well as returned
+				saved, exists, err := journal.Get(hash)
+				if err != nil || !exists {
+					t.Fatalf("could not get transaction %d: %v", id, err)
+				}
+				if saved.Status != status {
+					t.Errorf("expected transaction %d to be %s, got %s", id, status, saved.Status)
+				}
+				if saved.BlockNumber != test.expectedBlock[id] {
+					t.Errorf("expected transaction %d in block %d, got %d", id, test.expectedBlock[id], saved.BlockNumber)
+				}
+				for _, entry := range entries {
+					if entry.Hash == hash && entry.Status != status {
+						t.Errorf("expected transaction %d to be returned as %s, got %s", id, status, entry.Status)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileQueriesNonceOncePerAccount(t *testing.T) {
+	journal := NewJournal(t.TempDir())
+	for id := byte(1); id <= 3; id++ {
+		if err := journal.Save(testEntry(id, testAccount, uint64(id), transactions.StatusPending)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := &fakeReceiptClient{receipts: map[common.Hash]*types.Receipt{}, nonces: map[common.Address]uint64{testAccount: 10}}
+	if _, err := journal.Reconcile(client); err != nil {
+		t.Fatal(err)
+	}
+	if client.nonceCalls != 1 {
+		t.Errorf("expected the nonce of the account to be read once, got %d calls", client.nonceCalls)
+	}
+}
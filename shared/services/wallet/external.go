@@ -0,0 +1,187 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Message signed to recover the public key of an external node account
+const externalPubkeyMessage = "Stader node account public key"
+
+var errExternalNodeKey = errors.New("The node account key is held by the external signer and can't be read")
+
+// Node account whose key is held by an external signer implementing Clef's JSON-RPC API
+type externalNodeAccount struct {
+	url     string
+	address *common.Address
+	lock    sync.Mutex
+
+	// Connected on first use, so commands that don't sign keep working while the signer is down
+	signer  *external.ExternalSigner
+	account accounts.Account
+	pubkey  []byte
+}
+
+// Sign for the node account with an external signer. If no address is given, the signer must hold a single account.
+func (w *Wallet) SetExternalNodeSigner(url string, address *common.Address) {
+	w.externalNodeAccount = &externalNodeAccount{
+		url:     url,
+		address: address,
+	}
+}
+
+// Check if the node account's key is held by an external signer
+func (w *Wallet) IsExternal() bool {
+	return w.externalNodeAccount != nil
+}
+
+// Connect to the external signer and find the node account in it
+func (e *externalNodeAccount) getSigner() (*external.ExternalSigner, accounts.Account, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.signer != nil {
+		return e.signer, e.account, nil
+	}
+
+	signer, err := external.NewExternalSigner(e.url)
+	if err != nil {
+		return nil, accounts.Account{}, fmt.Errorf("Could not connect to the external signer at %s: %w", e.url, err)
+	}
+	signerAccounts := signer.Accounts()
+	var account *accounts.Account
+	for i := range signerAccounts {
+		if e.address == nil || signerAccounts[i].Address == *e.address {
+			if account != nil {
+				return nil, accounts.Account{}, fmt.Errorf("The external signer at %s holds %d accounts, please set the node account address", e.url, len(signerAccounts))
+			}
+			account = &signerAccounts[i]
+		}
+	}
+	if account == nil {
+		if e.address != nil {
+			return nil, accounts.Account{}, fmt.Errorf("The external signer at %s doesn't hold the node account %s", e.url, e.address.Hex())
+		}
+		return nil, accounts.Account{}, fmt.Errorf("The external signer at %s doesn't hold any accounts", e.url)
+	}
+
+	e.signer = signer
+	e.account = *account
+	return e.signer, e.account, nil
+}
+
+// Get the external node account
+func (e *externalNodeAccount) getAccount() (accounts.Account, error) {
+	if e.address != nil {
+		return accounts.Account{
+			Address: *e.address,
+			URL:     accounts.URL{Scheme: "extapi", Path: e.url},
+		}, nil
+	}
+	_, account, err := e.getSigner()
+	return account, err
+}
+
+// Get a transactor that has the external signer sign the node account's transactions
+func (w *Wallet) getExternalNodeAccountTransactor() (*bind.TransactOpts, error) {
+	account, err := w.externalNodeAccount.getAccount()
+	if err != nil {
+		return nil, err
+	}
+	return &bind.TransactOpts{
+		From: account.Address,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != account.Address {
+				return nil, bind.ErrNotAuthorized
+			}
+			return w.signExternalTx(tx)
+		},
+		GasFeeCap: w.maxFee,
+		GasTipCap: w.maxPriorityFee,
+		GasLimit:  w.gasLimit,
+		Context:   context.Background(),
+	}, nil
+}
+
+// Have the external signer sign a transaction and check it signed what was asked, with the node account
+func (w *Wallet) signExternalTx(tx *types.Transaction) (*types.Transaction, error) {
+	signer, account, err := w.externalNodeAccount.getSigner()
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := signer.SignTx(account, tx, w.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("The external signer could not sign the transaction: %w", err)
+	}
+	if signedTx == nil {
+		return nil, errors.New("The external signer did not return the signed transaction")
+	}
+
+	txSigner := types.LatestSignerForChainID(w.chainID)
+	if txSigner.Hash(signedTx) != txSigner.Hash(tx) {
+		return nil, errors.New("The external signer signed a different transaction than the one requested")
+	}
+	from, err := types.Sender(txSigner, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get the signer of the transaction: %w", err)
+	}
+	if from != account.Address {
+		return nil, fmt.Errorf("The external signer signed the transaction with %s instead of the node account %s", from.Hex(), account.Address.Hex())
+	}
+	return signedTx, nil
+}
+
+// Have the external signer sign a message with the node account, returning a signature with a 0/1 recovery ID
+func (w *Wallet) signExternalMessage(message []byte) ([]byte, error) {
+	signer, account, err := w.externalNodeAccount.getSigner()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.SignText(account, message)
+	if err != nil {
+		return nil, fmt.Errorf("The external signer could not sign the message: %w", err)
+	}
+
+	pubkey, err := crypto.SigToPub(accounts.TextHash(message), signature)
+	if err != nil {
+		return nil, fmt.Errorf("Could not recover the signer of the message: %w", err)
+	}
+	if crypto.PubkeyToAddress(*pubkey) != account.Address {
+		return nil, fmt.Errorf("The external signer signed the message with %s instead of the node account %s", crypto.PubkeyToAddress(*pubkey).Hex(), account.Address.Hex())
+	}
+	return signature, nil
+}
+
+// Get the public key of the external node account, recovered from a signature since the signer API doesn't expose it
+func (w *Wallet) getExternalNodePubkey() ([]byte, error) {
+	e := w.externalNodeAccount
+	e.lock.Lock()
+	pubkey := e.pubkey
+	e.lock.Unlock()
+	if pubkey != nil {
+		return pubkey, nil
+	}
+
+	signature, err := w.signExternalMessage([]byte(externalPubkeyMessage))
+	if err != nil {
+		return nil, err
+	}
+	recovered, err := crypto.SigToPub(accounts.TextHash([]byte(externalPubkeyMessage)), signature)
+	if err != nil {
+		return nil, fmt.Errorf("Could not recover the node public key: %w", err)
+	}
+	pubkey = crypto.FromECDSAPub(recovered)
+
+	e.lock.Lock()
+	e.pubkey = pubkey
+	e.lock.Unlock()
+	return pubkey, nil
+}
@@ -0,0 +1,161 @@
+package web3signer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"gopkg.in/yaml.v2"
+
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	keystore "github.com/stader-labs/stader-node/shared/services/wallet/keystore"
+	hexutil "github.com/stader-labs/stader-node/shared/utils/hex"
+	stadertypes "github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+const (
+	KeystoreDir            = "web3signer"
+	ValidatorsDir          = "validators"
+	DefinitionsFileName    = "validator_definitions.yml"
+	DefinitionTypeRemote   = "web3signer"
+	DirMode                = 0770
+	FileMode               = 0640
+	definitionsDescription = "Stader validator key held by the remote signer"
+)
+
+// Keystore for validator keys held by a Web3Signer-compatible remote signer.
+// Keys are imported into the signer, and listed in a Lighthouse validator definitions file since Lighthouse can't fetch them from the signer.
+type Keystore struct {
+	keystorePath string
+	remoteSigner *signer.Web3Signer
+	importKeys   bool
+	encryptor    *eth2ks.Encryptor
+}
+
+// Encrypted validator key store
+type validatorKey struct {
+	Crypto  map[string]interface{}      `json:"crypto"`
+	Version uint                        `json:"version"`
+	UUID    uuid.UUID                   `json:"uuid"`
+	Path    string                      `json:"path"`
+	Pubkey  stadertypes.ValidatorPubkey `json:"pubkey"`
+}
+
+// Create new remote signer keystore
+func NewKeystore(keystorePath string, remoteSigner *signer.Web3Signer, importKeys bool) *Keystore {
+	return &Keystore{
+		keystorePath: keystorePath,
+		remoteSigner: remoteSigner,
+		importKeys:   importKeys,
+		encryptor:    eth2ks.New(eth2ks.WithCipher("scrypt")),
+	}
+}
+
+// Get the keystore directory
+func (ks *Keystore) GetKeystoreDir() string {
+	return filepath.Join(ks.keystorePath, KeystoreDir)
+}
+
+// Store a validator key
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Get validator pubkey
+	pubkey := stadertypes.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	if ks.importKeys {
+		if err := ks.importKey(key, pubkey, derivationPath); err != nil {
+			return err
+		}
+	}
+
+	return ks.addDefinition(pubkey)
+
+}
+
+// Import a validator key into the remote signer as an EIP-2335 keystore
+func (ks *Keystore) importKey(key *eth2types.BLSPrivateKey, pubkey stadertypes.ValidatorPubkey, derivationPath string) error {
+
+	// Create a new password
+	password, err := keystore.GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("Could not generate random password: %w", err)
+	}
+
+	// Encrypt key
+	encryptedKey, err := ks.encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	// Encode key store
+	keyStoreBytes, err := json.Marshal(validatorKey{
+		Crypto:  encryptedKey,
+		Version: ks.encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    derivationPath,
+		Pubkey:  pubkey,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	return ks.remoteSigner.ImportKeystore(keyStoreBytes, password)
+
+}
+
+// Add a validator key to the Lighthouse validator definitions, unless it is already listed
+func (ks *Keystore) addDefinition(pubkey stadertypes.ValidatorPubkey) error {
+
+	definitionsPath := filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir, DefinitionsFileName)
+	votingPublicKey := hexutil.AddPrefix(pubkey.Hex())
+
+	// Read the existing definitions, keeping the fields Lighthouse adds to them
+	definitions := []yaml.MapSlice{}
+	bytes, err := os.ReadFile(definitionsPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("Could not read validator definitions: %w", err)
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(bytes, &definitions); err != nil {
+			return fmt.Errorf("Could not decode validator definitions: %w", err)
+		}
+	}
+	for _, definition := range definitions {
+		for _, item := range definition {
+			if item.Key == "voting_public_key" && item.Value == votingPublicKey {
+				return nil
+			}
+		}
+	}
+
+	definitions = append(definitions, yaml.MapSlice{
+		{Key: "enabled", Value: true},
+		{Key: "voting_public_key", Value: votingPublicKey},
+		{Key: "description", Value: definitionsDescription},
+		{Key: "type", Value: DefinitionTypeRemote},
+		{Key: "url", Value: ks.remoteSigner.GetUrl()},
+	})
+	bytes, err = yaml.Marshal(definitions)
+	if err != nil {
+		return fmt.Errorf("Could not encode validator definitions: %w", err)
+	}
+
+	// Create validators dir
+	if err := os.MkdirAll(filepath.Dir(definitionsPath), DirMode); err != nil {
+		return fmt.Errorf("Could not create validator definitions folder: %w", err)
+	}
+
+	// Write definitions to disk
+	if err := os.WriteFile(definitionsPath, bytes, FileMode); err != nil {
+		return fmt.Errorf("Could not write validator definitions to disk: %w", err)
+	}
+
+	return nil
+
+}
@@ -36,6 +36,21 @@ import (
 // Get the node account
 func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 
+	// Use the watched account if there is one
+	if w.watchOnlyNodeAddress != nil {
+		return accounts.Account{Address: *w.watchOnlyNodeAddress}, nil
+	}
+
+	// Use the offline account if there is one
+	if w.offlineNodeAddress != nil {
+		return accounts.Account{Address: *w.offlineNodeAddress}, nil
+	}
+
+	// Use the external signer's account if there is one
+	if w.externalNodeAccount != nil {
+		return w.externalNodeAccount.getAccount()
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return accounts.Account{}, errors.New("Wallet is not initialized")
@@ -68,6 +83,21 @@ func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 // Get a transactor for the node account
 func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
+	// A watched account can't send transactions
+	if w.watchOnlyNodeAddress != nil {
+		return nil, errWatchOnlyNodeAccount
+	}
+
+	// Use the offline account if there is one
+	if w.offlineNodeAddress != nil {
+		return w.getOfflineNodeAccountTransactor(), nil
+	}
+
+	// Have the external signer sign if there is one
+	if w.externalNodeAccount != nil {
+		return w.getExternalNodeAccountTransactor()
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
@@ -90,6 +120,16 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 }
 
 func (w *Wallet) GetNodePrivateKey() (*ecdsa.PrivateKey, error) {
+	// The key of a watched node account isn't here at all
+	if w.watchOnlyNodeAddress != nil {
+		return nil, errWatchOnlyNodeAccount
+	}
+
+	// The key of an external node account never leaves the signer
+	if w.externalNodeAccount != nil {
+		return nil, errExternalNodeKey
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
@@ -107,6 +147,16 @@ func (w *Wallet) GetNodePrivateKey() (*ecdsa.PrivateKey, error) {
 // Get the node account private key bytes
 func (w *Wallet) GetNodePrivateKeyBytes() ([]byte, error) {
 
+	// The key of a watched node account isn't here at all
+	if w.watchOnlyNodeAddress != nil {
+		return nil, errWatchOnlyNodeAccount
+	}
+
+	// The key of an external node account never leaves the signer
+	if w.externalNodeAccount != nil {
+		return nil, errExternalNodeKey
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
@@ -190,6 +240,20 @@ func (w *Wallet) getNodeDerivedKey(index uint) (*hdkeychain.ExtendedKey, string,
 
 // Get the node hex encoding public key
 func (w *Wallet) GetNodePubkey() (string, error) {
+	// The key of a watched node account isn't here at all
+	if w.watchOnlyNodeAddress != nil {
+		return "", errWatchOnlyNodeAccount
+	}
+
+	// Recover the public key of an external node account
+	if w.externalNodeAccount != nil {
+		publickeyBytes, err := w.getExternalNodePubkey()
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(publickeyBytes), nil
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return "", errors.New("Wallet is not initialized")
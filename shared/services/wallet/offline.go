@@ -0,0 +1,38 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Use a node account whose key is kept on another machine. Its transactions are left unsigned, so they can be
+// exported and signed there.
+func (w *Wallet) SetOfflineNodeAccount(address common.Address) {
+	w.offlineNodeAddress = &address
+}
+
+// Check if the node account's key is kept on another machine
+func (w *Wallet) IsOffline() bool {
+	return w.offlineNodeAddress != nil
+}
+
+// Get a transactor for the offline node account that passes transactions through unsigned
+func (w *Wallet) getOfflineNodeAccountTransactor() *bind.TransactOpts {
+	nodeAddress := *w.offlineNodeAddress
+	return &bind.TransactOpts{
+		From: nodeAddress,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != nodeAddress {
+				return nil, bind.ErrNotAuthorized
+			}
+			return tx, nil
+		},
+		GasFeeCap: w.maxFee,
+		GasTipCap: w.maxPriorityFee,
+		GasLimit:  w.gasLimit,
+		Context:   context.Background(),
+	}
+}
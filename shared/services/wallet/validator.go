@@ -187,7 +187,7 @@ func (w *Wallet) StoreValidatorKey(key *eth2types.BLSPrivateKey, path string) er
 
 func (w *Wallet) StoreValidatorPresignKey(key *eth2types.BLSPrivateKey, path string) error {
 
-	for name := range w.keystores {
+	for name := range w.keystoresPresign {
 		// Update the keystore in the wallet - using an iterator variable only runs it on the local copy
 		if err := w.keystoresPresign[name].StoreValidatorKey(key, path); err != nil {
 			return fmt.Errorf("Could not store %s  presign key for : %w", name, err)
@@ -383,6 +383,11 @@ func (w *Wallet) RebuildLodestarValidatorKeys() error {
 // Get a validator private key by index
 func (w *Wallet) getValidatorPrivateKey(index uint) (*eth2types.BLSPrivateKey, string, error) {
 
+	// The validator keys of a watched node account aren't here either
+	if w.watchOnlyNodeAddress != nil {
+		return nil, "", errWatchOnlyNodeAccount
+	}
+
 	// Get derivation path
 	derivationPath := fmt.Sprintf(ValidatorKeyPath, index)
 
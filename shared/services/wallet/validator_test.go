@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"testing"
+
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/stader-labs/stader-node/shared/services/wallet/keystore"
+)
+
+// Keystore that only counts the keys stored in it
+type countingKeystore struct {
+	stored int
+}
+
+func (ks *countingKeystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+	ks.stored++
+	return nil
+}
+
+func (ks *countingKeystore) GetKeystoreDir() string {
+	return ""
+}
+
+func TestStoreValidatorPresignKey(t *testing.T) {
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatal(err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The validator keystores don't have to match the presign ones, e.g. with a remote signer
+	validatorKeystore := &countingKeystore{}
+	presignKeystores := map[string]*countingKeystore{
+		"lighthouse": {},
+		"teku":       {},
+	}
+	w := &Wallet{
+		keystores:        map[string]keystore.Keystore{"web3signer": validatorKeystore},
+		keystoresPresign: map[string]keystore.Keystore{},
+	}
+	for name, ks := range presignKeystores {
+		w.AddPresignKeystore(name, ks)
+	}
+
+	if err := w.StoreValidatorPresignKey(key, "m/12381/3600/0/0/0"); err != nil {
+		t.Fatal(err)
+	}
+	for name, ks := range presignKeystores {
+		if ks.stored != 1 {
+			t.Errorf("expected the %s presign keystore to store the key once, got %d", name, ks.stored)
+		}
+	}
+	if validatorKeystore.stored != 0 {
+		t.Errorf("expected the validator keystore to be left alone, got %d keys", validatorKeystore.stored)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
@@ -84,6 +85,15 @@ type Wallet struct {
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
+
+	// Node account whose key is kept on another machine
+	offlineNodeAddress *common.Address
+
+	// Node account that is watched without its keys
+	watchOnlyNodeAddress *common.Address
+
+	// Node account whose key is held by an external signer
+	externalNodeAccount *externalNodeAccount
 }
 
 // Encrypted wallet store
@@ -176,6 +186,11 @@ func (w *Wallet) String() (string, error) {
 // Initialize the wallet from a random seed
 func (w *Wallet) Initialize(derivationPath string, walletIndex uint) (string, error) {
 
+	// A watched node account has no wallet here
+	if w.watchOnlyNodeAddress != nil {
+		return "", errWatchOnlyNodeAccount
+	}
+
 	// Check wallet is not initialized
 	if w.IsInitialized() {
 		return "", errors.New("Wallet is already initialized")
@@ -206,6 +221,11 @@ func (w *Wallet) Initialize(derivationPath string, walletIndex uint) (string, er
 // Recover a wallet from a mnemonic
 func (w *Wallet) Recover(derivationPath string, walletIndex uint, mnemonic string) error {
 
+	// A watched node account has no wallet here
+	if w.watchOnlyNodeAddress != nil {
+		return errWatchOnlyNodeAccount
+	}
+
 	// Check wallet is not initialized
 	if w.IsInitialized() {
 		return errors.New("Wallet is already initialized")
@@ -302,22 +322,35 @@ func (w *Wallet) Delete() error {
 
 // Signs a serialized TX using the wallet's private key
 func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
-	// Get private key
-	privateKey, _, err := w.getNodePrivateKey()
-	if err != nil {
-		return nil, err
+	if w.watchOnlyNodeAddress != nil {
+		return nil, errWatchOnlyNodeAccount
 	}
 
 	tx := types.Transaction{}
-	err = tx.UnmarshalBinary(serializedTx)
+	err := tx.UnmarshalBinary(serializedTx)
 	if err != nil {
 		return nil, fmt.Errorf("Error unmarshalling TX: %w", err)
 	}
 
-	signer := types.NewLondonSigner(w.chainID)
-	signedTx, err := types.SignTx(&tx, signer, privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("Error signing TX: %w", err)
+	var signedTx *types.Transaction
+	if w.externalNodeAccount != nil {
+		// Have the external signer sign it
+		signedTx, err = w.signExternalTx(&tx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Get private key
+		privateKey, _, err := w.getNodePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		signer := types.NewLondonSigner(w.chainID)
+		signedTx, err = types.SignTx(&tx, signer, privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error signing TX: %w", err)
+		}
 	}
 
 	signedData, err := signedTx.MarshalBinary()
@@ -330,16 +363,30 @@ func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
 
 // Signs an arbitrary message using the wallet's private key
 func (w *Wallet) SignMessage(message string) ([]byte, error) {
-	// Get the wallet's private key
-	privateKey, _, err := w.getNodePrivateKey()
-	if err != nil {
-		return nil, err
-	}
-
-	messageHash := accounts.TextHash([]byte(message))
-	signedMessage, err := crypto.Sign(messageHash, privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("Error signing message: %w", err)
+	if w.watchOnlyNodeAddress != nil {
+		return nil, errWatchOnlyNodeAccount
+	}
+
+	var signedMessage []byte
+	if w.externalNodeAccount != nil {
+		// Have the external signer sign it
+		var err error
+		signedMessage, err = w.signExternalMessage([]byte(message))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Get the wallet's private key
+		privateKey, _, err := w.getNodePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		messageHash := accounts.TextHash([]byte(message))
+		signedMessage, err = crypto.Sign(messageHash, privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error signing message: %w", err)
+		}
 	}
 
 	// fix the ECDSA 'v' (see https://medium.com/mycrypto/the-magic-of-digital-signatures-on-ethereum-98fe184dc9c7#:~:text=The%20version%20number,2%E2%80%9D%20was%20introduced)
@@ -0,0 +1,21 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Returned by everything that needs the key of a watched node account
+var errWatchOnlyNodeAccount = errors.New("The node account is only being watched with --address, so its keys aren't available and it can't send transactions. Run the command again without --address to use the node wallet.")
+
+// Watch a node account without its keys, so its status can be read on any machine. Anything that needs its keys is
+// refused.
+func (w *Wallet) SetWatchOnlyNodeAccount(address common.Address) {
+	w.watchOnlyNodeAddress = &address
+}
+
+// Check if the node account is only being watched
+func (w *Wallet) IsWatchOnly() bool {
+	return w.watchOnlyNodeAddress != nil
+}
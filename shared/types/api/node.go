@@ -4,13 +4,18 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/stader-labs/stader-node/shared/types/events"
+	"github.com/stader-labs/stader-node/shared/types/history"
 	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	"github.com/stader-labs/stader-node/shared/types/transactions"
 
 	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
 	"github.com/stader-labs/stader-node/stader-lib/stader"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/stader-labs/stader-node/stader-lib/tokens"
 )
@@ -186,6 +191,49 @@ type SendPresignedMsgResponse struct {
 	SignedMsg       types.ValidatorSignature `json:"signedMsg"`
 }
 
+type PresignLedgerEntry struct {
+	Pubkey         string `json:"pubkey"`
+	ValidatorIndex uint64 `json:"validatorIndex"`
+	ExitEpoch      uint64 `json:"exitEpoch"`
+	Status         string `json:"status"`
+	Retries        uint64 `json:"retries"`
+	LastError      string `json:"lastError"`
+	SignedAt       int64  `json:"signedAt"`
+	LastAttemptAt  int64  `json:"lastAttemptAt"`
+	AcknowledgedAt int64  `json:"acknowledgedAt"`
+}
+
+type PresignStatusResponse struct {
+	Status                 string               `json:"status"`
+	Error                  string               `json:"error"`
+	LedgerPath             string               `json:"ledgerPath"`
+	LastPassStartedAt      int64                `json:"lastPassStartedAt"`
+	LastPassFinishedAt     int64                `json:"lastPassFinishedAt"`
+	LastPassKeysChecked    uint64               `json:"lastPassKeysChecked"`
+	LastPassKeysSigned     uint64               `json:"lastPassKeysSigned"`
+	LastPassKeysSent       uint64               `json:"lastPassKeysSent"`
+	LastPassBackendError   string               `json:"lastPassBackendError"`
+	Entries                []PresignLedgerEntry `json:"entries"`
+	UntrackedValidatorKeys []string             `json:"untrackedValidatorKeys"`
+}
+
+type ExportedExit struct {
+	ValidatorPubKey types.ValidatorPubkey `json:"validatorPubKey"`
+	ValidatorIndex  uint64                `json:"validatorIndex"`
+	FileName        string                `json:"fileName"`
+}
+
+type ExportExitsResponse struct {
+	Status       string            `json:"status"`
+	Error        string            `json:"error"`
+	ExitEpoch    uint64            `json:"exitEpoch"`
+	CurrentEpoch uint64            `json:"currentEpoch"`
+	Encrypted    bool              `json:"encrypted"`
+	OutputPath   string            `json:"outputPath"`
+	Exported     []ExportedExit    `json:"exported"`
+	Skipped      map[string]string `json:"skipped"`
+}
+
 type CanExitValidatorResponse struct {
 	Status                 string `json:"status"`
 	Error                  string `json:"error"`
@@ -201,6 +249,37 @@ type ExitValidatorResponse struct {
 	Error          string `json:"error"`
 }
 
+type ValidatorExitPlan struct {
+	ValidatorPubKey           types.ValidatorPubkey `json:"validatorPubKey"`
+	ValidatorIndex            uint64                `json:"validatorIndex"`
+	ActivationEpoch           uint64                `json:"activationEpoch"`
+	NotRegisteredWithOperator bool                  `json:"notRegisteredWithOperator"`
+	ValidatorKeyMissing       bool                  `json:"validatorKeyMissing"`
+	ValidatorNotRegistered    bool                  `json:"validatorNotRegistered"`
+	ValidatorTooYoung         bool                  `json:"validatorTooYoung"`
+	ValidatorExiting          bool                  `json:"validatorExiting"`
+	ValidatorNotActive        bool                  `json:"validatorNotActive"`
+	CanExit                   bool                  `json:"canExit"`
+	Reason                    string                `json:"reason"`
+}
+
+type CanExitValidatorsResponse struct {
+	Status       string              `json:"status"`
+	Error        string              `json:"error"`
+	CurrentEpoch uint64              `json:"currentEpoch"`
+	Validators   []ValidatorExitPlan `json:"validators"`
+}
+
+type ExitValidatorsResponse struct {
+	Status         string                  `json:"status"`
+	Error          string                  `json:"error"`
+	BeaconChainUrl string                  `json:"beaconChainUrl"`
+	ExitEpoch      uint64                  `json:"exitEpoch"`
+	Exited         []types.ValidatorPubkey `json:"exited"`
+	Skipped        map[string]string       `json:"skipped"`
+	Failed         map[string]string       `json:"failed"`
+}
+
 type CanUpdateSocializeElResponse struct {
 	Status                             string         `json:"status"`
 	Error                              string         `json:"error"`
@@ -236,6 +315,29 @@ type SendClRewardsResponse struct {
 	TxHash                common.Hash    `json:"txHash"`
 }
 
+type CanSweepClRewardsResponse struct {
+	Status           string                `json:"status"`
+	Error            string                `json:"error"`
+	RewardsThreshold *big.Int              `json:"rewardsThreshold"`
+	Vaults           []stdr.ClRewardsVault `json:"vaults"`
+	TotalRewards     *big.Int              `json:"totalRewards"`
+	GasInfo          stader.GasInfo        `json:"gasInfo"`
+}
+
+type SweptClRewards struct {
+	ValidatorPubKey types.ValidatorPubkey `json:"validatorPubKey"`
+	ClRewardsAmount *big.Int              `json:"clRewardsAmount"`
+	TxHash          common.Hash           `json:"txHash"`
+}
+
+type SweepClRewardsResponse struct {
+	Status                string            `json:"status"`
+	Error                 string            `json:"error"`
+	Swept                 []SweptClRewards  `json:"swept"`
+	Failed                map[string]string `json:"failed"`
+	OperatorRewardAddress common.Address    `json:"operatorRewardAddress"`
+}
+
 type CanSettleExitFunds struct {
 	Status                 string         `json:"status"`
 	Error                  string         `json:"error"`
@@ -306,9 +408,63 @@ type CanDownloadSpMerkleProofsResponse struct {
 }
 
 type DownloadSpMerkleProofsResponse struct {
-	Status           string  `json:"status"`
-	Error            string  `json:"error"`
-	DownloadedCycles []int64 `json:"downloadedCycles"`
+	Status            string  `json:"status"`
+	Error             string  `json:"error"`
+	DownloadedCycles  []int64 `json:"downloadedCycles"`
+	QuarantinedCycles []int64 `json:"quarantinedCycles"`
+}
+
+type NodeHistoryResponse struct {
+	Status        string                    `json:"status"`
+	Error         string                    `json:"error"`
+	RetentionDays uint64                    `json:"retentionDays"`
+	Snapshots     []history.MetricsSnapshot `json:"snapshots"`
+}
+
+type NodeEventsResponse struct {
+	Status       string         `json:"status"`
+	Error        string         `json:"error"`
+	IndexedBlock uint64         `json:"indexedBlock"`
+	Events       []events.Event `json:"events"`
+}
+
+// A realized income, expense or transfer of the operator, valued in ETH at its block
+type ReportEntry struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	Timestamp   int64       `json:"timestamp"`
+	TxHash      common.Hash `json:"txHash"`
+	Category    string      `json:"category"`
+	Kind        string      `json:"kind"`
+	Asset       string      `json:"asset"`
+	Amount      *big.Int    `json:"amount"`
+	EthValue    *big.Int    `json:"ethValue"`
+	Note        string      `json:"note"`
+}
+
+type NodeReportResponse struct {
+	Status        string        `json:"status"`
+	Error         string        `json:"error"`
+	IndexedBlock  uint64        `json:"indexedBlock"`
+	IndexedTime   int64         `json:"indexedTime"`
+	UsedArchiveEc bool          `json:"usedArchiveEc"`
+	Entries       []ReportEntry `json:"entries"`
+}
+
+type GenerateSpTreeResponse struct {
+	Status            string                            `json:"status"`
+	Error             string                            `json:"error"`
+	Cycle             int64                             `json:"cycle"`
+	StartBlock        uint64                            `json:"startBlock"`
+	EndBlock          uint64                            `json:"endBlock"`
+	Root              common.Hash                       `json:"root"`
+	OnChainRoot       common.Hash                       `json:"onChainRoot"`
+	RootMatches       bool                              `json:"rootMatches"`
+	OperatorCount     int                               `json:"operatorCount"`
+	Approximation     bool                              `json:"approximation"`
+	NodeRewards       *stader_backend.CycleMerkleProofs `json:"nodeRewards"`
+	DownloadedRewards *stader_backend.CycleMerkleProofs `json:"downloadedRewards"`
+	UsedArchiveEc     bool                              `json:"usedArchiveEc"`
+	TreeFilePath      string                            `json:"treeFilePath"`
 }
 
 type DetailedMerkleProofInfo struct {
@@ -391,12 +547,42 @@ type SetRewardAddress struct {
 	TxHash common.Hash `json:"txHash"`
 }
 
+type ConfirmRewardAddressTxResponse struct {
+	Status                string              `json:"status"`
+	Error                 string              `json:"error"`
+	NoPendingChange       bool                `json:"noPendingChange"`
+	OperatorAddress       common.Address      `json:"operatorAddress"`
+	RewardAddress         common.Address      `json:"rewardAddress"`
+	ProposedRewardAddress common.Address      `json:"proposedRewardAddress"`
+	CurrentBlock          uint64              `json:"currentBlock"`
+	Transaction           UnsignedTransaction `json:"transaction"`
+	// The EIP-2718 encoded payload to sign
+	RawTransaction hexutil.Bytes `json:"rawTransaction"`
+	Eip681Uri      string        `json:"eip681Uri"`
+}
+
+type RewardAddressChangeStatusResponse struct {
+	Status                string         `json:"status"`
+	Error                 string         `json:"error"`
+	RewardAddress         common.Address `json:"rewardAddress"`
+	ProposedRewardAddress common.Address `json:"proposedRewardAddress"`
+	Confirmed             bool           `json:"confirmed"`
+	ConfirmedBlock        uint64         `json:"confirmedBlock"`
+	ConfirmedTxHash       common.Hash    `json:"confirmedTxHash"`
+}
+
 type NodeSignResponse struct {
 	Status     string `json:"status"`
 	Error      string `json:"error"`
 	SignedData string `json:"signedData"`
 }
 
+type NodeSendSignedTxResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
 type CanClaimRewards struct {
 	Status            string         `json:"status"`
 	Error             string         `json:"error"`
@@ -462,6 +648,17 @@ type SdStatusResponse struct {
 	AlreadyLiquidated         bool       `json:"alreadyLiquidated"`
 }
 
+type SdRiskResponse struct {
+	Status   string                 `json:"status"`
+	Error    string                 `json:"error"`
+	Position sdutility.RiskPosition `json:"position"`
+	// The health factor reported by the pool, from the interest accrued up to its last update
+	HealthFactor          *big.Int `json:"healthFactor"`
+	ConservativeEthPerKey *big.Int `json:"conservativeEthPerKey"`
+	SdBalance             *big.Int `json:"sdBalance"`
+	AlreadyLiquidated     bool     `json:"alreadyLiquidated"`
+}
+
 type NodeRepayExcessSDResponse struct {
 	Status string      `json:"status"`
 	Error  string      `json:"error"`
@@ -473,3 +670,33 @@ type CanRepayExcessSDResponse struct {
 	Error   string         `json:"error"`
 	GasInfo stader.GasInfo `json:"gasInfo"`
 }
+
+type TxListResponse struct {
+	Status       string                     `json:"status"`
+	Error        string                     `json:"error"`
+	Transactions []transactions.Transaction `json:"transactions"`
+	LatestNonce  uint64                     `json:"latestNonce"`
+	PendingNonce uint64                     `json:"pendingNonce"`
+}
+
+type CanReplaceTxResponse struct {
+	Status            string                   `json:"status"`
+	Error             string                   `json:"error"`
+	NotFound          bool                     `json:"notFound"`
+	NotPending        bool                     `json:"notPending"`
+	FeesTooLow        bool                     `json:"feesTooLow"`
+	Transaction       transactions.Transaction `json:"transaction"`
+	MinMaxFee         *big.Int                 `json:"minMaxFee"`
+	MinMaxPriorityFee *big.Int                 `json:"minMaxPriorityFee"`
+	MaxFee            *big.Int                 `json:"maxFee"`
+	MaxPriorityFee    *big.Int                 `json:"maxPriorityFee"`
+	GasLimit          uint64                   `json:"gasLimit"`
+}
+
+type ReplaceTxResponse struct {
+	Status         string      `json:"status"`
+	Error          string      `json:"error"`
+	TxHash         common.Hash `json:"txHash"`
+	MaxFee         *big.Int    `json:"maxFee"`
+	MaxPriorityFee *big.Int    `json:"maxPriorityFee"`
+}
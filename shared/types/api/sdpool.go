@@ -0,0 +1,104 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+type SdPoolWithdrawRequest struct {
+	RequestId      *big.Int `json:"requestId"`
+	AmountOfCToken *big.Int `json:"amountOfCToken"`
+	SdExpected     *big.Int `json:"sdExpected"`
+	SdFinalized    *big.Int `json:"sdFinalized"`
+	RequestBlock   *big.Int `json:"requestBlock"`
+	// The block from which the request can be finalized
+	FinalizableBlock *big.Int `json:"finalizableBlock"`
+	Finalized        bool     `json:"finalized"`
+}
+
+type SdPoolStatusResponse struct {
+	Status                  string                  `json:"status"`
+	Error                   string                  `json:"error"`
+	AccountAddress          common.Address          `json:"accountAddress"`
+	SdBalance               *big.Int                `json:"sdBalance"`
+	CTokenBalance           *big.Int                `json:"cTokenBalance"`
+	DelegatedSdBalance      *big.Int                `json:"delegatedSdBalance"`
+	WithdrawRequestedCToken *big.Int                `json:"withdrawRequestedCToken"`
+	ExchangeRate            *big.Int                `json:"exchangeRate"`
+	DelegationRate          *big.Float              `json:"delegationRate"`
+	EstimatedYearlyYield    *big.Int                `json:"estimatedYearlyYield"`
+	UtilizationRate         *big.Float              `json:"utilizationRate"`
+	PoolAvailableSDBalance  *big.Int                `json:"poolAvailableSDBalance"`
+	TotalUtilizedSd         *big.Int                `json:"totalUtilizedSd"`
+	MinDelegateAmount       *big.Int                `json:"minDelegateAmount"`
+	MinWithdrawAmount       *big.Int                `json:"minWithdrawAmount"`
+	MaxOpenRequests         *big.Int                `json:"maxOpenRequests"`
+	CurrentBlock            uint64                  `json:"currentBlock"`
+	Paused                  bool                    `json:"paused"`
+	WithdrawRequests        []SdPoolWithdrawRequest `json:"withdrawRequests"`
+}
+
+type CanSdPoolDelegateResponse struct {
+	Status              string         `json:"status"`
+	Error               string         `json:"error"`
+	InsufficientBalance bool           `json:"insufficientBalance"`
+	BelowMinimum        bool           `json:"belowMinimum"`
+	Paused              bool           `json:"paused"`
+	GasInfo             stader.GasInfo `json:"gasInfo"`
+}
+
+type SdPoolDelegateResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
+type CanSdPoolRequestWithdrawResponse struct {
+	Status              string         `json:"status"`
+	Error               string         `json:"error"`
+	InsufficientBalance bool           `json:"insufficientBalance"`
+	BelowMinimum        bool           `json:"belowMinimum"`
+	TooManyRequests     bool           `json:"tooManyRequests"`
+	Paused              bool           `json:"paused"`
+	GasInfo             stader.GasInfo `json:"gasInfo"`
+}
+
+type SdPoolRequestWithdrawResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
+type CanSdPoolFinalizeResponse struct {
+	Status            string         `json:"status"`
+	Error             string         `json:"error"`
+	NothingToFinalize bool           `json:"nothingToFinalize"`
+	Paused            bool           `json:"paused"`
+	GasInfo           stader.GasInfo `json:"gasInfo"`
+}
+
+type SdPoolFinalizeResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
+type CanSdPoolClaimResponse struct {
+	Status         string         `json:"status"`
+	Error          string         `json:"error"`
+	RequestMissing bool           `json:"requestMissing"`
+	NotOwner       bool           `json:"notOwner"`
+	NotFinalized   bool           `json:"notFinalized"`
+	Paused         bool           `json:"paused"`
+	SdAmount       *big.Int       `json:"sdAmount"`
+	GasInfo        stader.GasInfo `json:"gasInfo"`
+}
+
+type SdPoolClaimResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
@@ -0,0 +1,12 @@
+package eth2
+
+// Signed voluntary exit in the JSON format of the beacon node API (POST /eth/v1/beacon/pool/voluntary_exits)
+type SignedVoluntaryExit struct {
+	Message   SignedVoluntaryExitMessage `json:"message"`
+	Signature string                     `json:"signature"`
+}
+
+type SignedVoluntaryExitMessage struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
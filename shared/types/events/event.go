@@ -0,0 +1,26 @@
+package events
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// A contract event that concerns the operator, as stored by the event indexer
+type Event struct {
+	Contract    string         `json:"contract"`
+	Name        string         `json:"name"`
+	Address     common.Address `json:"address"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	Timestamp   int64          `json:"timestamp"`
+	TxHash      common.Hash    `json:"txHash"`
+	LogIndex    uint           `json:"logIndex"`
+
+	// The event arguments; integers are in decimal, addresses and bytes in hex
+	Args map[string]string `json:"args"`
+}
+
+// The last block the indexer has fully processed; its hash is used to detect reorgs
+type Checkpoint struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+}
@@ -0,0 +1,35 @@
+package history
+
+// A compact copy of the guardian's metrics at one point in time, amounts are in ETH and SD
+type MetricsSnapshot struct {
+	Timestamp        int64  `json:"timestamp"`
+	ElBlockNumber    uint64 `json:"elBlockNumber"`
+	BeaconSlotNumber uint64 `json:"beaconSlotNumber"`
+
+	// Rewards
+	UnclaimedClRewards                   float64 `json:"unclaimedClRewards"`
+	UnclaimedNonSocializingPoolElRewards float64 `json:"unclaimedNonSpElRewards"`
+	UnclaimedSocializingPoolElRewards    float64 `json:"unclaimedSpElRewards"`
+	UnclaimedSocializingPoolSdRewards    float64 `json:"unclaimedSpSdRewards"`
+	ClaimedSocializingPoolElRewards      float64 `json:"claimedSpElRewards"`
+	ClaimedSocializingPoolSdRewards      float64 `json:"claimedSpSdRewards"`
+	ClaimVaultBalance                    float64 `json:"claimVaultBalance"`
+
+	// Collateral
+	SdPrice               float64 `json:"sdPrice"`
+	OperatorStakedSd      float64 `json:"operatorStakedSd"`
+	OperatorSdSelfBond    float64 `json:"operatorSdSelfBond"`
+	OperatorSdUtilized    float64 `json:"operatorSdUtilized"`
+	OperatorEthCollateral float64 `json:"operatorEthCollateral"`
+	SdCollateralPct       float64 `json:"sdCollateralPct"`
+	HealthFactor          float64 `json:"healthFactor"`
+
+	// Validator status counts
+	ActiveValidators            int64 `json:"activeValidators"`
+	StaderQueuedValidators      int64 `json:"staderQueuedValidators"`
+	BeaconChainQueuedValidators int64 `json:"beaconChainQueuedValidators"`
+	ExitingValidators           int64 `json:"exitingValidators"`
+	SlashedValidators           int64 `json:"slashedValidators"`
+	WithdrawnValidators         int64 `json:"withdrawnValidators"`
+	FundsSettledValidators      int64 `json:"fundsSettledValidators"`
+}
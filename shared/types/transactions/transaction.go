@@ -0,0 +1,39 @@
+package transactions
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Transaction statuses
+const (
+	StatusPending   string = "pending"
+	StatusConfirmed string = "confirmed"
+	StatusFailed    string = "failed"
+	StatusReplaced  string = "replaced"
+	StatusDropped   string = "dropped"
+)
+
+// A transaction sent by the node wallet, as stored by the transaction journal
+type Transaction struct {
+	Hash           common.Hash     `json:"hash"`
+	From           common.Address  `json:"from"`
+	To             *common.Address `json:"to"`
+	Nonce          uint64          `json:"nonce"`
+	Value          *big.Int        `json:"value"`
+	Data           hexutil.Bytes   `json:"data"`
+	GasLimit       uint64          `json:"gasLimit"`
+	MaxFee         *big.Int        `json:"maxFee"`
+	MaxPriorityFee *big.Int        `json:"maxPriorityFee"`
+	Purpose        string          `json:"purpose"`
+	SentTime       int64           `json:"sentTime"`
+	Status         string          `json:"status"`
+	BlockNumber    uint64          `json:"blockNumber"`
+}
+
+// Whether the status of the transaction can still change
+func (t *Transaction) IsPending() bool {
+	return t.Status == StatusPending
+}
@@ -75,6 +75,11 @@ func printTransactionHashImpl(staderClient *stader.Client, hash common.Hash, fin
 		return
 	}
 
+	if staderClient.IsOffline() {
+		fmt.Printf("Added the transaction to the offline bundle at %s.\n\n", cfg.StaderNode.GetOfflineBundlePath(false))
+		return
+	}
+
 	txWatchUrl := cfg.StaderNode.GetTxWatchUrl()
 	hashString := hash.String()
 
@@ -263,3 +263,28 @@ func ValidatePubkey(name, value string) (types.ValidatorPubkey, error) {
 	}
 	return pubkey, nil
 }
+
+// Validate a comma separated list of validator pubkeys
+func ValidatePubkeys(name, value string) ([]types.ValidatorPubkey, error) {
+	pubkeys := []types.ValidatorPubkey{}
+	seen := map[types.ValidatorPubkey]bool{}
+	for _, element := range strings.Split(value, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+		pubkey, err := ValidatePubkey(name, element)
+		if err != nil {
+			return nil, err
+		}
+		if seen[pubkey] {
+			continue
+		}
+		seen[pubkey] = true
+		pubkeys = append(pubkeys, pubkey)
+	}
+	if len(pubkeys) == 0 {
+		return nil, fmt.Errorf("invalid %s '%s': no pubkeys given", name, value)
+	}
+	return pubkeys, nil
+}
@@ -0,0 +1,82 @@
+package eth1
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// Build an unsigned EIP-1559 transaction for an account that isn't the node wallet,
+// using its pending nonce and a max fee of twice the current base fee plus the suggested tip
+func NewUnsignedDynamicFeeTx(ec stader.ExecutionClient, chainId *big.Int, from common.Address, to common.Address, value *big.Int, data []byte, gasLimit uint64) (*types.DynamicFeeTx, error) {
+	nonce, err := ec.PendingNonceAt(context.Background(), from)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get the pending nonce of %s: %w", from.Hex(), err)
+	}
+	tip, err := ec.SuggestGasTipCap(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Could not get the suggested priority fee: %w", err)
+	}
+	baseFee, err := GetBaseFee(ec)
+	if err != nil {
+		return nil, err
+	}
+	maxFee := new(big.Int).Mul(baseFee, big.NewInt(2))
+	maxFee.Add(maxFee, tip)
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	return &types.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: maxFee,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}, nil
+}
+
+// Get the base fee of the latest block
+func GetBaseFee(ec stader.ExecutionClient) (*big.Int, error) {
+	header, err := ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get the latest block header: %w", err)
+	}
+	if header.BaseFee == nil {
+		return nil, fmt.Errorf("The latest block has no base fee, EIP-1559 transactions are not supported")
+	}
+	return header.BaseFee, nil
+}
+
+// Encode the payload a signer signs for an EIP-1559 transaction:
+// 0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gas, to, value, data, accessList])
+func EncodeUnsignedDynamicFeeTx(tx *types.DynamicFeeTx) ([]byte, error) {
+	accessList := tx.AccessList
+	if accessList == nil {
+		accessList = types.AccessList{}
+	}
+	payload, err := rlp.EncodeToBytes([]interface{}{
+		tx.ChainID,
+		tx.Nonce,
+		tx.GasTipCap,
+		tx.GasFeeCap,
+		tx.Gas,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		accessList,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode the unsigned transaction: %w", err)
+	}
+
+	return append([]byte{types.DynamicFeeTxType}, payload...), nil
+}
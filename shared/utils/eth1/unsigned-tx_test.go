@@ -0,0 +1,76 @@
+package eth1
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestEncodeUnsignedDynamicFeeTx(t *testing.T) {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	tests := []struct {
+		name string
+		tx   *types.DynamicFeeTx
+	}{
+		{"transfer", &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     5,
+			GasTipCap: big.NewInt(2e9),
+			GasFeeCap: big.NewInt(30e9),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(1e18),
+		}},
+		{"contract call", &types.DynamicFeeTx{
+			ChainID:   big.NewInt(17000),
+			Nonce:     0,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(1),
+			Gas:       250000,
+			To:        &to,
+			Value:     big.NewInt(0),
+			Data:      common.FromHex("0xa9059cbb0000000000000000000000002000000000000000000000000000000000000002"),
+		}},
+		{"contract creation", &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     12,
+			GasTipCap: big.NewInt(0),
+			GasFeeCap: big.NewInt(100e9),
+			Gas:       3000000,
+			Value:     big.NewInt(0),
+			Data:      common.FromHex("0x6080604052"),
+		}},
+		{"access list", &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     1,
+			GasTipCap: big.NewInt(2e9),
+			GasFeeCap: big.NewInt(30e9),
+			Gas:       60000,
+			To:        &to,
+			Value:     big.NewInt(0),
+			AccessList: types.AccessList{{
+				Address:     to,
+				StorageKeys: []common.Hash{common.HexToHash("0x01")},
+			}},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := EncodeUnsignedDynamicFeeTx(test.tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The hash of the payload must be the hash a signer for the chain signs
+			expected := types.LatestSignerForChainID(test.tx.ChainID).Hash(types.NewTx(test.tx))
+			if hash := crypto.Keccak256Hash(encoded); hash != expected {
+				t.Errorf("expected the payload to hash to %s, got %s", expected.Hex(), hash.Hex())
+			}
+		})
+	}
+}
@@ -20,7 +20,10 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package eth2
 
 import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/types/config"
 )
 
 const (
@@ -28,6 +31,15 @@ const (
 	HoleskyCapellaForkVersion = "0x04017000"
 )
 
+// Get the Capella fork version, which voluntary exits are signed for since Deneb (EIP-7044)
+func GetCapellaForkVersion(network config.Network) ([]byte, error) {
+	// TODO - we currently only support mainnet and testnet envs. We will have to update this as we change n/ws
+	if network == config.Network_Mainnet {
+		return hexutil.Decode(MainnetCapellaForkVersion)
+	}
+	return hexutil.Decode(HoleskyCapellaForkVersion)
+}
+
 // Get an eth2 epoch number by time
 func EpochAt(config beacon.Eth2Config, time uint64) uint64 {
 	return config.GenesisEpoch + (time-config.GenesisTime)/config.SecondsPerEpoch
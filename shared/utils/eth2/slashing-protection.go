@@ -0,0 +1,129 @@
+package eth2
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The EIP-3076 interchange format version supported by all validator clients
+const SlashingProtectionInterchangeVersion = "5"
+
+// EIP-3076 slashing protection interchange file
+type SlashingProtectionInterchange struct {
+	Metadata SlashingProtectionMetadata  `json:"metadata"`
+	Data     []SlashingProtectionHistory `json:"data"`
+}
+type SlashingProtectionMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+type SlashingProtectionHistory struct {
+	Pubkey             string                          `json:"pubkey"`
+	SignedBlocks       []SlashingProtectionBlock       `json:"signed_blocks"`
+	SignedAttestations []SlashingProtectionAttestation `json:"signed_attestations"`
+}
+type SlashingProtectionBlock struct {
+	Slot        uint64 `json:"slot,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+type SlashingProtectionAttestation struct {
+	SourceEpoch uint64 `json:"source_epoch,string"`
+	TargetEpoch uint64 `json:"target_epoch,string"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// Decode a slashing protection interchange file
+func ParseSlashingProtectionInterchange(bytes []byte) (*SlashingProtectionInterchange, error) {
+	var interchange SlashingProtectionInterchange
+	if err := json.Unmarshal(bytes, &interchange); err != nil {
+		return nil, fmt.Errorf("Could not decode slashing protection interchange: %w", err)
+	}
+	if interchange.Metadata.InterchangeFormatVersion != SlashingProtectionInterchangeVersion {
+		return nil, fmt.Errorf("Unsupported slashing protection interchange format version [%s]", interchange.Metadata.InterchangeFormatVersion)
+	}
+	return &interchange, nil
+}
+
+// Merge slashing protection interchanges for the same chain, keeping every block and attestation signed by each validator
+func MergeSlashingProtectionInterchanges(interchanges ...*SlashingProtectionInterchange) (*SlashingProtectionInterchange, error) {
+	if len(interchanges) == 0 {
+		return nil, fmt.Errorf("No slashing protection interchanges to merge")
+	}
+
+	merged := &SlashingProtectionInterchange{
+		Metadata: SlashingProtectionMetadata{
+			InterchangeFormatVersion: SlashingProtectionInterchangeVersion,
+			GenesisValidatorsRoot:    strings.ToLower(interchanges[0].Metadata.GenesisValidatorsRoot),
+		},
+		Data: []SlashingProtectionHistory{},
+	}
+
+	histories := map[string]int{}
+	for _, interchange := range interchanges {
+		if !strings.EqualFold(interchange.Metadata.GenesisValidatorsRoot, merged.Metadata.GenesisValidatorsRoot) {
+			return nil, fmt.Errorf("Slashing protection interchanges are for different chains: genesis validators root %s and %s", merged.Metadata.GenesisValidatorsRoot, interchange.Metadata.GenesisValidatorsRoot)
+		}
+
+		for _, history := range interchange.Data {
+			pubkey := strings.ToLower(history.Pubkey)
+			index, exists := histories[pubkey]
+			if !exists {
+				index = len(merged.Data)
+				histories[pubkey] = index
+				merged.Data = append(merged.Data, SlashingProtectionHistory{
+					Pubkey:             pubkey,
+					SignedBlocks:       []SlashingProtectionBlock{},
+					SignedAttestations: []SlashingProtectionAttestation{},
+				})
+			}
+			merged.Data[index].SignedBlocks = append(merged.Data[index].SignedBlocks, history.SignedBlocks...)
+			merged.Data[index].SignedAttestations = append(merged.Data[index].SignedAttestations, history.SignedAttestations...)
+		}
+	}
+
+	for i := range merged.Data {
+		merged.Data[i].SignedBlocks = mergeSignedBlocks(merged.Data[i].SignedBlocks)
+		merged.Data[i].SignedAttestations = mergeSignedAttestations(merged.Data[i].SignedAttestations)
+	}
+
+	return merged, nil
+}
+
+// Sort signed blocks by slot, dropping duplicates
+func mergeSignedBlocks(blocks []SlashingProtectionBlock) []SlashingProtectionBlock {
+	seen := map[SlashingProtectionBlock]bool{}
+	merged := []SlashingProtectionBlock{}
+	for _, block := range blocks {
+		block.SigningRoot = strings.ToLower(block.SigningRoot)
+		if !seen[block] {
+			seen[block] = true
+			merged = append(merged, block)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Slot < merged[j].Slot
+	})
+	return merged
+}
+
+// Sort signed attestations by target epoch, dropping duplicates
+func mergeSignedAttestations(attestations []SlashingProtectionAttestation) []SlashingProtectionAttestation {
+	seen := map[SlashingProtectionAttestation]bool{}
+	merged := []SlashingProtectionAttestation{}
+	for _, attestation := range attestations {
+		attestation.SigningRoot = strings.ToLower(attestation.SigningRoot)
+		if !seen[attestation] {
+			seen[attestation] = true
+			merged = append(merged, attestation)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].TargetEpoch != merged[j].TargetEpoch {
+			return merged[i].TargetEpoch < merged[j].TargetEpoch
+		}
+		return merged[i].SourceEpoch < merged[j].SourceEpoch
+	})
+	return merged
+}
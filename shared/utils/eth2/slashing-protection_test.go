@@ -0,0 +1,198 @@
+package eth2
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	genesisRoot = "0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1"
+	pubkey      = "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+	otherPubkey = "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"
+)
+
+func newInterchange(root string, histories ...SlashingProtectionHistory) *SlashingProtectionInterchange {
+	return &SlashingProtectionInterchange{
+		Metadata: SlashingProtectionMetadata{
+			InterchangeFormatVersion: SlashingProtectionInterchangeVersion,
+			GenesisValidatorsRoot:    root,
+		},
+		Data: histories,
+	}
+}
+
+func TestMergeSlashingProtectionInterchanges(t *testing.T) {
+	tests := []struct {
+		name         string
+		interchanges []*SlashingProtectionInterchange
+		expected     []SlashingProtectionHistory
+		err          string
+	}{
+		{
+			name: "overlapping pubkeys with different case",
+			interchanges: []*SlashingProtectionInterchange{
+				newInterchange(genesisRoot, SlashingProtectionHistory{
+					Pubkey:             pubkey,
+					SignedBlocks:       []SlashingProtectionBlock{{Slot: 20}},
+					SignedAttestations: []SlashingProtectionAttestation{{SourceEpoch: 4, TargetEpoch: 5}},
+				}),
+				newInterchange(strings.ToUpper(genesisRoot), SlashingProtectionHistory{
+					Pubkey:             strings.ToUpper(pubkey),
+					SignedBlocks:       []SlashingProtectionBlock{{Slot: 10}},
+					SignedAttestations: []SlashingProtectionAttestation{{SourceEpoch: 2, TargetEpoch: 3}},
+				}),
+			},
+			expected: []SlashingProtectionHistory{{
+				Pubkey:             pubkey,
+				SignedBlocks:       []SlashingProtectionBlock{{Slot: 10}, {Slot: 20}},
+				SignedAttestations: []SlashingProtectionAttestation{{SourceEpoch: 2, TargetEpoch: 3}, {SourceEpoch: 4, TargetEpoch: 5}},
+			}},
+		},
+		{
+			name: "duplicate and unique signing roots",
+			interchanges: []*SlashingProtectionInterchange{
+				newInterchange(genesisRoot, SlashingProtectionHistory{
+					Pubkey: pubkey,
+					SignedBlocks: []SlashingProtectionBlock{
+						{Slot: 10, SigningRoot: "0xAA"},
+						{Slot: 11, SigningRoot: "0xbb"},
+					},
+					SignedAttestations: []SlashingProtectionAttestation{
+						{SourceEpoch: 2, TargetEpoch: 3, SigningRoot: "0xCC"},
+					},
+				}),
+				newInterchange(genesisRoot, SlashingProtectionHistory{
+					Pubkey: pubkey,
+					SignedBlocks: []SlashingProtectionBlock{
+						{Slot: 10, SigningRoot: "0xaa"},
+						{Slot: 11, SigningRoot: "0xdd"},
+					},
+					SignedAttestations: []SlashingProtectionAttestation{
+						{SourceEpoch: 2, TargetEpoch: 3, SigningRoot: "0xcc"},
+						{SourceEpoch: 1, TargetEpoch: 3, SigningRoot: "0xee"},
+					},
+				}),
+			},
+			expected: []SlashingProtectionHistory{{
+				Pubkey: pubkey,
+				SignedBlocks: []SlashingProtectionBlock{
+					{Slot: 10, SigningRoot: "0xaa"},
+					{Slot: 11, SigningRoot: "0xbb"},
+					{Slot: 11, SigningRoot: "0xdd"},
+				},
+				SignedAttestations: []SlashingProtectionAttestation{
+					{SourceEpoch: 1, TargetEpoch: 3, SigningRoot: "0xee"},
+					{SourceEpoch: 2, TargetEpoch: 3, SigningRoot: "0xcc"},
+				},
+			}},
+		},
+		{
+			name: "separate validators",
+			interchanges: []*SlashingProtectionInterchange{
+				newInterchange(genesisRoot, SlashingProtectionHistory{
+					Pubkey:       pubkey,
+					SignedBlocks: []SlashingProtectionBlock{{Slot: 10}},
+				}),
+				newInterchange(genesisRoot, SlashingProtectionHistory{
+					Pubkey:             otherPubkey,
+					SignedAttestations: []SlashingProtectionAttestation{{SourceEpoch: 2, TargetEpoch: 3}},
+				}),
+			},
+			expected: []SlashingProtectionHistory{
+				{
+					Pubkey:             pubkey,
+					SignedBlocks:       []SlashingProtectionBlock{{Slot: 10}},
+					SignedAttestations: []SlashingProtectionAttestation{},
+				},
+				{
+					Pubkey:             otherPubkey,
+					SignedBlocks:       []SlashingProtectionBlock{},
+					SignedAttestations: []SlashingProtectionAttestation{{SourceEpoch: 2, TargetEpoch: 3}},
+				},
+			},
+		},
+		{
+			name: "genesis validators root mismatch",
+			interchanges: []*SlashingProtectionInterchange{
+				newInterchange(genesisRoot),
+				newInterchange("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+			},
+			err: "different chains",
+		},
+		{
+			name: "nothing to merge",
+			err:  "No slashing protection interchanges",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, err := MergeSlashingProtectionInterchanges(test.interchanges...)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected an error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if merged.Metadata.InterchangeFormatVersion != SlashingProtectionInterchangeVersion || merged.Metadata.GenesisValidatorsRoot != genesisRoot {
+				t.Errorf("unexpected metadata %+v", merged.Metadata)
+			}
+			if !reflect.DeepEqual(merged.Data, test.expected) {
+				t.Errorf("expected\n%+v\ngot\n%+v", test.expected, merged.Data)
+			}
+		})
+	}
+}
+
+func TestParseSlashingProtectionInterchange(t *testing.T) {
+	tests := []struct {
+		name        string
+		interchange string
+		err         string
+	}{
+		{
+			name: "version 5",
+			interchange: `{
+				"metadata": {"interchange_format_version": "5", "genesis_validators_root": "` + genesisRoot + `"},
+				"data": [{
+					"pubkey": "` + pubkey + `",
+					"signed_blocks": [{"slot": "81952", "signing_root": "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"}],
+					"signed_attestations": [{"source_epoch": "2290", "target_epoch": "3007"}]
+				}]
+			}`,
+		},
+		{
+			name:        "unsupported version",
+			interchange: `{"metadata": {"interchange_format_version": "4", "genesis_validators_root": "` + genesisRoot + `"}, "data": []}`,
+			err:         "Unsupported slashing protection interchange format version [4]",
+		},
+		{
+			name:        "invalid JSON",
+			interchange: `{"metadata": `,
+			err:         "Could not decode",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			interchange, err := ParseSlashingProtectionInterchange([]byte(test.interchange))
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected an error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			history := interchange.Data[0]
+			if history.SignedBlocks[0].Slot != 81952 || history.SignedAttestations[0].SourceEpoch != 2290 || history.SignedAttestations[0].TargetEpoch != 3007 {
+				t.Errorf("unexpected history %+v", history)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package stader
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mitchellh/go-homedir"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// Returned when the cycle of a merkle proof has no root on chain yet, so it can't be checked
+var ErrMerkleRootNotReported = errors.New("merkle root not reported on chain yet")
+
+// Parse the amounts, proof and root out of a cycle merkle proof
+func DecodeCycleMerkleProofs(cycleMerkleProof *stader_backend.CycleMerkleProofs) (*big.Int, *big.Int, [][32]byte, [32]byte, error) {
+	amountSd, ok := big.NewInt(0).SetString(cycleMerkleProof.Sd, 10)
+	if !ok {
+		return nil, nil, nil, [32]byte{}, fmt.Errorf("could not parse sd amount %s", cycleMerkleProof.Sd)
+	}
+	amountEth, ok := big.NewInt(0).SetString(cycleMerkleProof.Eth, 10)
+	if !ok {
+		return nil, nil, nil, [32]byte{}, fmt.Errorf("could not parse eth amount %s", cycleMerkleProof.Eth)
+	}
+
+	merkleProof := [][32]byte{}
+	for _, proof := range cycleMerkleProof.Proof {
+		proofBytes, err := decodeBytes32(proof)
+		if err != nil {
+			return nil, nil, nil, [32]byte{}, fmt.Errorf("could not parse proof element %s: %w", proof, err)
+		}
+		merkleProof = append(merkleProof, proofBytes)
+	}
+
+	root, err := decodeBytes32(cycleMerkleProof.Root)
+	if err != nil {
+		return nil, nil, nil, [32]byte{}, fmt.Errorf("could not parse root %s: %w", cycleMerkleProof.Root, err)
+	}
+
+	return amountSd, amountEth, merkleProof, root, nil
+}
+
+// Check that a cycle merkle proof hashes up to its own root, and that the root is the one reported on chain for the cycle
+func VerifyCycleMerkleProofs(sp *stader.SocializingPoolContractManager, operator common.Address, cycleMerkleProof *stader_backend.CycleMerkleProofs) error {
+	amountSd, amountEth, merkleProof, root, err := DecodeCycleMerkleProofs(cycleMerkleProof)
+	if err != nil {
+		return err
+	}
+
+	leaf := socializing_pool.ComputeMerkleLeaf(operator, amountSd, amountEth)
+	computedRoot := socializing_pool.ComputeMerkleRoot(leaf, merkleProof)
+	if computedRoot != root {
+		return fmt.Errorf("proof for cycle %d hashes to root %s instead of %s", cycleMerkleProof.Cycle, common.Hash(computedRoot), common.Hash(root))
+	}
+
+	cycle := big.NewInt(cycleMerkleProof.Cycle)
+	onChainRoot, err := socializing_pool.GetCycleMerkleRoot(sp, cycle, nil)
+	if err != nil {
+		return err
+	}
+	if onChainRoot == [32]byte{} {
+		return ErrMerkleRootNotReported
+	}
+	if onChainRoot != root {
+		return fmt.Errorf("root %s for cycle %d does not match on chain root %s", common.Hash(root), cycleMerkleProof.Cycle, common.Hash(onChainRoot))
+	}
+
+	verified, err := socializing_pool.VerifyProof(sp, operator, cycle, amountSd, amountEth, merkleProof, nil)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return fmt.Errorf("socializing pool contract rejected the proof for cycle %d", cycleMerkleProof.Cycle)
+	}
+
+	return nil
+}
+
+// Move a cycle merkle proof which failed verification out of the proofs folder so it is never used for a claim.
+// A proof is kept once per cycle and root, so a bad proof that keeps being served doesn't pile up copies.
+func QuarantineCycleMerkleProofs(cfg *config.StaderConfig, cycleMerkleProof *stader_backend.CycleMerkleProofs) (string, error) {
+	root, err := decodeBytes32(cycleMerkleProof.Root)
+	if err != nil {
+		// Name a proof with an unreadable root after the hash of the root instead
+		root = crypto.Keccak256Hash([]byte(cycleMerkleProof.Root))
+	}
+	quarantinePath, err := homedir.Expand(cfg.StaderNode.GetSpRewardCycleQuarantinePath(cycleMerkleProof.Cycle, root, true))
+	if err != nil {
+		return "", err
+	}
+
+	_, err = os.Stat(quarantinePath)
+	if os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(quarantinePath), 0755); err != nil {
+			return "", fmt.Errorf("could not create quarantine folder: %w", err)
+		}
+		data, err := json.Marshal(cycleMerkleProof)
+		if err != nil {
+			return "", fmt.Errorf("error encoding JSON: %w", err)
+		}
+		if err := os.WriteFile(quarantinePath, data, 0644); err != nil {
+			return "", fmt.Errorf("could not write quarantined proof to %s: %w", quarantinePath, err)
+		}
+	} else if err != nil {
+		return "", fmt.Errorf("could not check quarantined proof %s: %w", quarantinePath, err)
+	}
+
+	cycleMerkleProofFile, err := homedir.Expand(cfg.StaderNode.GetSpRewardCyclePath(cycleMerkleProof.Cycle, true))
+	if err != nil {
+		return "", err
+	}
+	if err := os.Remove(cycleMerkleProofFile); err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("could not remove proof file %s: %w", cycleMerkleProofFile, err)
+	}
+
+	return quarantinePath, nil
+}
+
+// Move a cycle merkle proof file which can't be decoded out of the proofs folder, so a fresh copy can be downloaded.
+// The quarantined file is named after the hash of its contents.
+func QuarantineCorruptCycleMerkleProofs(cfg *config.StaderConfig, cycle int64) (string, error) {
+	cycleMerkleProofFile, err := homedir.Expand(cfg.StaderNode.GetSpRewardCyclePath(cycle, true))
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(cycleMerkleProofFile)
+	if err != nil {
+		return "", fmt.Errorf("could not read proof file %s: %w", cycleMerkleProofFile, err)
+	}
+
+	quarantinePath, err := homedir.Expand(cfg.StaderNode.GetSpRewardCycleQuarantinePath(cycle, crypto.Keccak256Hash(data), true))
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(quarantinePath), 0755); err != nil {
+		return "", fmt.Errorf("could not create quarantine folder: %w", err)
+	}
+	if err := os.Rename(cycleMerkleProofFile, quarantinePath); err != nil {
+		return "", fmt.Errorf("could not move proof file %s to %s: %w", cycleMerkleProofFile, quarantinePath, err)
+	}
+
+	return quarantinePath, nil
+}
+
+func decodeBytes32(value string) ([32]byte, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(data) != 32 {
+		return [32]byte{}, fmt.Errorf("expected 32 bytes, got %d", len(data))
+	}
+
+	var result [32]byte
+	copy(result[:], data)
+
+	return result, nil
+}
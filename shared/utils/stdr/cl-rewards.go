@@ -0,0 +1,126 @@
+package stdr
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	stader_config "github.com/stader-labs/stader-node/stader-lib/stader-config"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// A validator withdraw vault holding CL rewards, and whether a sweep distributes it
+type ClRewardsVault struct {
+	ValidatorPubKey         types.ValidatorPubkey
+	WithdrawVaultAddress    common.Address
+	Balance                 *big.Int
+	OperatorRewards         *big.Int
+	OperatorWithdrawShare   *big.Int
+	CrossedRewardsThreshold bool
+	BelowMinimum            bool
+	SkipReason              string
+	Distribute              bool
+	GasInfo                 stader.GasInfo
+}
+
+// Scan the withdraw vaults of every validator registered with the operator. A vault is distributed when its balance is
+// within the rewards threshold and the operator's share of it is at least the minimum. Vaults past the threshold hold
+// withdrawn stake and must wait for the oracles to settle them; their operator withdraw share is reported instead.
+// A vault whose distribution fails to estimate is skipped with the reason, so it doesn't hold back the others.
+// The vaults to distribute come first, the most rewards per unit of gas first.
+func GetClRewardsVaults(
+	pnr *stader.PermissionlessNodeRegistryContractManager,
+	putils *stader.PoolUtilsContractManager,
+	sdcfg *stader.StaderConfigContractManager,
+	operatorId *big.Int,
+	operatorAddress common.Address,
+	minimum *big.Int,
+	opts *bind.TransactOpts,
+) ([]ClRewardsVault, *big.Int, error) {
+	rewardsThreshold, err := stader_config.GetRewardsThreshold(sdcfg, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorInfoMap, validatorPubKeys, err := GetAllValidatorsRegisteredWithOperator(pnr, operatorId, operatorAddress, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vaults := []ClRewardsVault{}
+	for _, validatorPubKey := range validatorPubKeys {
+		validatorContractInfo := validatorInfoMap[validatorPubKey]
+		if validatorContractInfo.Status == types.ValidatorStatusWithdrawn {
+			continue
+		}
+		withdrawVaultBalance, err := tokens.GetEthBalance(pnr.Client, validatorContractInfo.WithdrawVaultAddress, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if withdrawVaultBalance.Sign() == 0 {
+			continue
+		}
+
+		vault := ClRewardsVault{
+			ValidatorPubKey:      validatorPubKey,
+			WithdrawVaultAddress: validatorContractInfo.WithdrawVaultAddress,
+			Balance:              withdrawVaultBalance,
+			OperatorRewards:      big.NewInt(0),
+		}
+		if withdrawVaultBalance.Cmp(rewardsThreshold) > 0 {
+			withdrawShares, err := node.CalculateValidatorWithdrawVaultWithdrawShare(pnr.Client, validatorContractInfo.WithdrawVaultAddress, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			vault.CrossedRewardsThreshold = true
+			vault.OperatorWithdrawShare = withdrawShares.OperatorShare
+			vaults = append(vaults, vault)
+			continue
+		}
+
+		rewardShares, err := pool_utils.CalculateRewardShare(putils, 1, withdrawVaultBalance, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		vault.OperatorRewards = rewardShares.OperatorShare
+		if vault.OperatorRewards.Sign() == 0 || vault.OperatorRewards.Cmp(minimum) < 0 {
+			vault.BelowMinimum = true
+			vaults = append(vaults, vault)
+			continue
+		}
+
+		gasInfo, err := node.EstimateDistributeRewards(pnr.Client, validatorContractInfo.WithdrawVaultAddress, opts)
+		if err != nil {
+			vault.SkipReason = fmt.Sprintf("the distribution would fail: %s", err.Error())
+			vaults = append(vaults, vault)
+			continue
+		}
+		vault.GasInfo = gasInfo
+		vault.Distribute = true
+		vaults = append(vaults, vault)
+	}
+
+	sortClRewardsVaults(vaults)
+	return vaults, rewardsThreshold, nil
+}
+
+// Put the vaults to distribute first, the most rewards per unit of gas first, and keep the order of the others
+func sortClRewardsVaults(vaults []ClRewardsVault) {
+	sort.SliceStable(vaults, func(i, j int) bool {
+		if vaults[i].Distribute != vaults[j].Distribute {
+			return vaults[i].Distribute
+		}
+		if !vaults[i].Distribute {
+			return false
+		}
+		// rewards_i / gas_i > rewards_j / gas_j
+		left := new(big.Int).Mul(vaults[i].OperatorRewards, new(big.Int).SetUint64(vaults[j].GasInfo.EstGasLimit))
+		right := new(big.Int).Mul(vaults[j].OperatorRewards, new(big.Int).SetUint64(vaults[i].GasInfo.EstGasLimit))
+		return left.Cmp(right) > 0
+	})
+}
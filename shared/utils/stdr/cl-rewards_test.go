@@ -0,0 +1,43 @@
+package stdr
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+func testVault(id byte, rewards int64, gas uint64, distribute bool) ClRewardsVault {
+	return ClRewardsVault{
+		ValidatorPubKey: types.BytesToValidatorPubkey([]byte{id}),
+		OperatorRewards: big.NewInt(rewards),
+		Distribute:      distribute,
+		GasInfo:         stader.GasInfo{EstGasLimit: gas},
+	}
+}
+
+func TestSortClRewardsVaults(t *testing.T) {
+	vaults := []ClRewardsVault{
+		testVault(1, 0, 0, false),
+		testVault(2, 100, 100, true),
+		testVault(3, 300, 100, true),
+		testVault(4, 50, 0, false),
+		testVault(5, 400, 200, true),
+		testVault(6, 1000, 1000, true),
+	}
+
+	sortClRewardsVaults(vaults)
+
+	// The most rewards per unit of gas first, ties and skipped vaults in their original order
+	expected := []byte{3, 5, 2, 6, 1, 4}
+	for i, id := range expected {
+		if vaults[i].ValidatorPubKey != types.BytesToValidatorPubkey([]byte{id}) {
+			got := []byte{}
+			for _, vault := range vaults {
+				got = append(got, vault.ValidatorPubKey.Bytes()[0])
+			}
+			t.Fatalf("expected the vaults in the order %v, got %v", expected, got)
+		}
+	}
+}
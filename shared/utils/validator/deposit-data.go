@@ -22,43 +22,32 @@ package validator
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stader-labs/stader-node/shared/types/eth2"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	"github.com/stader-labs/stader-node/stader-lib/types"
 
 	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/signer"
 )
 
-// Get deposit data & root for a given validator key and withdrawal credentials
-func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, amount uint64) (eth2.DepositData, common.Hash, error) {
-	// Build deposit data
-	dd := eth2.DepositDataNoSignature{
-		PublicKey:             validatorKey.PublicKey().Marshal(),
-		WithdrawalCredentials: withdrawalCredentials[:],
-		Amount:                amount,
-	}
-
-	// Get signing root
-	or, err := dd.HashTreeRoot()
-	if err != nil {
-		return eth2.DepositData{}, common.Hash{}, err
-	}
-
-	sr := eth2.SigningRoot{
-		ObjectRoot: or[:],
-		Domain:     eth2types.Domain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot),
-	}
+// Get deposit data & root for a given validator and withdrawal credentials, signed by the validator key's signer
+func GetDepositData(s signer.Signer, pubkey types.ValidatorPubkey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, amount uint64) (eth2.DepositData, common.Hash, error) {
 
-	// Get signing root with domain
-	srHash, err := sr.HashTreeRoot()
+	// Sign deposit data
+	signature, err := s.SignDeposit(signer.DepositRequest{
+		Pubkey:                pubkey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                amount,
+		GenesisForkVersion:    eth2Config.GenesisForkVersion,
+	})
 	if err != nil {
 		return eth2.DepositData{}, common.Hash{}, err
 	}
 
 	// Build deposit data struct (with signature)
 	var depositData = eth2.DepositData{
-		PublicKey:             dd.PublicKey,
-		WithdrawalCredentials: dd.WithdrawalCredentials,
-		Amount:                dd.Amount,
-		Signature:             validatorKey.Sign(srHash[:]).Marshal(),
+		PublicKey:             pubkey.Bytes(),
+		WithdrawalCredentials: withdrawalCredentials[:],
+		Amount:                amount,
+		Signature:             signature.Bytes(),
 	}
 
 	// Get deposit data root
@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const exitArchiveName = "stader-exit-messages"
+
+// Password protected archive of signed exit messages, encrypted with the EIP-2335 keystore scheme
+type exitArchive struct {
+	Name    string                 `json:"name"`
+	Version uint                   `json:"version"`
+	Crypto  map[string]interface{} `json:"crypto"`
+}
+
+// Zip the given files and write them to path, encrypted with password
+func WriteExitArchive(path string, files map[string][]byte, password string) error {
+
+	// Zip the files in a stable order
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buffer bytes.Buffer
+	zipWriter := zip.NewWriter(&buffer)
+	for _, name := range names {
+		fileWriter, err := zipWriter.Create(name)
+		if err != nil {
+			return fmt.Errorf("could not add %s to the exit archive: %w", name, err)
+		}
+		if _, err := fileWriter.Write(files[name]); err != nil {
+			return fmt.Errorf("could not add %s to the exit archive: %w", name, err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("could not finish the exit archive: %w", err)
+	}
+
+	// Encrypt
+	encryptor := eth2ks.New(eth2ks.WithCipher("scrypt"))
+	encryptedArchive, err := encryptor.Encrypt(buffer.Bytes(), password)
+	if err != nil {
+		return fmt.Errorf("could not encrypt the exit archive: %w", err)
+	}
+	archiveBytes, err := json.Marshal(exitArchive{
+		Name:    exitArchiveName,
+		Version: encryptor.Version(),
+		Crypto:  encryptedArchive,
+	})
+	if err != nil {
+		return fmt.Errorf("could not encode the exit archive: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("could not create the exit archive folder: %w", err)
+	}
+	if err := os.WriteFile(path, archiveBytes, 0600); err != nil {
+		return fmt.Errorf("could not write the exit archive to %s: %w", path, err)
+	}
+
+	return nil
+
+}
+
+// Decrypt the archive at path and return its files
+func ReadExitArchive(path string, password string) (map[string][]byte, error) {
+
+	archiveBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the exit archive %s: %w", path, err)
+	}
+	var archive exitArchive
+	if err := json.Unmarshal(archiveBytes, &archive); err != nil {
+		return nil, fmt.Errorf("could not decode the exit archive %s: %w", path, err)
+	}
+	if archive.Name != exitArchiveName {
+		return nil, fmt.Errorf("%s is not a Stader exit archive", path)
+	}
+
+	encryptor := eth2ks.New()
+	zipBytes, err := encryptor.Decrypt(archive.Crypto, password)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt the exit archive, check the password: %w", err)
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+	if err != nil {
+		return nil, fmt.Errorf("could not open the decrypted exit archive: %w", err)
+	}
+	files := map[string][]byte{}
+	for _, file := range zipReader.File {
+		reader, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("could not open %s in the exit archive: %w", file.Name, err)
+		}
+		contents, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s in the exit archive: %w", file.Name, err)
+		}
+		files[filepath.Base(file.Name)] = contents
+	}
+
+	return files, nil
+
+}
@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExitArchiveRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exits", "archive.json")
+	files := map[string][]byte{
+		"exit-0x01.json": []byte(`{"message":{"epoch":"100","validator_index":"7"},"signature":"0x01"}`),
+		"exit-0x02.json": []byte(`{"message":{"epoch":"100","validator_index":"8"},"signature":"0x02"}`),
+		"empty.json":     {},
+	}
+
+	if err := WriteExitArchive(path, files, "correct horse battery staple"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The archive must not leak the messages
+	archiveBytes, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(archiveBytes, []byte("validator_index")) {
+		t.Error("expected the exit messages to be encrypted")
+	}
+
+	decrypted, err := ReadExitArchive(path, "correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decrypted) != len(files) {
+		t.Fatalf("expected %d files, got %d", len(files), len(decrypted))
+	}
+	for name, contents := range files {
+		if !bytes.Equal(decrypted[name], contents) {
+			t.Errorf("expected %s to be %q, got %q", name, contents, decrypted[name])
+		}
+	}
+}
+
+func TestExitArchiveWrongPassword(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "archive.json")
+	if err := WriteExitArchive(path, map[string][]byte{"exit.json": []byte("{}")}, "correct horse battery staple"); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := ReadExitArchive(path, "wrong password")
+	if err == nil {
+		t.Fatal("expected the wrong password to be refused")
+	}
+	if files != nil {
+		t.Error("expected no files with the wrong password")
+	}
+	if !strings.Contains(err.Error(), "check the password") {
+		t.Errorf("expected the error to point at the password, got %s", err.Error())
+	}
+}
+
+func TestExitArchiveNotAnArchive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	if err := os.WriteFile(path, []byte(`{"name":"something else","version":4,"crypto":{}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ReadExitArchive(path, "password"); err == nil {
+		t.Error("expected a file that isn't an exit archive to be refused")
+	}
+}
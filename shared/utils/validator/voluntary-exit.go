@@ -20,41 +20,47 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package validator
 
 import (
-	"github.com/stader-labs/stader-node/shared/types/eth2"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	"github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
 	"github.com/stader-labs/stader-node/stader-lib/types"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
 )
 
-// Get a voluntary exit message signature for a given validator key and index
-func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex uint64, epoch uint64, signatureDomain []byte) (types.ValidatorSignature, [32]byte, error) {
+// Get the fork voluntary exits are signed for, which is pinned to Capella since Deneb (EIP-7044)
+func GetExitForkInfo(eth2Config beacon.Eth2Config, network config.Network) (signer.ForkInfo, error) {
+	forkVersion, err := eth2.GetCapellaForkVersion(network)
+	if err != nil {
+		return signer.ForkInfo{}, err
+	}
+	return signer.ForkInfo{
+		Version:               forkVersion,
+		GenesisValidatorsRoot: eth2Config.GenesisValidatorsRoot,
+	}, nil
+}
 
-	// Build voluntary exit message
-	exitMessage := eth2.VoluntaryExit{
-		Epoch:          epoch,
+func GetSignedExitMessage(s signer.Signer, pubkey types.ValidatorPubkey, validatorIndex uint64, epoch uint64, forkInfo signer.ForkInfo) (types.ValidatorSignature, [32]byte, error) {
+
+	request := signer.VoluntaryExitRequest{
+		Pubkey:         pubkey,
 		ValidatorIndex: validatorIndex,
+		Epoch:          epoch,
+		ForkInfo:       forkInfo,
 	}
 
-	// Get object root
-	or, err := exitMessage.HashTreeRoot()
+	// Get signing root
+	srHash, err := request.SigningRoot()
 	if err != nil {
 		return types.ValidatorSignature{}, [32]byte{}, err
 	}
 
-	// Get signing root
-	sr := eth2.SigningRoot{
-		ObjectRoot: or[:],
-		Domain:     signatureDomain,
-	}
-
-	srHash, err := sr.HashTreeRoot()
+	// Sign message
+	signature, err := s.SignVoluntaryExit(request)
 	if err != nil {
 		return types.ValidatorSignature{}, [32]byte{}, err
 	}
 
-	// Sign message
-	signature := validatorKey.Sign(srHash[:]).Marshal()
-
 	// Return
-	return types.BytesToValidatorSignature(signature), srHash, nil
+	return signature, srHash, nil
 
 }
@@ -0,0 +1,223 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/services/txbundle"
+	"github.com/stader-labs/stader-node/shared/services/txjournal"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func showBundle(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	path, bundle, err := loadBundle(c, staderClient)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Offline bundle %s:\n\n", path)
+	return printBundle(bundle)
+}
+
+func signBundle(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// The bundle is signed with the node wallet of this machine, which doesn't need to be connected to the chain
+	if staderClient.IsOffline() {
+		return errors.New("The bundle must be signed with the node wallet, please run this command without the --offline flag.")
+	}
+
+	path, bundle, err := loadBundle(c, staderClient)
+	if err != nil {
+		return err
+	}
+	if err := printBundle(bundle); err != nil {
+		return err
+	}
+
+	unsigned := 0
+	for _, tx := range bundle.Transactions {
+		if len(tx.Signed) == 0 {
+			unsigned++
+		}
+	}
+	if unsigned == 0 {
+		fmt.Println("Every transaction of the bundle is already signed.")
+		return nil
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to sign these %d transactions with the node wallet?", unsigned))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	for i, tx := range bundle.Transactions {
+		if len(tx.Signed) > 0 {
+			continue
+		}
+		// Sign what was shown, re-encoded from the checked transaction
+		unsignedTx, err := bundle.GetUnsigned(i)
+		if err != nil {
+			return err
+		}
+		unsigned, err := unsignedTx.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("could not encode unsigned transaction %d: %w", tx.Nonce, err)
+		}
+		res, err := staderClient.SignTransaction(unsigned)
+		if err != nil {
+			return err
+		}
+		signed, err := hexutil.Decode(res.SignedData)
+		if err != nil {
+			return fmt.Errorf("could not decode signed transaction %d: %w", tx.Nonce, err)
+		}
+		if err := bundle.SetSigned(i, signed); err != nil {
+			return err
+		}
+	}
+	if err := bundle.Save(path); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Signed %d transactions. Take %s to the online node and send them with `stader-cli node bundle send`.\n", unsigned, path)
+	return nil
+}
+
+func sendBundle(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	if staderClient.IsOffline() {
+		return errors.New("Please run this command without the --offline flag, otherwise the signed transactions would be added to the bundle again.")
+	}
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	path, bundle, err := loadBundle(c, staderClient)
+	if err != nil {
+		return err
+	}
+	if err := printBundle(bundle); err != nil {
+		return err
+	}
+	if !bundle.IsSigned() {
+		return fmt.Errorf("Every transaction of the bundle must be signed before it is sent. Please sign %s with `stader-cli node bundle sign` on the machine with the node wallet.", path)
+	}
+
+	// Check the signatures before anything is sent
+	serializedTxs := make([][]byte, len(bundle.Transactions))
+	for i := range bundle.Transactions {
+		signedTx, err := bundle.GetSigned(i)
+		if err != nil {
+			return err
+		}
+		serializedTxs[i], err = signedTx.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("could not encode signed transaction %d: %w", signedTx.Nonce(), err)
+		}
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to send these %d transactions?", len(serializedTxs)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Send in nonce order and stop at the first failure, since the later ones can't be mined without it
+	for i, serializedTx := range serializedTxs {
+		res, err := staderClient.SendSignedTransaction(serializedTx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Sending transaction %d: %s\n", bundle.Transactions[i].Nonce, bundle.Transactions[i].Intent)
+		cliutils.PrintTransactionHash(staderClient, res.TxHash)
+		if _, err = staderClient.WaitForTransaction(res.TxHash); err != nil {
+			return err
+		}
+	}
+
+	// Log & return
+	fmt.Printf("Sent the %d transactions of the bundle. Their nonces are now used, so they will be left out the next time a transaction is added to the offline bundle.\n", len(serializedTxs))
+	return nil
+}
+
+// Load the bundle given as an argument, or the node's offline bundle
+func loadBundle(c *cli.Context, staderClient *stader.Client) (string, *txbundle.Bundle, error) {
+	path := c.Args().Get(0)
+	if path == "" {
+		cfg, isNew, err := staderClient.LoadConfig()
+		if err != nil {
+			return "", nil, err
+		}
+		if isNew {
+			return "", nil, errors.New("Settings file not found. Please run `stader-cli service config` to set up your Stadernode, or give the path of the bundle.")
+		}
+		path = cfg.StaderNode.GetOfflineBundlePath(false)
+	}
+
+	bundle, exists, err := txbundle.Load(path)
+	if err != nil {
+		return "", nil, err
+	}
+	if !exists || len(bundle.Transactions) == 0 {
+		return "", nil, fmt.Errorf("There are no transactions in the bundle at %s. Run a command with `stader-cli --offline <node address>` to add some.", path)
+	}
+	return path, bundle, nil
+}
+
+// Print the transactions of the bundle as decoded from the bytes that get signed, failing if the bundle's fields disagree with them
+func printBundle(bundle *txbundle.Bundle) error {
+	fmt.Printf("%s=== Transactions of %s on chain %s ===%s\n", log.ColorGreen, bundle.From.Hex(), bundle.ChainID.String(), log.ColorReset)
+	for i, bundled := range bundle.Transactions {
+		tx, err := bundle.GetUnsigned(i)
+		if err != nil {
+			return fmt.Errorf("The bundle may have been tampered with: %w", err)
+		}
+		status := fmt.Sprintf("%sunsigned%s", log.ColorYellow, log.ColorReset)
+		if len(bundled.Signed) > 0 {
+			status = fmt.Sprintf("%ssigned%s", log.ColorGreen, log.ColorReset)
+		}
+		fmt.Printf("Nonce %d (%s): %s\n", tx.Nonce(), status, txjournal.GetIntent(bundle.From, tx.To(), tx.Value(), tx.Data()))
+		fmt.Printf("    Max fee %.2f gwei, priority fee %.2f gwei, gas limit %d: costs up to %s\n",
+			eth.WeiToGwei(tx.GasFeeCap()), eth.WeiToGwei(tx.GasTipCap()), tx.Gas(),
+			eth.DisplayAmountInUnits(new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas())), "eth"))
+	}
+	fmt.Println()
+	return nil
+}
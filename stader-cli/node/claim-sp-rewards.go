@@ -36,6 +36,9 @@ func ClaimSpRewards(c *cli.Context) error {
 	if len(downloadRes.DownloadedCycles) != 0 {
 		fmt.Printf("Merkle proofs downloaded for cycles %v!\n", downloadRes.DownloadedCycles)
 	}
+	if len(downloadRes.QuarantinedCycles) != 0 {
+		fmt.Printf("Merkle proofs for cycles %v failed verification against the on-chain root and were quarantined\n", downloadRes.QuarantinedCycles)
+	}
 
 	// prompt user to select the cycles to claim from
 	canClaimSpRewards, err := staderClient.CanClaimSpRewards()
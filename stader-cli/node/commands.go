@@ -21,6 +21,7 @@ package node
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/urfave/cli"
 
@@ -270,6 +271,142 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return downloadSPMerkleProofs(c)
 				},
 			},
+			{
+				Name:      "events",
+				Aliases:   []string{"ev"},
+				Usage:     "Show the contract events of the node, its validators, vaults and reward address, as indexed by the node daemon",
+				UsageText: "stader-cli node events [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "from-block, f",
+						Usage: "The first block to show events from",
+					},
+					cli.Uint64Flag{
+						Name:  "to-block, t",
+						Usage: "The last block to show events from; 0 shows up to the last indexed block",
+					},
+					cli.StringFlag{
+						Name:  "name, n",
+						Usage: "Comma-separated event or contract names to show, e.g. SDDeposited,SocializingPool",
+					},
+					cli.IntFlag{
+						Name:  "limit, l",
+						Usage: "Show only the latest events; 0 shows them all",
+						Value: 50,
+					},
+					cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print the events as JSON",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					if c.Int("limit") < 0 {
+						return fmt.Errorf("limit can't be negative")
+					}
+
+					// Run
+					return getNodeEvents(c)
+
+				},
+			},
+			{
+				Name:      "report",
+				Aliases:   []string{"rep"},
+				Usage:     "Export the node's realized income, expenses and transfers between two dates, from the events indexed by the node daemon",
+				UsageText: "stader-cli node report --from date [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "from",
+						Usage: "The start of the report, as a UTC date like 2024-01-01 or an RFC 3339 time",
+					},
+					cli.StringFlag{
+						Name:  "to",
+						Usage: "The end of the report, as a UTC date (included) or an RFC 3339 time (excluded); defaults to now",
+					},
+					cli.StringFlag{
+						Name:  "format",
+						Usage: "The output format, csv or json",
+						Value: "csv",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "The file to write the report to; defaults to stdout",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getNodeReport(c)
+
+				},
+			},
+			{
+				Name:      "history",
+				Aliases:   []string{"hi"},
+				Usage:     "Show how the node's rewards, collateral and validators changed over time, from the snapshots recorded by the guardian",
+				UsageText: "stader-cli node history [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "days, d",
+						Usage: "The number of days to show",
+						Value: 7,
+					},
+					cli.StringFlag{
+						Name:  "interval, i",
+						Usage: "Show one snapshot per interval, e.g. 1h or 24h; 0 shows every snapshot",
+						Value: "24h",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					if c.Uint64("days") == 0 {
+						return fmt.Errorf("days needs to be > 0")
+					}
+					interval, err := time.ParseDuration(c.String("interval"))
+					if err != nil || interval < 0 {
+						return fmt.Errorf("invalid interval '%s', use a duration such as 1h or 24h", c.String("interval"))
+					}
+
+					// Run
+					return getNodeHistory(c, c.Uint64("days"), interval)
+				},
+			},
+			{
+				Name:      "generate-sp-tree",
+				Aliases:   []string{"gspt"},
+				Usage:     "Rebuild an approximation of the Socializing Pool rewards tree of a cycle from archive EC data, to sanity check the merkle proofs from the Stader backend",
+				UsageText: "stader-cli node generate-sp-tree --cycle value",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "cycle, c",
+						Usage: "The rewards cycle to rebuild the tree for",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					cycle, err := cliutils.ValidatePositiveUint("cycle", c.String("cycle"))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					return generateSpTree(c, int64(cycle))
+				},
+			},
 			{
 				Name:      "claim-sp-rewards",
 				Aliases:   []string{"cspr"},
@@ -331,6 +468,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "yes, y",
 						Usage: "Automatically confirm claim of rewards",
 					},
+					cli.BoolFlag{
+						Name:  "wait, w",
+						Usage: "Wait until the new reward address confirms the change",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -343,6 +484,27 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return SetRewardAddress(c, operatorRewardAddress)
 				},
 			},
+			{
+				Name:      "confirm-reward-address-tx",
+				Aliases:   []string{"crat"},
+				Usage:     "Print the unsigned transaction your new reward address signs to confirm a pending reward address change",
+				UsageText: "stader-cli node confirm-reward-address-tx [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "wait, w",
+						Usage: "Wait until the new reward address confirms the change",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getConfirmRewardAddressTx(c)
+				},
+			},
 			{
 				Name:      "utilize-sd",
 				Aliases:   []string{"us"},
@@ -369,6 +531,185 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 				Action: repaySD,
 			},
+			{
+				Name:      "sd-risk",
+				Aliases:   []string{"sdr"},
+				Usage:     "Project the health factor of your SD Utility Pool position as interest accrues, under SD price changes, repays and added validators",
+				UsageText: "stader-cli node sd-risk [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "days, d",
+						Usage: "Comma-separated numbers of days to project the health factor over",
+						Value: "30,90,180,365",
+					},
+					cli.StringFlag{
+						Name:  "price-changes, p",
+						Usage: "Comma-separated SD price changes to simulate, in percent",
+						Value: "-50,-25,0,25,50,100",
+					},
+					cli.StringFlag{
+						Name:  "repay, r",
+						Usage: "Simulate repaying this amount of SD now",
+					},
+					cli.Uint64Flag{
+						Name:  "add-validators, v",
+						Usage: "Simulate adding this number of validators now",
+					},
+					cli.Float64Flag{
+						Name:  "target, t",
+						Usage: "The health factor to stay above; defaults to the health factor alert threshold",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getSdRisk(c)
+
+				},
+			},
+			{
+				Name:      "bundle",
+				Usage:     "Show, sign or send the offline bundle of transactions made with the --offline flag, so the node wallet can be kept on an offline machine",
+				UsageText: "stader-cli node bundle command [options]",
+				Subcommands: []cli.Command{
+					{
+						Name:      "show",
+						Usage:     "Show the transactions of a bundle; defaults to the node's offline bundle",
+						UsageText: "stader-cli node bundle show [bundle-path]",
+						Action: func(c *cli.Context) error {
+
+							if len(c.Args()) > 1 {
+								return cliutils.ValidateArgCount(c, 1)
+							}
+
+							// Run
+							return showBundle(c)
+
+						},
+					},
+					{
+						Name:      "sign",
+						Usage:     "Sign the transactions of a bundle with the node wallet of this machine; this doesn't need a connection to the chain",
+						UsageText: "stader-cli node bundle sign [bundle-path] [options]",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm signing the transactions",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							if len(c.Args()) > 1 {
+								return cliutils.ValidateArgCount(c, 1)
+							}
+
+							// Run
+							return signBundle(c)
+
+						},
+					},
+					{
+						Name:      "send",
+						Usage:     "Send the signed transactions of a bundle",
+						UsageText: "stader-cli node bundle send [bundle-path] [options]",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm sending the transactions",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							if len(c.Args()) > 1 {
+								return cliutils.ValidateArgCount(c, 1)
+							}
+
+							// Run
+							return sendBundle(c)
+
+						},
+					},
+				},
+			},
+			{
+				Name:      "tx",
+				Usage:     "List, speed up or cancel the transactions sent by the node wallet",
+				UsageText: "stader-cli node tx command [options]",
+				Subcommands: []cli.Command{
+					{
+						Name:      "list",
+						Aliases:   []string{"l"},
+						Usage:     "List the transactions sent by the node wallet and their status",
+						UsageText: "stader-cli node tx list",
+						Action: func(c *cli.Context) error {
+
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getTxList(c)
+
+						},
+					},
+					{
+						Name:      "speedup",
+						Aliases:   []string{"s"},
+						Usage:     "Resend a pending transaction with higher fees",
+						UsageText: "stader-cli node tx speedup tx-hash [options]",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the speed up",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return replaceTx(c, hash, false)
+
+						},
+					},
+					{
+						Name:      "cancel",
+						Aliases:   []string{"c"},
+						Usage:     "Replace a pending transaction with an empty one to the node wallet itself",
+						UsageText: "stader-cli node tx cancel tx-hash [options]",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the cancellation",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return replaceTx(c, hash, true)
+
+						},
+					},
+				},
+			},
 			{
 				Name:      "approve-deposit-sd",
 				Aliases:   []string{"ad"},
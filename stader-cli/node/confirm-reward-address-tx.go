@@ -0,0 +1,87 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/urfave/cli"
+)
+
+// How often the reward address change is checked while waiting for its confirmation
+const rewardAddressChangePollInterval = 12 * time.Second
+
+func getConfirmRewardAddressTx(c *cli.Context) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	response, err := staderClient.GetConfirmRewardAddressTx()
+	if err != nil {
+		return err
+	}
+	if response.NoPendingChange {
+		fmt.Printf("There is no pending reward address change. Your reward address is %s.\n", response.RewardAddress.Hex())
+		return nil
+	}
+
+	if err := printConfirmRewardAddressTx(response); err != nil {
+		return err
+	}
+
+	if !c.Bool("wait") {
+		return nil
+	}
+	return waitForRewardAddressChange(staderClient, response.CurrentBlock, response.ProposedRewardAddress)
+}
+
+// Print the unsigned confirmation transaction in every supported format
+func printConfirmRewardAddressTx(response api.ConfirmRewardAddressTxResponse) error {
+	txJson, err := json.MarshalIndent(response.Transaction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding the unsigned transaction: %w", err)
+	}
+
+	fmt.Printf("To confirm the change, your new reward address %s has to sign and send the following transaction.\n", response.ProposedRewardAddress.Hex())
+	fmt.Println("Nonce and fees are based on the current network state; refresh them if you sign it much later.")
+	fmt.Println()
+	fmt.Println("Unsigned EIP-1559 transaction (RLP):")
+	fmt.Println(hexutil.Encode(response.RawTransaction))
+	fmt.Println()
+	fmt.Println("Unsigned transaction (JSON, for eth_signTransaction):")
+	fmt.Println(string(txJson))
+	fmt.Println()
+	fmt.Println("EIP-681 URI, for wallets that scan QR codes:")
+	fmt.Println(response.Eip681Uri)
+	fmt.Println()
+	return nil
+}
+
+// Poll the registry until the reward address change is confirmed
+func waitForRewardAddressChange(staderClient *stader.Client, fromBlock uint64, proposedRewardAddress common.Address) error {
+	fmt.Printf("Waiting for %s to confirm the reward address change (press Ctrl+C to stop)...\n", proposedRewardAddress.Hex())
+	for {
+		status, err := staderClient.GetRewardAddressChangeStatus(fromBlock)
+		if err != nil {
+			return err
+		}
+		if status.Confirmed {
+			fmt.Printf("%sThe reward address change was confirmed in block %d (transaction %s). Your reward address is now %s.%s\n", colorGreen, status.ConfirmedBlock, status.ConfirmedTxHash.Hex(), status.RewardAddress.Hex(), colorReset)
+			return nil
+		}
+		time.Sleep(rewardAddressChangePollInterval)
+	}
+}
@@ -47,6 +47,9 @@ func downloadSPMerkleProofs(c *cli.Context) error {
 	}
 
 	fmt.Printf("Successfully downloaded the merkle proofs for cycles: %v\n", res.DownloadedCycles)
+	if len(res.QuarantinedCycles) != 0 {
+		fmt.Printf("Merkle proofs for cycles %v failed verification against the on-chain root and were quarantined\n", res.QuarantinedCycles)
+	}
 
 	return nil
 }
@@ -0,0 +1,92 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/types/events"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+func getNodeEvents(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	res, err := staderClient.NodeEvents(c.Uint64("from-block"), c.Uint64("to-block"))
+	if err != nil {
+		return err
+	}
+
+	// Filter by event or contract name
+	shown := res.Events
+	if c.String("name") != "" {
+		names := map[string]bool{}
+		for _, name := range strings.Split(c.String("name"), ",") {
+			names[strings.ToLower(strings.TrimSpace(name))] = true
+		}
+		shown = []events.Event{}
+		for _, event := range res.Events {
+			if names[strings.ToLower(event.Name)] || names[strings.ToLower(event.Contract)] {
+				shown = append(shown, event)
+			}
+		}
+	}
+	matching := len(shown)
+	if limit := c.Int("limit"); limit > 0 && len(shown) > limit {
+		shown = shown[len(shown)-limit:]
+	}
+
+	if c.Bool("json") {
+		bytes, err := json.MarshalIndent(shown, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error encoding events: %w", err)
+		}
+		fmt.Println(string(bytes))
+		return nil
+	}
+
+	if res.IndexedBlock == 0 {
+		fmt.Println("No events have been indexed yet. The node daemon indexes them in the background; the first run can take a while.")
+		return nil
+	}
+	fmt.Printf("Events are indexed up to block %d.\n\n", res.IndexedBlock)
+	if len(shown) == 0 {
+		fmt.Println("No events found.")
+		return nil
+	}
+
+	for _, event := range shown {
+		fmt.Printf("%s%s%s  block %d  %s%s.%s%s\n", log.ColorBlue, time.Unix(event.Timestamp, 0).Format("2006-01-02 15:04:05"), log.ColorReset,
+			event.BlockNumber, log.ColorGreen, event.Contract, event.Name, log.ColorReset)
+		fmt.Printf("    tx %s\n", event.TxHash.Hex())
+		argNames := make([]string, 0, len(event.Args))
+		for name := range event.Args {
+			argNames = append(argNames, name)
+		}
+		sort.Strings(argNames)
+		for _, name := range argNames {
+			fmt.Printf("    %s: %s\n", name, event.Args[name])
+		}
+	}
+	if len(shown) < matching {
+		fmt.Printf("\nShowing the latest %d of %d events; use --limit 0 to show all of them.\n", len(shown), matching)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+	"github.com/urfave/cli"
+)
+
+func generateSpTree(c *cli.Context, cycle int64) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rebuilding the Socializing Pool rewards tree for cycle %d. This reads the state of every operator and can take a while...\n", cycle)
+
+	res, err := staderClient.GenerateSpTree(cycle)
+	if err != nil {
+		return err
+	}
+
+	if !res.UsedArchiveEc {
+		fmt.Println("No archive EC is configured, the primary EC was used. Set the Archive-Mode EC URL in `stader-cli service config` if it can't serve old cycles.")
+	}
+
+	fmt.Printf("Cycle %d covers blocks %d to %d\n", res.Cycle, res.StartBlock, res.EndBlock)
+	fmt.Printf("Operators in the tree: %d\n", res.OperatorCount)
+	fmt.Printf("Generated root: %s\n", res.Root.Hex())
+	fmt.Printf("On-chain root:  %s\n", res.OnChainRoot.Hex())
+	if res.RootMatches {
+		fmt.Println("The generated tree matches the root reported on chain.")
+	} else {
+		fmt.Println("The generated tree does not match the root reported on chain.")
+	}
+	if res.Approximation {
+		fmt.Printf("%sNOTE: the generated amounts are an approximation. ETH is split by the blocks each operator's validators spent in the Socializing Pool and SD by the reward eligible SD stake at the end of the cycle, which are not the exact rules of the Stader backend. Use them to spot large differences, not to dispute small ones.%s\n", colorYellow, colorReset)
+	}
+
+	if res.NodeRewards != nil {
+		fmt.Println()
+		printCycleRewards("Generated rewards", res.NodeRewards)
+		if res.DownloadedRewards != nil {
+			printCycleRewards("Backend rewards  ", res.DownloadedRewards)
+			if res.DownloadedRewards.Eth == res.NodeRewards.Eth && res.DownloadedRewards.Sd == res.NodeRewards.Sd {
+				fmt.Println("The backend's amounts for your node match the generated tree.")
+			} else {
+				fmt.Println("The backend's amounts for your node differ from the generated approximation.")
+			}
+		}
+	} else {
+		fmt.Println("\nYour node is not part of the generated tree for this cycle.")
+	}
+
+	fmt.Printf("\nThe full tree was saved to %s\n", res.TreeFilePath)
+
+	return nil
+}
+
+func printCycleRewards(label string, rewards *stader_backend.CycleMerkleProofs) {
+	ethRewards, ok := big.NewInt(0).SetString(rewards.Eth, 10)
+	if !ok {
+		ethRewards = big.NewInt(0)
+	}
+	sdRewards, ok := big.NewInt(0).SetString(rewards.Sd, 10)
+	if !ok {
+		sdRewards = big.NewInt(0)
+	}
+	fmt.Printf("%s: %s and %s\n", label, eth.DisplayAmountInUnits(ethRewards, "eth"), eth.DisplayAmountInUnits(sdRewards, "sd"))
+}
@@ -0,0 +1,75 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/types/history"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+func getNodeHistory(c *cli.Context, days uint64, interval time.Duration) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	res, err := staderClient.NodeHistory(days, uint64(interval.Seconds()))
+	if err != nil {
+		return err
+	}
+
+	if res.RetentionDays == 0 {
+		fmt.Printf("%sThe guardian isn't recording history, set the History Retention in `stader-cli service config` to enable it.%s\n", log.ColorYellow, log.ColorReset)
+	}
+	if len(res.Snapshots) == 0 {
+		fmt.Println("No history has been recorded for this period yet. The guardian takes a snapshot every time it updates its metrics.")
+		return nil
+	}
+	if days > res.RetentionDays && res.RetentionDays > 0 {
+		fmt.Printf("Only the last %d days are kept.\n\n", res.RetentionDays)
+	}
+
+	fmt.Printf("%s=== Rewards ===%s\n", log.ColorGreen, log.ColorReset)
+	fmt.Printf("%-17s %16s %16s %16s %16s %16s\n", "Time", "SP ETH", "SP SD", "Non SP EL ETH", "CL ETH", "Claim Vault ETH")
+	for _, snapshot := range res.Snapshots {
+		fmt.Printf("%-17s %16.6f %16.6f %16.6f %16.6f %16.6f\n", formatSnapshotTime(snapshot),
+			snapshot.UnclaimedSocializingPoolElRewards, snapshot.UnclaimedSocializingPoolSdRewards,
+			snapshot.UnclaimedNonSocializingPoolElRewards, snapshot.UnclaimedClRewards, snapshot.ClaimVaultBalance)
+	}
+	fmt.Println("Unclaimed amounts, except the Claim Vault balance.")
+	fmt.Println()
+
+	fmt.Printf("%s=== Collateral ===%s\n", log.ColorGreen, log.ColorReset)
+	fmt.Printf("%-17s %16s %16s %12s %12s %12s\n", "Time", "SD Self Bond", "SD Utilized", "SD %", "Health", "SD Price")
+	for _, snapshot := range res.Snapshots {
+		fmt.Printf("%-17s %16.4f %16.4f %11.2f%% %12.4f %12.6f\n", formatSnapshotTime(snapshot),
+			snapshot.OperatorSdSelfBond, snapshot.OperatorSdUtilized, snapshot.SdCollateralPct, snapshot.HealthFactor, snapshot.SdPrice)
+	}
+	fmt.Println()
+
+	fmt.Printf("%s=== Validators ===%s\n", log.ColorGreen, log.ColorReset)
+	fmt.Printf("%-17s %8s %8s %8s %8s %8s %10s\n", "Time", "Active", "Queued", "Exiting", "Slashed", "Settled", "Withdrawn")
+	for _, snapshot := range res.Snapshots {
+		fmt.Printf("%-17s %8d %8d %8d %8d %8d %10d\n", formatSnapshotTime(snapshot),
+			snapshot.ActiveValidators, snapshot.StaderQueuedValidators+snapshot.BeaconChainQueuedValidators,
+			snapshot.ExitingValidators, snapshot.SlashedValidators, snapshot.FundsSettledValidators, snapshot.WithdrawnValidators)
+	}
+
+	return nil
+}
+
+func formatSnapshotTime(snapshot history.MetricsSnapshot) string {
+	return time.Unix(snapshot.Timestamp, 0).Format("2006-01-02 15:04")
+}
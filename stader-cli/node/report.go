@@ -0,0 +1,199 @@
+package node
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+// A report entry as written to the CSV and JSON outputs, with amounts in whole tokens
+type reportRow struct {
+	Block     uint64 `json:"block"`
+	Timestamp string `json:"timestamp"`
+	TxHash    string `json:"txHash"`
+	Category  string `json:"category"`
+	Kind      string `json:"kind"`
+	Asset     string `json:"asset"`
+	Amount    string `json:"amount"`
+	EthValue  string `json:"ethValue"`
+	Note      string `json:"note"`
+}
+
+var reportCsvHeader = []string{"block", "timestamp", "tx_hash", "category", "kind", "asset", "amount", "eth_value", "note"}
+
+func getNodeReport(c *cli.Context) error {
+
+	format := strings.ToLower(c.String("format"))
+	if format != "csv" && format != "json" {
+		return fmt.Errorf("invalid format '%s', it must be csv or json", c.String("format"))
+	}
+	from, err := parseReportTime("from", c.String("from"), false)
+	if err != nil {
+		return err
+	}
+	to := time.Now().UTC()
+	if c.String("to") != "" {
+		to, err = parseReportTime("to", c.String("to"), true)
+		if err != nil {
+			return err
+		}
+	}
+	if !to.After(from) {
+		return fmt.Errorf("--to must be after --from")
+	}
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	res, err := staderClient.NodeReport(from.Unix(), to.Unix())
+	if err != nil {
+		return err
+	}
+
+	// The report goes to stdout when no file is given, so notes go to stderr to keep it parseable
+	notes := os.Stdout
+	if c.String("output") == "" {
+		notes = os.Stderr
+	}
+	if res.IndexedBlock == 0 {
+		fmt.Fprintln(notes, "No events have been indexed yet. The node daemon indexes them in the background; the first run can take a while.")
+		return nil
+	}
+	if res.IndexedTime < to.Unix() {
+		fmt.Fprintf(notes, "%sEvents are indexed up to block %d (%s); later activity isn't in the report yet.%s\n", log.ColorYellow,
+			res.IndexedBlock, time.Unix(res.IndexedTime, 0).UTC().Format(time.RFC3339), log.ColorReset)
+	}
+	if !res.UsedArchiveEc {
+		fmt.Fprintf(notes, "%sNo archive EC is configured; SD valuations of old blocks need one if your EC prunes historical state.%s\n", log.ColorYellow, log.ColorReset)
+	}
+
+	rows := make([]reportRow, len(res.Entries))
+	for i, entry := range res.Entries {
+		rows[i] = reportRow{
+			Block:     entry.BlockNumber,
+			Timestamp: time.Unix(entry.Timestamp, 0).UTC().Format(time.RFC3339),
+			TxHash:    entry.TxHash.Hex(),
+			Category:  entry.Category,
+			Kind:      entry.Kind,
+			Asset:     entry.Asset,
+			Amount:    formatTokenAmount(entry.Amount),
+			EthValue:  formatTokenAmount(entry.EthValue),
+			Note:      entry.Note,
+		}
+	}
+
+	var output io.Writer = os.Stdout
+	if c.String("output") != "" {
+		file, err := os.Create(c.String("output"))
+		if err != nil {
+			return fmt.Errorf("error creating %s: %w", c.String("output"), err)
+		}
+		defer file.Close()
+		output = file
+	}
+
+	if format == "json" {
+		bytes, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error encoding the report: %w", err)
+		}
+		if _, err := fmt.Fprintln(output, string(bytes)); err != nil {
+			return fmt.Errorf("error writing the report: %w", err)
+		}
+	} else {
+		csvWriter := csv.NewWriter(output)
+		if err := csvWriter.Write(reportCsvHeader); err != nil {
+			return fmt.Errorf("error writing the report: %w", err)
+		}
+		for _, row := range rows {
+			record := []string{strconv.FormatUint(row.Block, 10), row.Timestamp, row.TxHash, row.Category, row.Kind, row.Asset, row.Amount, row.EthValue, row.Note}
+			if err := csvWriter.Write(record); err != nil {
+				return fmt.Errorf("error writing the report: %w", err)
+			}
+		}
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return fmt.Errorf("error writing the report: %w", err)
+		}
+	}
+
+	if c.String("output") != "" {
+		printReportTotals(res.Entries)
+		fmt.Printf("Report of %d entries written to %s%s%s\n", len(rows), log.ColorGreen, c.String("output"), log.ColorReset)
+	}
+
+	return nil
+}
+
+// Print the ETH value of the entries by kind
+func printReportTotals(entries []api.ReportEntry) {
+	kinds := []string{"income", "expense", "transfer"}
+	totals := map[string]*big.Int{}
+	for _, kind := range kinds {
+		totals[kind] = big.NewInt(0)
+	}
+	for _, entry := range entries {
+		if total, exists := totals[entry.Kind]; exists {
+			total.Add(total, entry.EthValue)
+		}
+	}
+	for _, kind := range kinds {
+		fmt.Printf("Total %s: %s ETH\n", kind, formatTokenAmount(totals[kind]))
+	}
+	fmt.Println()
+}
+
+// Parse a report bound given as a date (YYYY-MM-DD, UTC) or an RFC 3339 time.
+// A date used as the end of the range includes that whole day.
+func parseReportTime(name string, value string, end bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("--%s is required", name)
+	}
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		if end {
+			return date.AddDate(0, 0, 1), nil
+		}
+		return date, nil
+	}
+	if moment, err := time.Parse(time.RFC3339, value); err == nil {
+		return moment, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --%s '%s', it must be a date like 2024-01-31 or a time like 2024-01-31T12:00:00Z", name, value)
+}
+
+// Format an 18-decimals amount in whole tokens without losing precision
+func formatTokenAmount(amount *big.Int) string {
+	if amount == nil {
+		return "0"
+	}
+	units, remainder := new(big.Int).QuoRem(new(big.Int).Abs(amount), big.NewInt(1e18), new(big.Int))
+	result := units.String()
+	if remainder.Sign() != 0 {
+		result += "." + strings.TrimRight(fmt.Sprintf("%018s", remainder.String()), "0")
+	}
+	if amount.Sign() < 0 {
+		result = "-" + result
+	}
+	return result
+}
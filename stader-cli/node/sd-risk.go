@@ -0,0 +1,208 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+	"github.com/urfave/cli"
+)
+
+// How far ahead the time to liquidation is searched
+const sdRiskMaxDays = 3650
+
+func getSdRisk(c *cli.Context) error {
+
+	horizons, err := parseIntList("days", c.String("days"))
+	if err != nil {
+		return err
+	}
+	priceChanges, err := parseIntList("price-changes", c.String("price-changes"))
+	if err != nil {
+		return err
+	}
+	for _, days := range horizons {
+		if days <= 0 {
+			return fmt.Errorf("invalid --days value %d, it must be positive", days)
+		}
+	}
+	for _, change := range priceChanges {
+		if change <= -100 {
+			return fmt.Errorf("invalid --price-changes value %d, the SD price can't drop 100%% or more", change)
+		}
+	}
+	repayAmount := big.NewInt(0)
+	if c.String("repay") != "" {
+		amount, err := cliutils.ValidateEthAmount("repay", c.String("repay"))
+		if err != nil {
+			return err
+		}
+		repayAmount = eth.EthToWei(amount)
+	}
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	target := c.Float64("target")
+	if !c.IsSet("target") {
+		cfg, _, err := staderClient.LoadConfig()
+		if err != nil {
+			return err
+		}
+		target = cfg.Alerting.HealthFactorThreshold.Value.(float64)
+	}
+	if target <= 0 {
+		return fmt.Errorf("invalid --target %f, it must be positive", target)
+	}
+	targetWei := eth.EthToWei(target)
+
+	res, err := staderClient.GetSdRisk()
+	if err != nil {
+		return err
+	}
+
+	if res.AlreadyLiquidated {
+		fmt.Println("Your node is under the liquidation process, there is no position to simulate.")
+		return nil
+	}
+	position := res.Position
+	if position.PrincipalSd.Sign() == 0 && position.InterestSd.Sign() == 0 {
+		fmt.Println("You do not have an existing Utilization Position, so your node can't be liquidated.")
+		return nil
+	}
+
+	fmt.Printf("%sCurrent position%s\n", log.ColorGreen, log.ColorReset)
+	fmt.Printf("Utilized SD:                %s\n", eth.DisplayAmountInUnits(position.PrincipalSd, "sd"))
+	fmt.Printf("Interest owed:              %s\n", eth.DisplayAmountInUnits(position.InterestSd, "sd"))
+	fmt.Printf("ETH counted as collateral:  %s (%s per validator)\n", eth.DisplayAmountInUnits(position.CollateralEth, "eth"), eth.DisplayAmountInUnits(res.ConservativeEthPerKey, "eth"))
+	fmt.Printf("SD price:                   %.8f ETH\n", eth.WeiToEth(position.SdPriceEth))
+	fmt.Printf("Liquidation threshold:      %s%%\n", position.LiquidationThreshold.String())
+	fmt.Printf("Health factor:              %s (the pool reports %s until it next accrues interest)\n\n", formatHealthFactor(position.HealthFactor()), formatHealthFactor(res.HealthFactor))
+
+	// Apply the scenario's actions
+	scenario := []string{}
+	if repayAmount.Sign() > 0 {
+		position = position.Repay(repayAmount)
+		scenario = append(scenario, fmt.Sprintf("repaying %s now", eth.DisplayAmountInUnits(repayAmount, "sd")))
+	}
+	if addedValidators := c.Uint64("add-validators"); addedValidators > 0 {
+		position = position.WithCollateral(new(big.Int).Mul(res.ConservativeEthPerKey, new(big.Int).SetUint64(addedValidators)))
+		scenario = append(scenario, fmt.Sprintf("adding %d validators now", addedValidators))
+	}
+	if len(scenario) > 0 {
+		fmt.Printf("Simulating %s.\n\n", strings.Join(scenario, " and "))
+	}
+
+	// Project the health factor of each SD price over each horizon
+	fmt.Printf("%sProjected health factor%s (liquidation below 1, target %.2f)\n", log.ColorGreen, log.ColorReset, target)
+	fmt.Printf("%-10s %12s", "SD price", "now")
+	for _, days := range horizons {
+		fmt.Printf(" %12s", fmt.Sprintf("%dd", days))
+	}
+	fmt.Printf("  %s\n", "liquidation")
+	for _, change := range priceChanges {
+		scenarioPosition := position.WithPriceChange(int64(change))
+		fmt.Printf("%-10s %12s", fmt.Sprintf("%+d%%", change), formatHealthFactor(scenarioPosition.HealthFactor()))
+		for _, days := range horizons {
+			fmt.Printf(" %12s", formatHealthFactor(scenarioPosition.After(uint64(days)*sdutility.BlocksPerDay).HealthFactor()))
+		}
+		fmt.Printf("  %s\n", formatLiquidationTime(scenarioPosition))
+	}
+	fmt.Println()
+
+	// Tell how to stay above the target for the longest horizon
+	longest := horizons[0]
+	for _, days := range horizons {
+		if days > longest {
+			longest = days
+		}
+	}
+	blocks := uint64(longest) * sdutility.BlocksPerDay
+	fmt.Printf("%sTo stay above a health factor of %.2f for %d days%s\n", log.ColorGreen, target, longest, log.ColorReset)
+	for _, change := range priceChanges {
+		scenarioPosition := position.WithPriceChange(int64(change))
+		repay := scenarioPosition.RepayForHealthFactor(targetWei, blocks)
+		if repay.Sign() == 0 {
+			fmt.Printf("%-10s no action needed\n", fmt.Sprintf("%+d%%", change))
+			continue
+		}
+		advice := fmt.Sprintf("repay %s", eth.DisplayAmountInUnits(repay, "sd"))
+		collateral := scenarioPosition.CollateralForHealthFactor(targetWei, blocks)
+		if collateral != nil && res.ConservativeEthPerKey.Sign() > 0 {
+			validators := new(big.Int).Add(collateral, new(big.Int).Sub(res.ConservativeEthPerKey, big.NewInt(1)))
+			validators.Quo(validators, res.ConservativeEthPerKey)
+			advice += fmt.Sprintf(", or add %s validators", validators.String())
+		}
+		fmt.Printf("%-10s %s\n", fmt.Sprintf("%+d%%", change), advice)
+	}
+	if res.SdBalance != nil {
+		fmt.Printf("\nYour node wallet holds %s that can be used to repay.\n", eth.DisplayAmountInUnits(res.SdBalance, "sd"))
+	}
+	fmt.Println("Note: the health factor only weighs the interest owed against the ETH of your validators. Repaying pays the interest first; depositing SD collateral doesn't change it.")
+	fmt.Println("Projections assume the current utilization rate, compounded daily.")
+
+	return nil
+}
+
+// The time until the health factor drops below 1, searched day by day
+func formatLiquidationTime(position sdutility.RiskPosition) string {
+	one := eth.EthToWei(1)
+	if position.HealthFactor().Cmp(one) < 0 {
+		return "now"
+	}
+	for days := 1; days <= sdRiskMaxDays; days++ {
+		position = position.After(sdutility.BlocksPerDay)
+		if position.HealthFactor().Cmp(one) < 0 {
+			return fmt.Sprintf("in %d days", days)
+		}
+	}
+	return fmt.Sprintf("not within %d years", sdRiskMaxDays/365)
+}
+
+func formatHealthFactor(healthFactor *big.Int) string {
+	if healthFactor == nil || healthFactor.Cmp(math.MaxBig256) == 0 {
+		return "no interest"
+	}
+	return strconv.FormatFloat(eth.WeiToEth(healthFactor), 'f', 4, 64)
+}
+
+func parseIntList(name string, value string) ([]int, error) {
+	values := []int{}
+	for _, element := range strings.Split(value, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+		number, err := strconv.Atoi(element)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s value '%s': %w", name, element, err)
+		}
+		values = append(values, number)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("--%s needs at least one value", name)
+	}
+	return values, nil
+}
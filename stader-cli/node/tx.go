@@ -0,0 +1,146 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/types/transactions"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func getTxList(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	res, err := staderClient.GetTxList()
+	if err != nil {
+		return err
+	}
+
+	if len(res.Transactions) == 0 {
+		fmt.Println("The node wallet hasn't sent any transactions that are still on record.")
+	} else {
+		fmt.Printf("%-7s %-66s %-11s %-16s %14s %14s  %s\n", "Nonce", "Hash", "Status", "Sent", "Max Fee", "Priority Fee", "Purpose")
+		for _, tx := range res.Transactions {
+			statusColor := log.ColorReset
+			switch tx.Status {
+			case transactions.StatusPending:
+				statusColor = log.ColorYellow
+			case transactions.StatusFailed, transactions.StatusDropped:
+				statusColor = log.ColorRed
+			}
+			fmt.Printf("%-7d %-66s %s%-11s%s %-16s %9.2f gwei %9.2f gwei  %s\n",
+				tx.Nonce, tx.Hash.Hex(), statusColor, tx.Status, log.ColorReset, time.Unix(tx.SentTime, 0).Format("2006-01-02 15:04"),
+				eth.WeiToGwei(tx.MaxFee), eth.WeiToGwei(tx.MaxPriorityFee), tx.Purpose)
+		}
+	}
+	fmt.Printf("\nNonce of the next transaction to be mined: %d\n", res.LatestNonce)
+	fmt.Printf("Nonce of the next transaction to be sent: %d\n", res.PendingNonce)
+	if res.PendingNonce > res.LatestNonce {
+		fmt.Printf("%d transactions are waiting to be mined. Use `stader-cli node tx speedup` or `stader-cli node tx cancel` to replace a stuck one.\n", res.PendingNonce-res.LatestNonce)
+	}
+
+	return nil
+}
+
+func replaceTx(c *cli.Context, hash common.Hash, cancel bool) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	action := "speed up"
+	if cancel {
+		action = "cancel"
+	}
+
+	plan, err := staderClient.CanReplaceTx(hash, cancel)
+	if err != nil {
+		return err
+	}
+	if plan.NotFound {
+		fmt.Printf("Cannot %s transaction %s: it isn't on record as sent by the node wallet.\n", action, hash.Hex())
+		return nil
+	}
+	if plan.NotPending {
+		fmt.Printf("Cannot %s transaction %s: it is already %s.\n", action, hash.Hex(), plan.Transaction.Status)
+		return nil
+	}
+
+	// Print the fees
+	fmt.Printf("Transaction %s (nonce %d, %s):\n", hash.Hex(), plan.Transaction.Nonce, plan.Transaction.Purpose)
+	fmt.Printf("Current max fee:      %.2f gwei, priority fee %.2f gwei\n", eth.WeiToGwei(plan.Transaction.MaxFee), eth.WeiToGwei(plan.Transaction.MaxPriorityFee))
+	fmt.Printf("Minimum replacement:  %.2f gwei, priority fee %.2f gwei\n", eth.WeiToGwei(plan.MinMaxFee), eth.WeiToGwei(plan.MinMaxPriorityFee))
+	fmt.Printf("Replacement:          %.2f gwei, priority fee %.2f gwei\n", eth.WeiToGwei(plan.MaxFee), eth.WeiToGwei(plan.MaxPriorityFee))
+	if plan.FeesTooLow {
+		fmt.Printf("%sThe replacement fees must be at least the minimum above, and the priority fee can't be more than the max fee. Please raise them with the --maxFee and --maxPrioFee flags, or in the manual fee settings of `stader-cli service config`.%s\n", log.ColorRed, log.ColorReset)
+		return nil
+	}
+	fmt.Printf("The fees can be set with the --maxFee and --maxPrioFee flags.\n")
+	if cancel {
+		fmt.Printf("The cancellation sends 0 ETH from the node wallet to itself with the same nonce, so the original transaction can no longer be mined.\n")
+	}
+	fmt.Printf("Sending it will cost up to %s.\n\n", eth.DisplayAmountInUnits(new(big.Int).Mul(plan.MaxFee, new(big.Int).SetUint64(plan.GasLimit)), "eth"))
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to %s transaction %s?", action, hash.Hex()))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	res, err := staderClient.ReplaceTx(hash, cancel)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Replacing transaction %s...\n", hash.Hex())
+	cliutils.PrintTransactionHash(staderClient, res.TxHash)
+	if _, err = staderClient.WaitForTransaction(res.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	if cancel {
+		fmt.Printf("Cancelled transaction %s.\n", hash.Hex())
+	} else {
+		fmt.Printf("Sped up transaction %s.\n", hash.Hex())
+	}
+	return nil
+}
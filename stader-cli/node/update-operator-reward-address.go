@@ -101,7 +101,25 @@ Do you wish to proceed with the Reward Address change?`
 	}
 
 	promptSuccessChangedRewardAndNextStep(cfg, infoResponse.PermissionlessNodeRegistry)
-	return nil
+
+	// Offer the confirmation as a transaction to sign for reward addresses that can't use the web UI
+	confirmTx, err := staderClient.GetConfirmRewardAddressTx()
+	if err != nil {
+		fmt.Printf("%sCould not build the confirmation transaction: %s\nYou can try again later with `stader-cli node confirm-reward-address-tx`.%s\n", colorYellow, err.Error(), colorReset)
+		return nil
+	}
+	if confirmTx.NoPendingChange {
+		return nil
+	}
+	fmt.Println("Alternatively, if your new reward address is a hardware wallet or a multisig:")
+	if err := printConfirmRewardAddressTx(confirmTx); err != nil {
+		return err
+	}
+
+	if !c.Bool("wait") {
+		return nil
+	}
+	return waitForRewardAddressChange(staderClient, confirmTx.CurrentBlock, confirmTx.ProposedRewardAddress)
 }
 
 func promptSuccessChangedRewardAndNextStep(cfg *config.StaderConfig, contractAddr common.Address) {
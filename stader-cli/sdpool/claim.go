@@ -0,0 +1,204 @@
+package sdpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/gas"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func finalizeRequests(c *cli.Context) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// If a custom nonce is set, print the multi-transaction warning
+	if c.GlobalUint64("nonce") != 0 {
+		cliutils.PrintMultiTransactionNonceWarning()
+	}
+
+	_, err = finalize(staderClient, c.Bool("yes"))
+	return err
+}
+
+// Finalize the withdraw requests that are ready; returns false if nothing was finalized
+func finalize(staderClient *stader.Client, autoConfirm bool) (bool, error) {
+	canFinalize, err := staderClient.CanSdPoolFinalize()
+	if err != nil {
+		return false, err
+	}
+	if canFinalize.Paused {
+		fmt.Println("The SD Utility Pool is paused, please try again later.")
+		return false, nil
+	}
+	if canFinalize.NothingToFinalize {
+		fmt.Println("There are no withdraw requests ready to be finalized.")
+		return false, nil
+	}
+
+	// Assign max fees
+	err = gas.AssignMaxFeeAndLimit(canFinalize.GasInfo, staderClient, autoConfirm)
+	if err != nil {
+		return false, err
+	}
+
+	// Prompt for confirmation
+	if !(autoConfirm || cliutils.Confirm("Are you sure you want to finalize the withdraw requests that are ready?")) {
+		fmt.Println("Cancelled.")
+		return false, nil
+	}
+
+	response, err := staderClient.SdPoolFinalize()
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Println("Finalizing withdraw requests...")
+	cliutils.PrintTransactionHash(staderClient, response.TxHash)
+	if _, err = staderClient.WaitForTransaction(response.TxHash); err != nil {
+		return false, err
+	}
+	fmt.Println("Successfully finalized the withdraw requests.")
+
+	return true, nil
+}
+
+// Claim a withdraw request, or every finalized request of the node wallet if requestId is nil
+func claim(c *cli.Context, requestId *big.Int) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	nonce := c.GlobalUint64("nonce")
+	// If a custom nonce is set, print the multi-transaction warning
+	if nonce != 0 {
+		cliutils.PrintMultiTransactionNonceWarning()
+	}
+
+	autoConfirm := c.Bool("yes")
+
+	requestIds := []*big.Int{}
+	if requestId != nil {
+		requestIds = append(requestIds, requestId)
+	} else {
+		status, err := staderClient.SdPoolStatus()
+		if err != nil {
+			return err
+		}
+
+		// Requests are finalized in batches, so finalize the ones that are ready first
+		finalizable := false
+		for _, request := range status.WithdrawRequests {
+			if !request.Finalized && request.FinalizableBlock.Uint64() <= status.CurrentBlock {
+				finalizable = true
+				break
+			}
+		}
+		if finalizable {
+			fmt.Println("Some of your withdraw requests are ready but haven't been finalized yet.")
+			finalized, err := finalize(staderClient, autoConfirm)
+			if err != nil {
+				return err
+			}
+			if finalized {
+				if nonce != 0 {
+					staderClient.IncrementCustomNonce()
+				}
+				status, err = staderClient.SdPoolStatus()
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, request := range status.WithdrawRequests {
+			if request.Finalized {
+				requestIds = append(requestIds, request.RequestId)
+			}
+		}
+		if len(requestIds) == 0 {
+			fmt.Println("You have no finalized withdraw requests to claim.")
+			return nil
+		}
+	}
+
+	for _, id := range requestIds {
+		canClaim, err := staderClient.CanSdPoolClaim(id)
+		if err != nil {
+			return err
+		}
+		if canClaim.Paused {
+			fmt.Println("The SD Utility Pool is paused, please try again later.")
+			return nil
+		}
+		if canClaim.RequestMissing {
+			fmt.Printf("Withdraw request %s doesn't exist or has already been claimed.\n", id.String())
+			continue
+		}
+		if canClaim.NotOwner {
+			fmt.Printf("Withdraw request %s doesn't belong to your node wallet.\n", id.String())
+			continue
+		}
+		if canClaim.NotFinalized {
+			fmt.Printf("Withdraw request %s hasn't been finalized yet. Check when it can be finalized with `stader-cli sd-pool status`.\n", id.String())
+			continue
+		}
+
+		// Assign max fees
+		err = gas.AssignMaxFeeAndLimit(canClaim.GasInfo, staderClient, autoConfirm)
+		if err != nil {
+			return err
+		}
+
+		// Prompt for confirmation
+		if !(autoConfirm || cliutils.Confirm(fmt.Sprintf("Are you sure you want to claim %s from withdraw request %s?", eth.DisplayAmountInUnits(canClaim.SdAmount, "sd"), id.String()))) {
+			fmt.Println("Cancelled.")
+			continue
+		}
+
+		response, err := staderClient.SdPoolClaim(id)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Claiming withdraw request %s...\n", id.String())
+		cliutils.PrintTransactionHash(staderClient, response.TxHash)
+		if _, err = staderClient.WaitForTransaction(response.TxHash); err != nil {
+			return err
+		}
+		fmt.Printf("Successfully claimed %s.\n", eth.DisplayAmountInUnits(canClaim.SdAmount, "sd"))
+
+		if nonce != 0 {
+			staderClient.IncrementCustomNonce()
+		}
+	}
+
+	return nil
+}
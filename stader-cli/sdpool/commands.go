@@ -0,0 +1,143 @@
+package sdpool
+
+import (
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Register commands
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Delegate SD from the node wallet to the SD Utility Pool",
+		Subcommands: []cli.Command{
+			{
+				Name:      "status",
+				Aliases:   []string{"s"},
+				Usage:     "Get the delegated SD, the estimated yield and the open withdraw requests",
+				UsageText: "stader-cli sd-pool status",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getStatus(c)
+				},
+			},
+			{
+				Name:      "delegate",
+				Aliases:   []string{"d"},
+				Usage:     "Delegate SD to the SD Utility Pool",
+				UsageText: "stader-cli sd-pool delegate --amount <amount>",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "amount, a",
+						Usage: "The amount of SD to delegate",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the delegation",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					// Validate flags
+					amount, err := cliutils.ValidatePositiveEthAmount("delegate amount", c.String("amount"))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					return delegate(c, amount)
+				},
+			},
+			{
+				Name:      "request-withdraw",
+				Aliases:   []string{"rw"},
+				Usage:     "Request a withdrawal of delegated SD",
+				UsageText: "stader-cli sd-pool request-withdraw --amount <amount | max>",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "amount, a",
+						Usage: "The amount of SD to withdraw, or 'max' for the whole delegated balance",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the withdraw request",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					// Validate flags
+					amountWei := big.NewInt(0)
+					if c.String("amount") != "max" {
+						amount, err := cliutils.ValidatePositiveEthAmount("withdraw amount", c.String("amount"))
+						if err != nil {
+							return err
+						}
+						amountWei = eth.EthToWei(amount)
+					}
+
+					// Run
+					return requestWithdraw(c, amountWei)
+				},
+			},
+			{
+				Name:      "finalize",
+				Aliases:   []string{"f"},
+				Usage:     "Finalize the withdraw requests of the pool that are ready, so they can be claimed",
+				UsageText: "stader-cli sd-pool finalize",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the finalization",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return finalizeRequests(c)
+				},
+			},
+			{
+				Name:      "claim",
+				Aliases:   []string{"c"},
+				Usage:     "Claim the SD of finalized withdraw requests, finalizing them first if needed",
+				UsageText: "stader-cli sd-pool claim [--request-id <id>]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "request-id, r",
+						Usage: "The withdraw request to claim; all of your finalized requests are claimed if not set",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the claims",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					// Validate flags
+					var requestId *big.Int
+					if c.String("request-id") != "" {
+						id, err := cliutils.ValidateBigInt("request-id", c.String("request-id"))
+						if err != nil {
+							return err
+						}
+						requestId = id
+					}
+
+					// Run
+					return claim(c, requestId)
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+package sdpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/gas"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func delegate(c *cli.Context, amount float64) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	nonce := c.GlobalUint64("nonce")
+	// If a custom nonce is set, print the multi-transaction warning
+	if nonce != 0 {
+		cliutils.PrintMultiTransactionNonceWarning()
+	}
+
+	autoConfirm := c.Bool("yes")
+	amountWei := eth.EthToWei(amount)
+
+	canDelegate, err := staderClient.CanSdPoolDelegate(amountWei)
+	if err != nil {
+		return err
+	}
+	if canDelegate.Paused {
+		fmt.Println("The SD Utility Pool is paused, please try again later.")
+		return nil
+	}
+	if canDelegate.InsufficientBalance {
+		fmt.Println("You don't have enough SD in your node wallet to delegate this amount.")
+		return nil
+	}
+	if canDelegate.BelowMinimum {
+		fmt.Println("The amount is below the minimum delegation of the SD Utility Pool.")
+		return nil
+	}
+
+	// Check allowance
+	allowance, err := staderClient.SdPoolSdAllowance()
+	if err != nil {
+		return err
+	}
+	if allowance.Allowance.Cmp(amountWei) < 0 {
+		fmt.Println("Before delegating SD, you must first give the SD Utility Pool approval to interact with your SD.")
+		approved, err := approveSd(staderClient, amountWei, autoConfirm, nonce)
+		if err != nil || !approved {
+			return err
+		}
+
+		// The gas could not be estimated before the approval
+		canDelegate, err = staderClient.CanSdPoolDelegate(amountWei)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Assign max fees
+	err = gas.AssignMaxFeeAndLimit(canDelegate.GasInfo, staderClient, autoConfirm)
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	if !(autoConfirm || cliutils.Confirm(fmt.Sprintf("Are you sure you want to delegate %s to the SD Utility Pool?", eth.DisplayAmountInUnits(amountWei, "sd")))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	response, err := staderClient.SdPoolDelegate(amountWei)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Delegating SD...")
+	cliutils.PrintTransactionHash(staderClient, response.TxHash)
+	if _, err = staderClient.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully delegated %s to the SD Utility Pool.\n", eth.DisplayAmountInUnits(amountWei, "sd"))
+	return nil
+}
+
+// Approve the SD Utility Pool to spend SD from the node wallet; returns false if the user cancelled
+func approveSd(staderClient *stader.Client, amountWei *big.Int, autoConfirm bool, nonce uint64) (bool, error) {
+	approvalGas, err := staderClient.SdPoolSdApprovalGas(amountWei)
+	if err != nil {
+		return false, err
+	}
+	// Assign max fees
+	err = gas.AssignMaxFeeAndLimit(approvalGas.GasInfo, staderClient, autoConfirm)
+	if err != nil {
+		return false, err
+	}
+
+	// Prompt for confirmation
+	if !(autoConfirm || cliutils.Confirm(fmt.Sprintf("Do you want to approve %s to be spent by the SD Utility Pool?", eth.DisplayAmountInUnits(amountWei, "sd")))) {
+		fmt.Println("Cancelled.")
+		return false, nil
+	}
+
+	response, err := staderClient.SdPoolSdApprove(amountWei)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Println("Approving SD...")
+	cliutils.PrintTransactionHash(staderClient, response.ApproveTxHash)
+	if _, err = staderClient.WaitForTransaction(response.ApproveTxHash); err != nil {
+		return false, err
+	}
+	fmt.Println("Successfully approved SD.")
+
+	// If a custom nonce is set, increment it for the next transaction
+	if nonce != 0 {
+		staderClient.IncrementCustomNonce()
+	}
+
+	return true, nil
+}
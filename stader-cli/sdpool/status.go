@@ -0,0 +1,72 @@
+package sdpool
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func getStatus(c *cli.Context) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	status, err := staderClient.SdPoolStatus()
+	if err != nil {
+		return err
+	}
+
+	if status.Paused {
+		fmt.Printf("%sThe SD Utility Pool is paused.%s\n\n", log.ColorYellow, log.ColorReset)
+	}
+
+	fmt.Printf("%s=== Delegation ===%s\n", log.ColorGreen, log.ColorReset)
+	fmt.Printf("Wallet: %s\n", status.AccountAddress.Hex())
+	fmt.Printf("SD in wallet: %s\n", eth.DisplayAmountInUnits(status.SdBalance, "sd"))
+	fmt.Printf("Delegated SD: %s (%.6f cTokens)\n", eth.DisplayAmountInUnits(status.DelegatedSdBalance, "sd"), eth.WeiToEth(status.CTokenBalance))
+	fmt.Printf("Exchange rate: %.6f SD per cToken\n", eth.WeiToEth(status.ExchangeRate))
+	fmt.Printf("Delegation rate: %s%%\n", status.DelegationRate.Text('f', 2))
+	fmt.Printf("Estimated yield over a year: %s\n\n", eth.DisplayAmountInUnits(status.EstimatedYearlyYield, "sd"))
+
+	fmt.Printf("%s=== Pool ===%s\n", log.ColorGreen, log.ColorReset)
+	fmt.Printf("Available SD: %s\n", eth.DisplayAmountInUnits(status.PoolAvailableSDBalance, "sd"))
+	fmt.Printf("Utilized SD: %s\n", eth.DisplayAmountInUnits(status.TotalUtilizedSd, "sd"))
+	fmt.Printf("Utilization rate: %s%%\n", status.UtilizationRate.Text('f', 2))
+	fmt.Printf("Minimum delegation: %s\n", eth.DisplayAmountInUnits(status.MinDelegateAmount, "sd"))
+	fmt.Printf("Minimum withdrawal: %s\n\n", eth.DisplayAmountInUnits(status.MinWithdrawAmount, "sd"))
+
+	fmt.Printf("%s=== Withdraw Requests ===%s\n", log.ColorGreen, log.ColorReset)
+	if len(status.WithdrawRequests) == 0 {
+		fmt.Println("You have no open withdraw requests.")
+		return nil
+	}
+	fmt.Printf("%-10s %20s %20s %12s %s\n", "Request", "SD Expected", "SD Finalized", "Block", "State")
+	for _, request := range status.WithdrawRequests {
+		var state string
+		switch {
+		case request.Finalized:
+			state = "Ready to claim"
+		case request.FinalizableBlock.Uint64() <= status.CurrentBlock:
+			state = "Ready to finalize"
+		default:
+			state = fmt.Sprintf("Finalizable at block %s", request.FinalizableBlock.String())
+		}
+		fmt.Printf("%-10s %20.6f %20.6f %12s %s\n", request.RequestId.String(), eth.WeiToEth(request.SdExpected), eth.WeiToEth(request.SdFinalized), request.RequestBlock.String(), state)
+	}
+	fmt.Printf("\nYou can have at most %s open withdraw requests. Claim finalized requests with %sstader-cli sd-pool claim%s.\n", status.MaxOpenRequests.String(), log.ColorGreen, log.ColorReset)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package sdpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services/gas"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Request a withdrawal of delegated SD; a zero amount withdraws the whole balance
+func requestWithdraw(c *cli.Context, amountWei *big.Int) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// If a custom nonce is set, print the multi-transaction warning
+	if c.GlobalUint64("nonce") != 0 {
+		cliutils.PrintMultiTransactionNonceWarning()
+	}
+
+	canRequest, err := staderClient.CanSdPoolRequestWithdraw(amountWei)
+	if err != nil {
+		return err
+	}
+	if canRequest.Paused {
+		fmt.Println("The SD Utility Pool is paused, please try again later.")
+		return nil
+	}
+	if canRequest.InsufficientBalance {
+		fmt.Println("You don't have enough delegated SD to withdraw this amount. Check your balance with `stader-cli sd-pool status`.")
+		return nil
+	}
+	if canRequest.BelowMinimum {
+		fmt.Println("The amount is below the minimum withdrawal of the SD Utility Pool.")
+		return nil
+	}
+	if canRequest.TooManyRequests {
+		fmt.Println("You have too many open withdraw requests. Claim your finalized requests with `stader-cli sd-pool claim` and try again.")
+		return nil
+	}
+
+	// Assign max fees
+	err = gas.AssignMaxFeeAndLimit(canRequest.GasInfo, staderClient, c.Bool("yes"))
+	if err != nil {
+		return err
+	}
+
+	amountDescription := "all of your delegated SD"
+	if amountWei.Sign() > 0 {
+		amountDescription = eth.DisplayAmountInUnits(amountWei, "sd")
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to request the withdrawal of %s from the SD Utility Pool?\nThe request has to be finalized before the SD can be claimed.", amountDescription))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	response, err := staderClient.SdPoolRequestWithdraw(amountWei)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Requesting withdrawal...")
+	cliutils.PrintTransactionHash(staderClient, response.TxHash)
+	if _, err = staderClient.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully requested the withdrawal of %s. Follow its progress with `stader-cli sd-pool status`.\n", amountDescription)
+	return nil
+}
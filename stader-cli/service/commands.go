@@ -240,7 +240,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Flags: []cli.Flag{
 					cli.BoolFlag{
 						Name:  "ignore-slash-timer",
-						Usage: "Bypass the safety timer that forces a delay when switching to a new ETH2 client",
+						Usage: "Bypass the safety timer that forces a delay when switching to a new ETH2 client; the slashing protection history is still moved over",
 					},
 					cli.BoolFlag{
 						Name:  "yes, y",
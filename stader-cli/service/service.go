@@ -677,23 +677,23 @@ func startService(
 		return nil
 	}
 
-	if !c.Bool("ignore-slash-timer") {
-		// Do the client swap check
-		err := checkForValidatorChange(staderClient, cfg)
-		if err != nil {
-			fmt.Printf("%sWarning: couldn't verify that the validator container can be safely restarted:\n\t%s\n", colorYellow, err.Error())
-			fmt.Println("If you are changing to a different ETH2 client, it may resubmit an attestation you have already submitted.")
-			fmt.Println("This will slash your validator!")
-			fmt.Println("To prevent slashing, you must wait 15 minutes from the time you stopped the clients before starting them again.")
-			fmt.Println("**If you did NOT change clients, you can safely ignore this warning.**")
-			if !cliutils.Confirm(fmt.Sprintf("Press y when you understand the above warning, have waited, and are ready to start Stader:%s", colorReset)) {
-				fmt.Println("Cancelled.")
-				return nil
-			}
-		}
-	} else {
+	// Do the client swap check; the slashing protection history is always moved, only the delay can be skipped
+	ignoreSlashTimer := c.Bool("ignore-slash-timer")
+	if ignoreSlashTimer {
 		fmt.Printf("%sIgnoring anti-slashing safety delay.%s\n", colorYellow, colorReset)
 	}
+	err = checkForValidatorChange(staderClient, cfg, ignoreSlashTimer)
+	if err != nil {
+		fmt.Printf("%sWarning: couldn't verify that the validator container can be safely restarted:\n\t%s\n", colorYellow, err.Error())
+		fmt.Println("If you are changing to a different ETH2 client, it may resubmit an attestation you have already submitted.")
+		fmt.Println("This will slash your validator!")
+		fmt.Println("To prevent slashing, you must wait 15 minutes from the time you stopped the clients before starting them again.")
+		fmt.Println("**If you did NOT change clients, you can safely ignore this warning.**")
+		if !cliutils.Confirm(fmt.Sprintf("Press y when you understand the above warning, have waited, and are ready to start Stader:%s", colorReset)) {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
 
 	// Write a note on doppelganger protection
 	doppelgangerEnabled, err := cfg.IsDoppelgangerEnabled()
@@ -716,7 +716,7 @@ func startService(
 
 }
 
-func checkForValidatorChange(stader *stader.Client, cfg *config.StaderConfig) error {
+func checkForValidatorChange(stader *stader.Client, cfg *config.StaderConfig, ignoreSlashTimer bool) error {
 
 	// Get the container prefix
 	prefix, err := getContainerPrefix(stader)
@@ -780,10 +780,18 @@ func checkForValidatorChange(stader *stader.Client, cfg *config.StaderConfig) er
 			}
 		}
 
+		// Move the slashing protection history over to the new client before it starts
+		err = migrateSlashingProtection(stader, cfg, currentValidatorName, currentValidatorImageString)
+		if err != nil {
+			return fmt.Errorf("Error moving the slashing protection history from %s to %s: %w", currentValidatorName, pendingValidatorName, err)
+		}
+
 		// Print the warning and start the time lockout
 		safeStartTime := validatorFinishTime.Add(15 * time.Minute)
 		remainingTime := time.Until(safeStartTime)
-		if remainingTime <= 0 {
+		if ignoreSlashTimer {
+			fmt.Printf("%sStarting %s without waiting for the slashing prevention delay.%s\n", colorYellow, pendingValidatorName, colorReset)
+		} else if remainingTime <= 0 {
 			fmt.Printf("The validator has been offline for %s, which is long enough to prevent slashing.\n", time.Since(validatorFinishTime))
 			fmt.Println("The new client can be safely started.")
 		} else {
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
+)
+
+// Move the slashing protection history of the previous validator client into the new one, merging it with the history the new client already has.
+// The previous validator client must be stopped, and the new one not started yet.
+func migrateSlashingProtection(staderClient *stader.Client, cfg *config.StaderConfig, currentValidatorName string, currentValidatorImage string) error {
+
+	previousClient, err := getValidatorClientFromImageName(currentValidatorName)
+	if err != nil {
+		return err
+	}
+	previousImage := getSlashingProtectionImage(cfg, previousClient, currentValidatorImage)
+
+	newClient, _ := cfg.GetSelectedConsensusClient()
+	selectedConsensusClientConfig, err := cfg.GetSelectedConsensusClientConfig()
+	if err != nil {
+		return fmt.Errorf("Error getting selected consensus client config: %w", err)
+	}
+	newImage := getSlashingProtectionImage(cfg, newClient, selectedConsensusClientConfig.GetValidatorImage())
+
+	// Interchange files are kept in the validators folder so they can be used again if something goes wrong
+	validatorsPath, err := homedir.Expand(cfg.StaderNode.GetValidatorKeychainPathInCLI())
+	if err != nil {
+		return fmt.Errorf("Error loading validators folder path: %w", err)
+	}
+	interchangePath := filepath.Join(validatorsPath, stader.SlashingProtectionDir)
+	if err := os.MkdirAll(interchangePath, 0775); err != nil {
+		return fmt.Errorf("Error creating slashing protection folder: %w", err)
+	}
+	timestamp := time.Now().Unix()
+
+	// Export the history of both clients
+	fmt.Printf("Exporting the slashing protection history of %s...\n", previousClient)
+	previousFile := fmt.Sprintf("%s-export-%d.json", previousClient, timestamp)
+	previousInterchange, err := exportSlashingProtection(staderClient, cfg, previousClient, previousImage, interchangePath, previousFile)
+	if err != nil {
+		return err
+	}
+	if previousInterchange == nil {
+		fmt.Printf("%s has no slashing protection history to move.\n", previousClient)
+		return nil
+	}
+
+	fmt.Printf("Exporting the existing slashing protection history of %s...\n", newClient)
+	newFile := fmt.Sprintf("%s-export-%d.json", newClient, timestamp)
+	newInterchange, err := exportSlashingProtection(staderClient, cfg, newClient, newImage, interchangePath, newFile)
+	if err != nil {
+		return err
+	}
+
+	// Merge them, so the new client keeps anything it signed before
+	interchanges := []*eth2.SlashingProtectionInterchange{previousInterchange}
+	if newInterchange != nil {
+		interchanges = append(interchanges, newInterchange)
+	}
+	merged, err := eth2.MergeSlashingProtectionInterchanges(interchanges...)
+	if err != nil {
+		return err
+	}
+	bytes, err := json.MarshalIndent(merged, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error encoding slashing protection interchange: %w", err)
+	}
+	importFile := fmt.Sprintf("%s-import-%d.json", newClient, timestamp)
+	if err := os.WriteFile(filepath.Join(interchangePath, importFile), bytes, 0664); err != nil {
+		return fmt.Errorf("Error writing slashing protection interchange: %w", err)
+	}
+
+	// Import the merged history into the new client
+	fmt.Printf("Importing the slashing protection history of %d validators into %s...\n", len(merged.Data), newClient)
+	if err := staderClient.RunSlashingProtectionTool(cfg, newClient, newImage, "import", importFile); err != nil {
+		return fmt.Errorf("Error importing slashing protection history into %s: %w", newClient, err)
+	}
+	fmt.Printf("%sMoved the slashing protection history from %s to %s.%s\n", colorGreen, previousClient, newClient, colorReset)
+
+	return nil
+
+}
+
+// Export the slashing protection history of a validator client, returning nil if it has none
+func exportSlashingProtection(staderClient *stader.Client, cfg *config.StaderConfig, client cfgtypes.ConsensusClient, image string, interchangePath string, file string) (*eth2.SlashingProtectionInterchange, error) {
+
+	if err := staderClient.RunSlashingProtectionTool(cfg, client, image, "export", file); err != nil {
+		return nil, fmt.Errorf("Error exporting slashing protection history from %s: %w", client, err)
+	}
+
+	bytes, err := os.ReadFile(filepath.Join(interchangePath, file))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading slashing protection history of %s: %w", client, err)
+	}
+	return eth2.ParseSlashingProtectionInterchange(bytes)
+
+}
+
+// Get the validator client from the name of its Docker image
+func getValidatorClientFromImageName(imageName string) (cfgtypes.ConsensusClient, error) {
+	for _, client := range []cfgtypes.ConsensusClient{
+		cfgtypes.ConsensusClient_Lighthouse,
+		cfgtypes.ConsensusClient_Lodestar,
+		cfgtypes.ConsensusClient_Nimbus,
+		cfgtypes.ConsensusClient_Prysm,
+		cfgtypes.ConsensusClient_Teku,
+	} {
+		if strings.Contains(imageName, string(client)) {
+			return client, nil
+		}
+	}
+	return cfgtypes.ConsensusClient_Unknown, fmt.Errorf("Unknown validator client image [%s]", imageName)
+}
+
+// Get the image that holds the slashing protection tools of a validator client
+func getSlashingProtectionImage(cfg *config.StaderConfig, client cfgtypes.ConsensusClient, validatorImage string) string {
+	// The Nimbus validator client image doesn't include them, but the beacon node image does
+	if client == cfgtypes.ConsensusClient_Nimbus {
+		return cfg.Nimbus.BnContainerTag.Value.(string)
+	}
+	return validatorImage
+}
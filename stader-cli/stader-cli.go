@@ -27,6 +27,7 @@ import (
 	"github.com/stader-labs/stader-node/shared"
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
 	"github.com/stader-labs/stader-node/stader-cli/node"
+	"github.com/stader-labs/stader-node/stader-cli/sdpool"
 	"github.com/stader-labs/stader-node/stader-cli/service"
 	"github.com/stader-labs/stader-node/stader-cli/validator"
 	"github.com/stader-labs/stader-node/stader-cli/wallet"
@@ -764,6 +765,16 @@ Public License instead of this License. But first, please read
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.StringFlag{
+			Name: "offline",
+			Usage: "Add the transactions of write commands to an unsigned bundle for the node account with this `address` instead of signing and sending them, " +
+				"so the node wallet can be kept on an offline machine. See `stader-cli node bundle`",
+		},
+		cli.StringFlag{
+			Name: "address",
+			Usage: "Watch the node account with this `address` without its keys, e.g. on a support or monitoring machine. " +
+				"Read-only commands such as `node status` work as usual, and write commands are refused",
+		},
 		cli.BoolFlag{
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
@@ -818,6 +829,7 @@ Public License instead of this License. But first, please read
 	service.RegisterCommands(app, "service", []string{"s"})
 	wallet.RegisterCommands(app, "wallet", []string{"w"})
 	validator.RegisterCommands(app, "validator", []string{"v"})
+	sdpool.RegisterCommands(app, "sd-pool", []string{"sp"})
 	app.Commands = append(app.Commands, cli.Command{
 		Name:    "license",
 		Aliases: []string{"l"},
@@ -25,6 +25,7 @@ import (
 	"github.com/urfave/cli"
 
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/types"
 )
 
 // Register commands
@@ -69,13 +70,29 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 			{
 				Name:      "exit-validator",
 				Aliases:   []string{"e"},
-				Usage:     "Exit validator",
-				UsageText: "stader-cli validator exit-validator --validator-pub-key",
+				Usage:     "Exit validator, or several validators at once with one of the batch options",
+				UsageText: "stader-cli validator exit-validator --validator-pub-key | --validator-pub-keys | --file | --all-active | --oldest",
 				Flags: []cli.Flag{
 					cli.StringFlag{
 						Name:  "validator-pub-key, vpk",
 						Usage: "Public key of validator we want to exit",
 					},
+					cli.StringFlag{
+						Name:  "validator-pub-keys, vpks",
+						Usage: "Comma separated public keys of the validators we want to exit",
+					},
+					cli.StringFlag{
+						Name:  "file, f",
+						Usage: "Path of a file with the public keys of the validators we want to exit, separated by commas or new lines",
+					},
+					cli.BoolFlag{
+						Name:  "all-active",
+						Usage: "Exit every validator of the operator that is able to exit",
+					},
+					cli.Uint64Flag{
+						Name:  "oldest",
+						Usage: "Exit the given number of validators that were activated first",
+					},
 					cli.BoolFlag{
 						Name:  "yes, y",
 						Usage: "Automatically confirm validator exit",
@@ -83,6 +100,26 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 				Action: func(c *cli.Context) error {
 
+					// Batch mode
+					selections := 0
+					for _, flag := range []string{"validator-pub-keys", "file", "all-active", "oldest"} {
+						if c.IsSet(flag) {
+							selections++
+						}
+					}
+					if selections > 1 || (selections == 1 && c.IsSet("validator-pub-key")) {
+						return fmt.Errorf("only one of validator-pub-key, validator-pub-keys, file, all-active and oldest can be used")
+					}
+					if selections == 1 {
+						selection, err := parseExitSelection(c)
+						if err != nil {
+							return err
+						}
+
+						// Run
+						return exitValidators(c, selection)
+					}
+
 					//// Validate args
 					validatorPubKey, err := cliutils.ValidatePubkey("validator-pub-key", c.String("validator-pub-key"))
 					if err != nil {
@@ -118,6 +155,33 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return SendClRewards(c, validatorPubKey)
 				},
 			},
+			{
+				Name:      "sweep-cl-rewards",
+				Aliases:   []string{"swcr"},
+				Usage:     "Send the Consensus Layer rewards of every validator to the operator claim vault",
+				UsageText: "stader-cli validator sweep-cl-rewards [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "min-amount, m",
+						Usage: "Skip withdraw vaults holding less than this many ETH of rewards for the operator",
+						Value: "0.01",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm CL rewards send",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return sweepClRewards(c)
+				},
+			},
 			{
 				Name:      "status",
 				Aliases:   []string{"s"},
@@ -130,6 +194,98 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return getValidatorStatus(c)
 				},
 			},
+			{
+				Name:      "export-exits",
+				Aliases:   []string{"ee"},
+				Usage:     "Sign voluntary exit messages for your validators and save them for offline disaster recovery",
+				UsageText: "stader-cli validator export-exits [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "validator-pub-keys, vpks",
+						Usage: "Comma separated public keys of the validators to export, or 'all' for every active validator of the operator",
+						Value: "all",
+					},
+					cli.Uint64Flag{
+						Name:  "epoch, e",
+						Usage: "The epoch to sign the exits for. The exits can't be submitted before this epoch. Defaults to the current epoch",
+					},
+					cli.BoolFlag{
+						Name:  "encrypt",
+						Usage: "Save the exits as a single password protected archive instead of plain JSON files",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the export",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					var validatorPubKeys []types.ValidatorPubkey
+					if c.String("validator-pub-keys") != "all" {
+						var err error
+						validatorPubKeys, err = cliutils.ValidatePubkeys("validator-pub-keys", c.String("validator-pub-keys"))
+						if err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return exportExits(c, validatorPubKeys, c.Uint64("epoch"))
+				},
+			},
+			{
+				Name:      "open-exit-archive",
+				Aliases:   []string{"oea"},
+				Usage:     "Decrypt an archive made by export-exits into plain exit message files",
+				UsageText: "stader-cli validator open-exit-archive --archive path --output-dir path",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "archive, a",
+						Usage: "Path of the encrypted exit archive",
+					},
+					cli.StringFlag{
+						Name:  "output-dir, o",
+						Usage: "Folder to write the exit message files to",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					if c.String("archive") == "" {
+						return fmt.Errorf("archive is required")
+					}
+					if c.String("output-dir") == "" {
+						return fmt.Errorf("output-dir is required")
+					}
+
+					// Run
+					return openExitArchive(c.String("archive"), c.String("output-dir"))
+				},
+			},
+			{
+				Name:      "presign-status",
+				Aliases:   []string{"ps"},
+				Usage:     "Show which validator exit messages the presign daemon has generated and whether the Stader backend acknowledged them",
+				UsageText: "stader-cli validator presign-status",
+				Flags:     []cli.Flag{},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getPresignStatus(c)
+				},
+			},
 			{
 				Name:      "export",
 				Aliases:   []string{"e"},
@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+	"github.com/urfave/cli"
+)
+
+// Validators picked for a batch exit
+type exitSelection struct {
+	// Explicit pubkeys; empty means every validator of the operator
+	validatorPubKeys []types.ValidatorPubkey
+	// Only exit this many of the earliest activated validators, 0 for no limit
+	oldest uint64
+}
+
+func parseExitSelection(c *cli.Context) (exitSelection, error) {
+	switch {
+	case c.IsSet("validator-pub-keys"):
+		validatorPubKeys, err := cliutils.ValidatePubkeys("validator-pub-keys", c.String("validator-pub-keys"))
+		if err != nil {
+			return exitSelection{}, err
+		}
+		return exitSelection{validatorPubKeys: validatorPubKeys}, nil
+
+	case c.IsSet("file"):
+		path, err := homedir.Expand(c.String("file"))
+		if err != nil {
+			return exitSelection{}, err
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return exitSelection{}, fmt.Errorf("could not read %s: %w", path, err)
+		}
+		validatorPubKeys, err := cliutils.ValidatePubkeys("validator pub key", strings.Join(strings.Fields(strings.ReplaceAll(string(contents), ",", " ")), ","))
+		if err != nil {
+			return exitSelection{}, err
+		}
+		return exitSelection{validatorPubKeys: validatorPubKeys}, nil
+
+	case c.IsSet("oldest"):
+		if c.Uint64("oldest") == 0 {
+			return exitSelection{}, fmt.Errorf("oldest needs to be > 0")
+		}
+		return exitSelection{oldest: c.Uint64("oldest")}, nil
+	}
+
+	return exitSelection{}, nil
+}
+
+func exitValidators(c *cli.Context, selection exitSelection) error {
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// check canExit for every selected validator
+	plan, err := staderClient.CanExitValidators(selection.validatorPubKeys)
+	if err != nil {
+		return err
+	}
+
+	approved := []api.ValidatorExitPlan{}
+	rejected := []api.ValidatorExitPlan{}
+	for _, validatorPlan := range plan.Validators {
+		if validatorPlan.CanExit {
+			approved = append(approved, validatorPlan)
+		} else {
+			rejected = append(rejected, validatorPlan)
+		}
+	}
+	if selection.oldest > 0 {
+		sort.SliceStable(approved, func(i, j int) bool {
+			return approved[i].ActivationEpoch < approved[j].ActivationEpoch
+		})
+		if uint64(len(approved)) > selection.oldest {
+			approved = approved[:selection.oldest]
+		}
+	}
+
+	// Print the plan
+	fmt.Printf("%s=== Exit Plan (epoch %d) ===%s\n", log.ColorGreen, plan.CurrentEpoch, log.ColorReset)
+	if len(rejected) > 0 {
+		fmt.Printf("\n%d validators can't exit:\n", len(rejected))
+		for _, validatorPlan := range rejected {
+			fmt.Printf("- %s: %s\n", validatorPlan.ValidatorPubKey, validatorPlan.Reason)
+		}
+	}
+	if len(approved) == 0 {
+		fmt.Println("\nNo validators can exit right now.")
+		return nil
+	}
+	fmt.Printf("\n%d validators will exit:\n", len(approved))
+	for _, validatorPlan := range approved {
+		fmt.Printf("- %s (index %d, activated at epoch %d)\n", validatorPlan.ValidatorPubKey, validatorPlan.ValidatorIndex, validatorPlan.ActivationEpoch)
+	}
+	fmt.Println()
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf(
+		"Are you sure you want to exit these %d validators? This can't be undone.", len(approved)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// now exit
+	validatorPubKeys := make([]types.ValidatorPubkey, 0, len(approved))
+	for _, validatorPlan := range approved {
+		validatorPubKeys = append(validatorPubKeys, validatorPlan.ValidatorPubKey)
+	}
+	exitResponse, err := staderClient.ExitValidators(validatorPubKeys)
+	if err != nil {
+		return err
+	}
+
+	for _, validatorPubKey := range exitResponse.Exited {
+		fmt.Printf("Exiting validator %s, you can check the validator status at %s\n", validatorPubKey, fmt.Sprintf("%s/validator/%s#withdrawals", exitResponse.BeaconChainUrl, validatorPubKey))
+	}
+	for pubkey, reason := range exitResponse.Skipped {
+		fmt.Printf("%sSkipped validator %s: %s%s\n", log.ColorYellow, pubkey, reason, log.ColorReset)
+	}
+	for pubkey, reason := range exitResponse.Failed {
+		fmt.Printf("%sCould not exit validator %s: %s%s\n", log.ColorRed, pubkey, reason, log.ColorReset)
+	}
+	fmt.Printf("\n%d of %d validators are exiting.\n", len(exitResponse.Exited), len(approved))
+
+	return nil
+}
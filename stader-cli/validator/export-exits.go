@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/stader-labs/stader-node/shared/services/passwords"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+	"github.com/urfave/cli"
+)
+
+func exportExits(c *cli.Context, validatorPubKeys []types.ValidatorPubkey, exitEpoch uint64) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%sAnyone holding these exit messages can exit your validators. Store them offline and never share them.%s\n\n", log.ColorYellow, log.ColorReset)
+
+	if len(validatorPubKeys) == 0 {
+		fmt.Println("Exit messages will be signed for all active validators of the operator.")
+	} else {
+		fmt.Printf("Exit messages will be signed for %d validators.\n", len(validatorPubKeys))
+	}
+	if !(c.Bool("yes") || cliutils.Confirm("Do you want to continue?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	password := ""
+	if c.Bool("encrypt") {
+		password = promptArchivePassword()
+	}
+
+	response, err := staderClient.ExportExits(exitEpoch, validatorPubKeys, password)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Skipped) > 0 {
+		fmt.Printf("\nThe following validators were skipped:\n")
+		skipped := make([]string, 0, len(response.Skipped))
+		for pubkey := range response.Skipped {
+			skipped = append(skipped, pubkey)
+		}
+		sort.Strings(skipped)
+		for _, pubkey := range skipped {
+			fmt.Printf("- %s: %s\n", pubkey, response.Skipped[pubkey])
+		}
+	}
+
+	if len(response.Exported) == 0 {
+		fmt.Println("\nNo exit messages were exported.")
+		return nil
+	}
+
+	fmt.Printf("\nSigned %d exit messages for epoch %d (the current epoch is %d).\n", len(response.Exported), response.ExitEpoch, response.CurrentEpoch)
+	if response.Encrypted {
+		fmt.Printf("The exit messages were saved to the encrypted archive %s\n", response.OutputPath)
+		fmt.Printf("Use %sstader-cli validator open-exit-archive%s to extract them when you need them.\n", log.ColorGreen, log.ColorReset)
+	} else {
+		fmt.Printf("The exit messages were saved to %s\n", response.OutputPath)
+		fmt.Println("Each file can be submitted to any beacon node at POST /eth/v1/beacon/pool/voluntary_exits.")
+	}
+	fmt.Println("Copy them to offline storage and remove them from this machine.")
+
+	return nil
+}
+
+func openExitArchive(archivePath string, outputDir string) error {
+
+	archivePath, err := homedir.Expand(archivePath)
+	if err != nil {
+		return err
+	}
+	outputDir, err = homedir.Expand(outputDir)
+	if err != nil {
+		return err
+	}
+
+	password := cliutils.PromptPassword("Please enter the password of the exit archive:", "^.*$", "")
+	files, err := validator.ReadExitArchive(archivePath, password)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return fmt.Errorf("could not create %s: %w", outputDir, err)
+	}
+	for fileName, contents := range files {
+		if err := os.WriteFile(filepath.Join(outputDir, fileName), contents, 0600); err != nil {
+			return fmt.Errorf("could not write %s: %w", fileName, err)
+		}
+	}
+
+	fmt.Printf("Extracted %d exit messages to %s\n", len(files), outputDir)
+	fmt.Println("Each file can be submitted to any beacon node at POST /eth/v1/beacon/pool/voluntary_exits.")
+
+	return nil
+}
+
+// Prompt for the exit archive password
+func promptArchivePassword() string {
+	for {
+		password := cliutils.PromptPassword(
+			"Please enter a password to encrypt the exit archive with:",
+			fmt.Sprintf("^.{%d,}$", passwords.MinPasswordLength),
+			fmt.Sprintf("Your password must be at least %d characters long. Please try again:", passwords.MinPasswordLength),
+		)
+		confirmation := cliutils.PromptPassword("Please confirm your password:", "^.*$", "")
+		if password == confirmation {
+			return password
+		}
+		fmt.Println("Password confirmation does not match.")
+		fmt.Println("")
+	}
+}
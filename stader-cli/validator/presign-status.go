@@ -0,0 +1,75 @@
+package validator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+func getPresignStatus(c *cli.Context) error {
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	status, err := staderClient.PresignStatus()
+	if err != nil {
+		return err
+	}
+
+	if status.LastPassStartedAt == 0 {
+		fmt.Printf("The presign daemon has not recorded a pass yet. Make sure the node daemon is running.\n")
+	} else {
+		fmt.Printf("%s=== Last Presign Pass ===%s\n", log.ColorGreen, log.ColorReset)
+		fmt.Printf("Started: %s\n", formatPresignTime(status.LastPassStartedAt))
+		fmt.Printf("Finished: %s\n", formatPresignTime(status.LastPassFinishedAt))
+		fmt.Printf("Keys checked: %d, newly signed: %d, accepted by the backend: %d\n", status.LastPassKeysChecked, status.LastPassKeysSigned, status.LastPassKeysSent)
+		if status.LastPassBackendError != "" {
+			fmt.Printf("%sThe Stader backend could not be reached: %s%s\n", log.ColorRed, status.LastPassBackendError, log.ColorReset)
+		}
+	}
+	fmt.Println()
+
+	fmt.Printf("%s=== Presigned Exit Messages ===%s\n", log.ColorGreen, log.ColorReset)
+	if len(status.Entries) == 0 {
+		fmt.Printf("No exit messages have been recorded yet.\n")
+	} else {
+		fmt.Printf("%-10s%-100s%-14s%-12s%-9s%s\n", "Index", "Validator Pub Key", "Status", "Exit Epoch", "Retries", "Last Attempt")
+		for _, entry := range status.Entries {
+			fmt.Printf("%-10d%-100s%-14s%-12d%-9d%s\n", entry.ValidatorIndex, entry.Pubkey, entry.Status, entry.ExitEpoch, entry.Retries, formatPresignTime(entry.LastAttemptAt))
+			if entry.LastError != "" {
+				fmt.Printf("%s  Last error: %s%s\n", log.ColorYellow, entry.LastError, log.ColorReset)
+			}
+		}
+	}
+
+	if len(status.UntrackedValidatorKeys) > 0 {
+		fmt.Printf("\nThe following registered validators have no record in the ledger yet. The daemon will pick them up on its next pass:\n")
+		for _, pubkey := range status.UntrackedValidatorKeys {
+			fmt.Printf("- %s\n", pubkey)
+		}
+	}
+
+	fmt.Printf("\nLedger file: %s\n", status.LedgerPath)
+
+	return nil
+}
+
+func formatPresignTime(timestamp int64) string {
+	if timestamp == 0 {
+		return "-"
+	}
+	return time.Unix(timestamp, 0).Format(time.RFC3339)
+}
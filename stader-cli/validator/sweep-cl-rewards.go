@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/stader-labs/stader-node/shared/services/gas"
+	"github.com/stader-labs/stader-node/shared/services/stader"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+	"github.com/urfave/cli"
+)
+
+func sweepClRewards(c *cli.Context) error {
+	minimumEth, err := cliutils.ValidateEthAmount("min-amount", c.String("min-amount"))
+	if err != nil {
+		return err
+	}
+	minimum := eth.EthToWei(minimumEth)
+
+	staderClient, err := stader.NewClientFromCtx(c)
+	if err != nil {
+		return err
+	}
+	defer staderClient.Close()
+
+	// Check and assign the EC status
+	err = cliutils.CheckClientStatus(staderClient)
+	if err != nil {
+		return err
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(staderClient)
+	if err != nil {
+		return err
+	}
+
+	plan, err := staderClient.CanSweepClRewards(minimum)
+	if err != nil {
+		return err
+	}
+
+	// Print the plan
+	distributed := 0
+	fmt.Printf("%s=== CL Rewards Sweep ===%s\n", log.ColorGreen, log.ColorReset)
+	for _, vault := range plan.Vaults {
+		switch {
+		case vault.Distribute:
+			distributed++
+		case vault.CrossedRewardsThreshold:
+			fmt.Printf("- %s: skipped, its vault holds %s which is above the rewards threshold of %s. Please wait for the Stader oracles to settle it; your share will be %s.\n",
+				vault.ValidatorPubKey, eth.DisplayAmountInUnits(vault.Balance, "eth"), eth.DisplayAmountInUnits(plan.RewardsThreshold, "eth"), eth.DisplayAmountInUnits(vault.OperatorWithdrawShare, "eth"))
+		case vault.BelowMinimum:
+			fmt.Printf("- %s: skipped, your rewards of %s are below the minimum of %s\n",
+				vault.ValidatorPubKey, eth.DisplayAmountInUnits(vault.OperatorRewards, "eth"), eth.DisplayAmountInUnits(minimum, "eth"))
+		case vault.SkipReason != "":
+			fmt.Printf("- %s: skipped, %s\n", vault.ValidatorPubKey, vault.SkipReason)
+		}
+	}
+	if distributed == 0 {
+		fmt.Println("\nNo withdraw vaults have CL rewards to send.")
+		return nil
+	}
+	fmt.Printf("\n%d withdraw vaults will send %s of CL rewards to the claim vault:\n", distributed, eth.DisplayAmountInUnits(plan.TotalRewards, "eth"))
+	for _, vault := range plan.Vaults {
+		if vault.Distribute {
+			fmt.Printf("- %s: %s\n", vault.ValidatorPubKey, eth.DisplayAmountInUnits(vault.OperatorRewards, "eth"))
+		}
+	}
+	fmt.Println()
+
+	err = gas.AssignMaxFeeAndLimit(plan.GasInfo, staderClient, c.Bool("yes"))
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf(
+		"Are you sure you want to send the CL rewards of these %d validators to the claim vault?", distributed))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	res, err := staderClient.SweepClRewards(minimum)
+	if err != nil {
+		return err
+	}
+
+	for pubKey, reason := range res.Failed {
+		fmt.Printf("%sCould not send the CL rewards of %s: %s%s\n", log.ColorRed, pubKey, reason, log.ColorReset)
+	}
+	for _, swept := range res.Swept {
+		fmt.Printf("Sending %s CL rewards of %s to the claim vault\n", eth.DisplayAmountInUnits(swept.ClRewardsAmount, "eth"), swept.ValidatorPubKey)
+		cliutils.PrintTransactionHash(staderClient, swept.TxHash)
+	}
+	for _, swept := range res.Swept {
+		if _, err = staderClient.WaitForTransaction(swept.TxHash); err != nil {
+			return err
+		}
+	}
+
+	// Log & return
+	fmt.Printf("Sent the CL rewards of %d validators to the claim vault\n\n", len(res.Swept))
+	return nil
+}
@@ -0,0 +1,187 @@
+package multicall
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// The number of calls sent in a single aggregate3 call
+const DefaultBatchSize = 100
+
+// The subset of the Multicall3 ABI used here
+const multicallAbi = `[
+	{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"},
+	{"inputs":[{"internalType":"address","name":"addr","type":"address"}],"name":"getEthBalance","outputs":[{"internalType":"uint256","name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// A pending read and where to store its result
+type call struct {
+	target   common.Address
+	abi      *abi.ABI
+	method   string
+	callData []byte
+	output   interface{}
+}
+
+// Batches view calls into Multicall3 aggregate3 calls, so a set of reads costs a few round trips
+// and every result comes from the same block
+type MultiCaller struct {
+	Address   common.Address
+	BatchSize int
+
+	contract *stader.Contract
+	calls    []call
+	err      error
+}
+
+// Create a new MultiCaller for the Multicall3 contract at the given address
+func NewMultiCaller(client stader.ExecutionClient, address common.Address) (*MultiCaller, error) {
+	parsedAbi, err := abi.JSON(strings.NewReader(multicallAbi))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the Multicall3 ABI: %w", err)
+	}
+
+	return &MultiCaller{
+		Address:   address,
+		BatchSize: DefaultBatchSize,
+		contract: &stader.Contract{
+			Contract: bind.NewBoundContract(address, parsedAbi, client, client, client),
+			Address:  &address,
+			ABI:      &parsedAbi,
+			Client:   client,
+		},
+	}, nil
+}
+
+// Queue a view call; output must be a pointer to the method's return type,
+// or to a struct with one field per return value when the method returns several
+func (mc *MultiCaller) AddCall(contract *stader.Contract, output interface{}, method string, args ...interface{}) {
+	if mc.err != nil {
+		return
+	}
+	callData, err := contract.ABI.Pack(method, args...)
+	if err != nil {
+		mc.err = fmt.Errorf("error encoding %s call: %w", method, err)
+		return
+	}
+	mc.calls = append(mc.calls, call{
+		target:   *contract.Address,
+		abi:      contract.ABI,
+		method:   method,
+		callData: callData,
+		output:   output,
+	})
+}
+
+// Queue a read of the ETH balance of an address
+func (mc *MultiCaller) AddEthBalance(output **big.Int, address common.Address) {
+	mc.AddCall(mc.contract, output, "getEthBalance", address)
+}
+
+// Run the queued calls with the given options and store their results; the queue is cleared afterwards
+func (mc *MultiCaller) Execute(opts *bind.CallOpts) error {
+	calls := mc.calls
+	err := mc.err
+	mc.calls = nil
+	mc.err = nil
+	if err != nil {
+		return err
+	}
+
+	batchSize := mc.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	for start := 0; start < len(calls); start += batchSize {
+		end := start + batchSize
+		if end > len(calls) {
+			end = len(calls)
+		}
+		if err := mc.executeBatch(calls[start:end], opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Send one aggregate3 call and decode its results
+func (mc *MultiCaller) executeBatch(calls []call, opts *bind.CallOpts) error {
+	input := make([]call3, len(calls))
+	for i, c := range calls {
+		input[i] = call3{
+			Target:       c.target,
+			AllowFailure: true,
+			CallData:     c.callData,
+		}
+	}
+
+	var results []result
+	if err := mc.contract.Call(opts, &results, "aggregate3", input); err != nil {
+		return fmt.Errorf("error running multicall: %w", err)
+	}
+	if len(results) != len(calls) {
+		return fmt.Errorf("multicall returned %d results for %d calls", len(results), len(calls))
+	}
+
+	for i, c := range calls {
+		if !results[i].Success {
+			return fmt.Errorf("%s call to %s reverted", c.method, c.target.Hex())
+		}
+		if err := unpack(c.abi, c.method, results[i].ReturnData, c.output); err != nil {
+			return fmt.Errorf("error decoding %s call to %s: %w", c.method, c.target.Hex(), err)
+		}
+	}
+	return nil
+}
+
+// Decode the return data of a method into output, the way the generated bindings do
+func unpack(contractAbi *abi.ABI, method string, data []byte, output interface{}) (err error) {
+	values, err := contractAbi.Unpack(method, data)
+	if err != nil {
+		return err
+	}
+
+	target := reflect.ValueOf(output)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return fmt.Errorf("output must be a non-nil pointer")
+	}
+	target = target.Elem()
+
+	// abi.ConvertType panics on a type mismatch
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	if len(values) == 1 {
+		target.Set(reflect.ValueOf(abi.ConvertType(values[0], output)).Elem())
+		return nil
+	}
+	if target.Kind() != reflect.Struct || target.NumField() < len(values) {
+		return fmt.Errorf("output needs a struct with %d fields", len(values))
+	}
+	for i, value := range values {
+		field := target.Field(i)
+		field.Set(reflect.ValueOf(abi.ConvertType(value, field.Addr().Interface())).Elem())
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package multicall
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+const testAbi = `[
+	{"inputs":[],"name":"getValue","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"getPair","outputs":[{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"owner","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"getInfo","outputs":[{"components":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"bool","name":"active","type":"bool"}],"internalType":"struct Test.Info","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"getValues","outputs":[{"internalType":"uint256[]","name":"","type":"uint256[]"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"getOwners","outputs":[{"internalType":"address[]","name":"","type":"address[]"}],"stateMutability":"view","type":"function"}
+]`
+
+type testPair struct {
+	Amount *big.Int
+	Owner  common.Address
+}
+
+type testInfo struct {
+	Id     *big.Int
+	Active bool
+}
+
+var (
+	ownerA = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	ownerB = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+func parseAbi(t *testing.T, definition string) *abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &parsed
+}
+
+// Encode the return values of a method
+func packOutputs(t *testing.T, contractAbi *abi.ABI, method string, values ...interface{}) []byte {
+	data, err := contractAbi.Methods[method].Outputs.Pack(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestUnpack(t *testing.T) {
+	contractAbi := parseAbi(t, testAbi)
+
+	t.Run("single value", func(t *testing.T) {
+		var value *big.Int
+		if err := unpack(contractAbi, "getValue", packOutputs(t, contractAbi, "getValue", big.NewInt(42)), &value); err != nil {
+			t.Fatal(err)
+		}
+		if value.Cmp(big.NewInt(42)) != 0 {
+			t.Errorf("expected 42, got %s", value)
+		}
+	})
+
+	t.Run("several values", func(t *testing.T) {
+		var pair testPair
+		if err := unpack(contractAbi, "getPair", packOutputs(t, contractAbi, "getPair", big.NewInt(7), ownerA), &pair); err != nil {
+			t.Fatal(err)
+		}
+		if pair.Amount.Cmp(big.NewInt(7)) != 0 || pair.Owner != ownerA {
+			t.Errorf("expected 7 and %s, got %s and %s", ownerA.Hex(), pair.Amount, pair.Owner.Hex())
+		}
+	})
+
+	t.Run("tuple", func(t *testing.T) {
+		data := packOutputs(t, contractAbi, "getInfo", testInfo{Id: big.NewInt(3), Active: true})
+		var info testInfo
+		if err := unpack(contractAbi, "getInfo", data, &info); err != nil {
+			t.Fatal(err)
+		}
+		if info.Id.Cmp(big.NewInt(3)) != 0 || !info.Active {
+			t.Errorf("expected an active info with id 3, got %+v", info)
+		}
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		data := packOutputs(t, contractAbi, "getValues", []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)})
+		var values []*big.Int
+		if err := unpack(contractAbi, "getValues", data, &values); err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != 3 || values[0].Int64() != 1 || values[1].Int64() != 2 || values[2].Int64() != 3 {
+			t.Errorf("expected [1 2 3], got %v", values)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		values := []common.Address{ownerA}
+		if err := unpack(contractAbi, "getOwners", packOutputs(t, contractAbi, "getOwners", []common.Address{}), &values); err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != 0 {
+			t.Errorf("expected no owners, got %v", values)
+		}
+	})
+
+	t.Run("mismatched output", func(t *testing.T) {
+		var value bool
+		if err := unpack(contractAbi, "getValue", packOutputs(t, contractAbi, "getValue", big.NewInt(42)), &value); err == nil {
+			t.Error("expected an error decoding a uint256 into a bool")
+		}
+	})
+
+	t.Run("several values without a struct", func(t *testing.T) {
+		var value *big.Int
+		if err := unpack(contractAbi, "getPair", packOutputs(t, contractAbi, "getPair", big.NewInt(7), ownerA), &value); err == nil {
+			t.Error("expected an error decoding several values into one")
+		}
+	})
+
+	t.Run("not a pointer", func(t *testing.T) {
+		var value *big.Int
+		if err := unpack(contractAbi, "getValue", packOutputs(t, contractAbi, "getValue", big.NewInt(42)), value); err == nil {
+			t.Error("expected an error decoding into a nil pointer")
+		}
+	})
+
+	t.Run("truncated data", func(t *testing.T) {
+		var value *big.Int
+		if err := unpack(contractAbi, "getValue", []byte{1, 2, 3}, &value); err == nil {
+			t.Error("expected an error decoding truncated data")
+		}
+	})
+}
+
+// Answers aggregate3 calls with the given results, one list per batch
+type fakeClient struct {
+	stader.ExecutionClient
+
+	multicallAbi *abi.ABI
+	batches      [][]result
+	calls        [][]call3
+}
+
+func (f *fakeClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method := f.multicallAbi.Methods["aggregate3"]
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	var calls []call3
+	abi.ConvertType(args[0], &calls)
+	f.calls = append(f.calls, calls)
+
+	results := f.batches[0]
+	f.batches = f.batches[1:]
+	return method.Outputs.Pack(results)
+}
+
+func newTestMultiCaller(t *testing.T, batches ...[]result) (*MultiCaller, *fakeClient, *stader.Contract) {
+	client := &fakeClient{
+		multicallAbi: parseAbi(t, multicallAbi),
+		batches:      batches,
+	}
+	mc, err := NewMultiCaller(client, common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	contract := &stader.Contract{
+		Address: &address,
+		ABI:     parseAbi(t, testAbi),
+		Client:  client,
+	}
+	return mc, client, contract
+}
+
+func TestExecute(t *testing.T) {
+	contractAbi := parseAbi(t, testAbi)
+	mc, client, contract := newTestMultiCaller(t,
+		[]result{
+			{Success: true, ReturnData: packOutputs(t, contractAbi, "getValue", big.NewInt(42))},
+			{Success: true, ReturnData: packOutputs(t, contractAbi, "getPair", big.NewInt(7), ownerB)},
+		},
+		[]result{
+			{Success: true, ReturnData: packOutputs(t, contractAbi, "getOwners", []common.Address{ownerA, ownerB})},
+		},
+	)
+	mc.BatchSize = 2
+
+	var value *big.Int
+	var pair testPair
+	var owners []common.Address
+	mc.AddCall(contract, &value, "getValue")
+	mc.AddCall(contract, &pair, "getPair")
+	mc.AddCall(contract, &owners, "getOwners")
+	if err := mc.Execute(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.calls) != 2 || len(client.calls[0]) != 2 || len(client.calls[1]) != 1 {
+		t.Fatalf("expected batches of 2 and 1 calls, got %d batches", len(client.calls))
+	}
+	for _, batch := range client.calls {
+		for _, c := range batch {
+			if c.Target != *contract.Address || !c.AllowFailure {
+				t.Errorf("expected a call to %s that allows failure, got %+v", contract.Address.Hex(), c)
+			}
+		}
+	}
+	if value.Cmp(big.NewInt(42)) != 0 {
+		t.Errorf("expected 42, got %s", value)
+	}
+	if pair.Amount.Cmp(big.NewInt(7)) != 0 || pair.Owner != ownerB {
+		t.Errorf("expected 7 and %s, got %s and %s", ownerB.Hex(), pair.Amount, pair.Owner.Hex())
+	}
+	if len(owners) != 2 || owners[0] != ownerA || owners[1] != ownerB {
+		t.Errorf("expected %s and %s, got %v", ownerA.Hex(), ownerB.Hex(), owners)
+	}
+}
+
+func TestExecuteFailedCall(t *testing.T) {
+	contractAbi := parseAbi(t, testAbi)
+	mc, _, contract := newTestMultiCaller(t, []result{
+		{Success: true, ReturnData: packOutputs(t, contractAbi, "getValue", big.NewInt(42))},
+		{Success: false, ReturnData: []byte{}},
+	})
+
+	var value *big.Int
+	var pair testPair
+	mc.AddCall(contract, &value, "getValue")
+	mc.AddCall(contract, &pair, "getPair")
+	err := mc.Execute(nil)
+	if err == nil {
+		t.Fatal("expected an error for the failed call")
+	}
+	if !strings.Contains(err.Error(), "getPair") {
+		t.Errorf("expected the error to name the failed call, got %s", err.Error())
+	}
+	if pair.Amount != nil {
+		t.Errorf("expected the failed call's output to be left alone, got %s", pair.Amount)
+	}
+}
+
+func TestExecuteClearsQueue(t *testing.T) {
+	mc, _, contract := newTestMultiCaller(t)
+
+	var value *big.Int
+	mc.AddCall(contract, &value, "missingMethod")
+	if err := mc.Execute(nil); err == nil {
+		t.Fatal("expected an error for an unknown method")
+	}
+
+	// The failed queue is gone, so an empty Execute sends nothing
+	if err := mc.Execute(nil); err != nil {
+		t.Errorf("expected an empty queue after Execute, got %s", err.Error())
+	}
+}
@@ -1,6 +1,8 @@
 package node
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/stader-labs/stader-node/stader-lib/stader"
 )
@@ -8,3 +10,8 @@ import (
 func IsPermissionlessNodeRegistryPaused(pnr *stader.PermissionlessNodeRegistryContractManager, opts *bind.CallOpts) (bool, error) {
 	return pnr.PermissionlessNodeRegistry.Paused(opts)
 }
+
+// The ETH bond an operator puts up per validator
+func GetCollateralEth(pnr *stader.PermissionlessNodeRegistryContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return pnr.PermissionlessNodeRegistry.GetCollateralETH(opts)
+}
@@ -95,6 +95,47 @@ func ProposeRewardAddress(pnr *stader.PermissionlessNodeRegistryContractManager,
 	return tx, nil
 }
 
+func GetProposedRewardAddress(pnr *stader.PermissionlessNodeRegistryContractManager, operatorId *big.Int, opts *bind.CallOpts) (common.Address, error) {
+	return pnr.PermissionlessNodeRegistry.ProposedRewardAddressByOperatorId(opts, operatorId)
+}
+
+// Get the calldata of the confirmRewardAddressChange call the proposed reward address has to send
+func GetConfirmRewardAddressChangeData(pnr *stader.PermissionlessNodeRegistryContractManager, operatorAddress common.Address) ([]byte, error) {
+	data, err := pnr.PermissionlessNodeRegistryContract.ABI.Pack("confirmRewardAddressChange", operatorAddress)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode confirmRewardAddressChange call: %w", err)
+	}
+
+	return data, nil
+}
+
+// opts.From must be the proposed reward address
+func EstimateConfirmRewardAddressChange(pnr *stader.PermissionlessNodeRegistryContractManager, operatorAddress common.Address, opts *bind.TransactOpts) (stader.GasInfo, error) {
+	return pnr.PermissionlessNodeRegistryContract.GetTransactionGasInfo(opts, "confirmRewardAddressChange", operatorAddress)
+}
+
+// Get the reward address changes of an operator confirmed between the given blocks; a nil end block means the latest block
+func GetOperatorRewardAddressUpdatedEvents(pnr *stader.PermissionlessNodeRegistryContractManager, operatorAddress common.Address, startBlock uint64, endBlock *uint64) ([]*contracts.PermissionlessNodeRegistryOperatorRewardAddressUpdated, error) {
+	iterator, err := pnr.PermissionlessNodeRegistry.FilterOperatorRewardAddressUpdated(&bind.FilterOpts{
+		Start: startBlock,
+		End:   endBlock,
+	}, []common.Address{operatorAddress}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get OperatorRewardAddressUpdated events: %w", err)
+	}
+	defer iterator.Close()
+
+	events := []*contracts.PermissionlessNodeRegistryOperatorRewardAddressUpdated{}
+	for iterator.Next() {
+		events = append(events, iterator.Event)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("Could not read OperatorRewardAddressUpdated events: %w", err)
+	}
+
+	return events, nil
+}
+
 func EstimateWithdrawFromNodeElVault(client stader.ExecutionClient, nevAddress common.Address, opts *bind.TransactOpts) (stader.GasInfo, error) {
 	nev, err := stader.NewNodeElRewardVaultFactory(client, nevAddress)
 	if err != nil {
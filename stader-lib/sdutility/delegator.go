@@ -0,0 +1,123 @@
+package sdutility
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	stadertypes "github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+func EstimateDelegate(sp *stader.SDUtilityPoolContractManager, sdAmount *big.Int, opts *bind.TransactOpts) (stader.GasInfo, error) {
+	return sp.SDUtilityPoolContract.GetTransactionGasInfo(opts, "delegate", sdAmount)
+}
+
+func Delegate(sp *stader.SDUtilityPoolContractManager, sdAmount *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
+	return sp.SDUtilityPool.Delegate(opts, sdAmount)
+}
+
+func EstimateRequestWithdraw(sp *stader.SDUtilityPoolContractManager, cTokenAmount *big.Int, opts *bind.TransactOpts) (stader.GasInfo, error) {
+	return sp.SDUtilityPoolContract.GetTransactionGasInfo(opts, "requestWithdraw", cTokenAmount)
+}
+
+func RequestWithdraw(sp *stader.SDUtilityPoolContractManager, cTokenAmount *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
+	return sp.SDUtilityPool.RequestWithdraw(opts, cTokenAmount)
+}
+
+func EstimateRequestWithdrawWithSDAmount(sp *stader.SDUtilityPoolContractManager, sdAmount *big.Int, opts *bind.TransactOpts) (stader.GasInfo, error) {
+	return sp.SDUtilityPoolContract.GetTransactionGasInfo(opts, "requestWithdrawWithSDAmount", sdAmount)
+}
+
+func RequestWithdrawWithSDAmount(sp *stader.SDUtilityPoolContractManager, sdAmount *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
+	return sp.SDUtilityPool.RequestWithdrawWithSDAmount(opts, sdAmount)
+}
+
+func EstimateFinalizeDelegatorWithdrawalRequest(sp *stader.SDUtilityPoolContractManager, opts *bind.TransactOpts) (stader.GasInfo, error) {
+	return sp.SDUtilityPoolContract.GetTransactionGasInfo(opts, "finalizeDelegatorWithdrawalRequest")
+}
+
+func FinalizeDelegatorWithdrawalRequest(sp *stader.SDUtilityPoolContractManager, opts *bind.TransactOpts) (*types.Transaction, error) {
+	return sp.SDUtilityPool.FinalizeDelegatorWithdrawalRequest(opts)
+}
+
+func EstimateClaim(sp *stader.SDUtilityPoolContractManager, requestId *big.Int, opts *bind.TransactOpts) (stader.GasInfo, error) {
+	return sp.SDUtilityPoolContract.GetTransactionGasInfo(opts, "claim", requestId)
+}
+
+func Claim(sp *stader.SDUtilityPoolContractManager, requestId *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
+	return sp.SDUtilityPool.Claim(opts, requestId)
+}
+
+// exchangeRateCurrent accrues the fee before returning the rate, so it is simulated with a call instead of sent
+func ExchangeRateCurrent(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	exchangeRate := new(*big.Int)
+	if err := sp.SDUtilityPoolContract.Call(opts, exchangeRate, "exchangeRateCurrent"); err != nil {
+		return nil, err
+	}
+	return *exchangeRate, nil
+}
+
+func GetDelegatorCTokenBalance(sp *stader.SDUtilityPoolContractManager, delegator common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.DelegatorCTokenBalance(opts, delegator)
+}
+
+func GetDelegatorLatestSDBalance(sp *stader.SDUtilityPoolContractManager, delegator common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.GetDelegatorLatestSDBalance(opts, delegator)
+}
+
+func GetDelegatorWithdrawRequestedCTokenCount(sp *stader.SDUtilityPoolContractManager, delegator common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.DelegatorWithdrawRequestedCTokenCount(opts, delegator)
+}
+
+func GetRequestIdsByDelegator(sp *stader.SDUtilityPoolContractManager, delegator common.Address, opts *bind.CallOpts) ([]*big.Int, error) {
+	return sp.SDUtilityPool.GetRequestIdsByDelegator(opts, delegator)
+}
+
+func GetDelegatorWithdrawRequest(sp *stader.SDUtilityPoolContractManager, requestId *big.Int, opts *bind.CallOpts) (stadertypes.DelegatorWithdrawRequest, error) {
+	return sp.SDUtilityPool.DelegatorWithdrawRequests(opts, requestId)
+}
+
+func GetNextRequestIdToFinalize(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.NextRequestIdToFinalize(opts)
+}
+
+func GetMinBlockDelayToFinalizeRequest(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.MinBlockDelayToFinalizeRequest(opts)
+}
+
+func GetMaxNonRedeemedDelegatorRequestCount(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.MaxNonRedeemedDelegatorRequestCount(opts)
+}
+
+func GetMinSDDelegateLimit(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.MINSDDELEGATELIMIT(opts)
+}
+
+func GetMinSDWithdrawLimit(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.MINSDWITHDRAWLIMIT(opts)
+}
+
+func IsPaused(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (bool, error) {
+	return sp.SDUtilityPool.Paused(opts)
+}
+
+func GetDelegationRatePercent(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Float, error) {
+	delegationRatePerBlockInWei, err := sp.SDUtilityPool.GetDelegationRatePerBlock(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationRatePerYear := new(big.Int).Mul(delegationRatePerBlockInWei, big.NewInt(2628000)) // 2628000 block per year
+
+	delegationRatePerYearF := new(big.Float).SetInt(delegationRatePerYear)
+
+	delegationRateInPercent := new(big.Float).Quo(delegationRatePerYearF, big.NewFloat(1e16))
+
+	return delegationRateInPercent, nil
+}
+
+func GetNextRequestId(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.NextRequestId(opts)
+}
@@ -0,0 +1,209 @@
+package sdutility
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// 12 second slots
+const BlocksPerDay = 7200
+
+var decimal = big.NewInt(1e18)
+
+// The inputs of an utilizer's health factor, as the SD utility pool computes it:
+// the ETH collateral of its validators, valued in SD, times the liquidation threshold, over the interest owed in SD
+type RiskPosition struct {
+	PrincipalSd *big.Int
+	InterestSd  *big.Int
+
+	// The validators' ETH the pool counts as collateral
+	CollateralEth *big.Int
+
+	// The ETH value of 1 SD, with 18 decimals
+	SdPriceEth *big.Int
+
+	// In percent
+	LiquidationThreshold *big.Int
+
+	// The interest rate per block, with 18 decimals
+	UtilizationRatePerBlock *big.Int
+}
+
+func GetLiquidationThreshold(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	riskConfig, err := sp.SDUtilityPool.RiskConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return riskConfig.LiquidationThreshold, nil
+}
+
+// The ETH the pool counts as collateral for each of the operator's validators
+func GetConservativeEthPerKey(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.ConservativeEthPerKey(opts)
+}
+
+func GetOperatorTotalEth(sp *stader.SDUtilityPoolContractManager, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	return sp.SDUtilityPool.GetOperatorTotalEth(opts, address)
+}
+
+// Read the utilizer's current position
+func GetRiskPosition(sp *stader.SDUtilityPoolContractManager, sdc *stader.SdCollateralContractManager, address common.Address, opts *bind.CallOpts) (RiskPosition, error) {
+	balance, err := GetUtilizerLatestBalance(sp, address, opts)
+	if err != nil {
+		return RiskPosition{}, err
+	}
+	principal, err := sdc.SdCollateral.OperatorUtilizedSDBalance(opts, address)
+	if err != nil {
+		return RiskPosition{}, err
+	}
+	collateralEth, err := GetOperatorTotalEth(sp, address, opts)
+	if err != nil {
+		return RiskPosition{}, err
+	}
+	sdPriceEth, err := sdc.SdCollateral.ConvertSDToETH(opts, decimal)
+	if err != nil {
+		return RiskPosition{}, err
+	}
+	liquidationThreshold, err := GetLiquidationThreshold(sp, opts)
+	if err != nil {
+		return RiskPosition{}, err
+	}
+	utilizationRatePerBlock, err := sp.SDUtilityPool.UtilizationRatePerBlock(opts)
+	if err != nil {
+		return RiskPosition{}, err
+	}
+
+	interest := new(big.Int).Sub(balance, principal)
+	if interest.Sign() < 0 {
+		interest = big.NewInt(0)
+	}
+	return RiskPosition{
+		PrincipalSd:             principal,
+		InterestSd:              interest,
+		CollateralEth:           collateralEth,
+		SdPriceEth:              sdPriceEth,
+		LiquidationThreshold:    liquidationThreshold,
+		UtilizationRatePerBlock: utilizationRatePerBlock,
+	}, nil
+}
+
+// The health factor with 18 decimals; the pool returns the max uint256 when no interest is owed
+func (p RiskPosition) HealthFactor() *big.Int {
+	if p.InterestSd.Sign() == 0 {
+		return new(big.Int).Set(math.MaxBig256)
+	}
+	if p.SdPriceEth.Sign() == 0 {
+		return big.NewInt(0)
+	}
+
+	collateralSd := new(big.Int).Mul(p.CollateralEth, decimal)
+	collateralSd.Quo(collateralSd, p.SdPriceEth)
+
+	healthFactor := new(big.Int).Mul(collateralSd, p.LiquidationThreshold)
+	healthFactor.Mul(healthFactor, decimal)
+	return healthFactor.Quo(healthFactor, new(big.Int).Mul(p.InterestSd, big.NewInt(100)))
+}
+
+// The position after the interest of the given number of blocks accrues, compounded daily
+func (p RiskPosition) After(blocks uint64) RiskPosition {
+	balance := new(big.Int).Add(p.PrincipalSd, p.InterestSd)
+	for blocks > 0 {
+		period := uint64(BlocksPerDay)
+		if blocks < period {
+			period = blocks
+		}
+		growth := new(big.Int).Mul(p.UtilizationRatePerBlock, new(big.Int).SetUint64(period))
+		balance.Add(balance, new(big.Int).Quo(new(big.Int).Mul(balance, growth), decimal))
+		blocks -= period
+	}
+
+	projected := p
+	projected.InterestSd = balance.Sub(balance, p.PrincipalSd)
+	return projected
+}
+
+// The position after a repayment, which pays the interest before the principal
+func (p RiskPosition) Repay(amount *big.Int) RiskPosition {
+	repaid := p
+	if amount.Cmp(p.InterestSd) <= 0 {
+		repaid.InterestSd = new(big.Int).Sub(p.InterestSd, amount)
+		return repaid
+	}
+	repaid.InterestSd = big.NewInt(0)
+	repaid.PrincipalSd = new(big.Int).Sub(p.PrincipalSd, new(big.Int).Sub(amount, p.InterestSd))
+	if repaid.PrincipalSd.Sign() < 0 {
+		repaid.PrincipalSd = big.NewInt(0)
+	}
+	return repaid
+}
+
+// The position with its SD price changed by the given percentage, e.g. -25 for a 25% drop
+func (p RiskPosition) WithPriceChange(percent int64) RiskPosition {
+	changed := p
+	changed.SdPriceEth = new(big.Int).Mul(p.SdPriceEth, big.NewInt(100+percent))
+	changed.SdPriceEth.Quo(changed.SdPriceEth, big.NewInt(100))
+	if changed.SdPriceEth.Sign() < 0 {
+		changed.SdPriceEth = big.NewInt(0)
+	}
+	return changed
+}
+
+// The position with more ETH collateral, like the collateral of added validators
+func (p RiskPosition) WithCollateral(ethAmount *big.Int) RiskPosition {
+	changed := p
+	changed.CollateralEth = new(big.Int).Add(p.CollateralEth, ethAmount)
+	return changed
+}
+
+// The smallest SD repayment made now that keeps the health factor at or above the target after the given number of blocks
+func (p RiskPosition) RepayForHealthFactor(target *big.Int, blocks uint64) *big.Int {
+	if p.After(blocks).HealthFactor().Cmp(target) >= 0 {
+		return big.NewInt(0)
+	}
+
+	// Repaying everything leaves no interest to accrue, so the search always ends
+	low := big.NewInt(0)
+	high := new(big.Int).Add(p.PrincipalSd, p.InterestSd)
+	one := big.NewInt(1)
+	for new(big.Int).Sub(high, low).Cmp(one) > 0 {
+		middle := new(big.Int).Add(low, high)
+		middle.Rsh(middle, 1)
+		if p.Repay(middle).After(blocks).HealthFactor().Cmp(target) >= 0 {
+			high = middle
+		} else {
+			low = middle
+		}
+	}
+	return high
+}
+
+// The ETH collateral to add now that keeps the health factor at or above the target after the given number of blocks.
+// Returns nil if no collateral is enough, when the liquidation threshold is 0.
+func (p RiskPosition) CollateralForHealthFactor(target *big.Int, blocks uint64) *big.Int {
+	projected := p.After(blocks)
+	if projected.HealthFactor().Cmp(target) >= 0 {
+		return big.NewInt(0)
+	}
+
+	// collateral >= target * interest * 100 * price / (threshold * 1e18 * 1e18), rounded up
+	needed := new(big.Int).Mul(target, projected.InterestSd)
+	needed.Mul(needed, big.NewInt(100))
+	needed.Mul(needed, p.SdPriceEth)
+	divisor := new(big.Int).Mul(p.LiquidationThreshold, new(big.Int).Mul(decimal, decimal))
+	if divisor.Sign() == 0 {
+		return nil
+	}
+	needed.Add(needed, new(big.Int).Sub(divisor, big.NewInt(1)))
+	needed.Quo(needed, divisor)
+
+	missing := needed.Sub(needed, p.CollateralEth)
+	if missing.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return missing
+}
@@ -0,0 +1,216 @@
+package sdutility
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// An amount with 18 decimals
+func units(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), decimal)
+}
+
+// 1 ETH of collateral at 0.001 ETH per SD and a 70% threshold backs 700 SD of interest at a health factor of 1
+func testPosition(interestSd *big.Int) RiskPosition {
+	return RiskPosition{
+		PrincipalSd:             units(2000),
+		InterestSd:              interestSd,
+		CollateralEth:           units(1),
+		SdPriceEth:              big.NewInt(1e15),
+		LiquidationThreshold:    big.NewInt(70),
+		UtilizationRatePerBlock: big.NewInt(0),
+	}
+}
+
+func TestHealthFactor(t *testing.T) {
+	zeroPrice := testPosition(units(700))
+	zeroPrice.SdPriceEth = big.NewInt(0)
+
+	tests := []struct {
+		name     string
+		position RiskPosition
+		expected *big.Int
+	}{
+		{"no interest", testPosition(big.NewInt(0)), math.MaxBig256},
+		{"healthy", testPosition(units(350)), units(2)},
+		{"at the liquidation boundary", testPosition(units(700)), units(1)},
+		{"just past the liquidation boundary", testPosition(new(big.Int).Add(units(700), big.NewInt(1))), new(big.Int).Sub(units(1), big.NewInt(1))},
+		{"no SD price", zeroPrice, big.NewInt(0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if healthFactor := test.position.HealthFactor(); healthFactor.Cmp(test.expected) != 0 {
+				t.Errorf("expected a health factor of %s, got %s", test.expected, healthFactor)
+			}
+		})
+	}
+}
+
+func TestAfter(t *testing.T) {
+	accruing := testPosition(big.NewInt(0))
+	accruing.UtilizationRatePerBlock = big.NewInt(1e12)
+
+	tests := []struct {
+		name     string
+		position RiskPosition
+		blocks   uint64
+		expected *big.Int
+	}{
+		{"no interest rate", testPosition(units(10)), BlocksPerDay * 30, units(10)},
+		{"no blocks", accruing, 0, big.NewInt(0)},
+		// 2000 SD at 0.0072 per day
+		{"one day", accruing, BlocksPerDay, new(big.Int).Mul(big.NewInt(144), big.NewInt(1e17))},
+		// The second day accrues on the first day's interest too
+		{"two days", accruing, BlocksPerDay * 2, new(big.Int).Mul(big.NewInt(2890368), big.NewInt(1e13))},
+		{"half a day", accruing, BlocksPerDay / 2, new(big.Int).Mul(big.NewInt(72), big.NewInt(1e17))},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			after := test.position.After(test.blocks)
+			if after.InterestSd.Cmp(test.expected) != 0 {
+				t.Errorf("expected %s SD wei of interest, got %s", test.expected, after.InterestSd)
+			}
+			if after.PrincipalSd.Cmp(test.position.PrincipalSd) != 0 {
+				t.Errorf("principal should not change, got %s", after.PrincipalSd)
+			}
+		})
+	}
+}
+
+func TestRepay(t *testing.T) {
+	tests := []struct {
+		name              string
+		amount            *big.Int
+		expectedInterest  *big.Int
+		expectedPrincipal *big.Int
+	}{
+		{"nothing", big.NewInt(0), units(100), units(2000)},
+		{"part of the interest", units(40), units(60), units(2000)},
+		{"all of the interest", units(100), big.NewInt(0), units(2000)},
+		{"into the principal", units(600), big.NewInt(0), units(1500)},
+		{"more than is owed", units(5000), big.NewInt(0), big.NewInt(0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			position := testPosition(units(100))
+			repaid := position.Repay(test.amount)
+			if repaid.InterestSd.Cmp(test.expectedInterest) != 0 {
+				t.Errorf("expected %s SD wei of interest, got %s", test.expectedInterest, repaid.InterestSd)
+			}
+			if repaid.PrincipalSd.Cmp(test.expectedPrincipal) != 0 {
+				t.Errorf("expected %s SD wei of principal, got %s", test.expectedPrincipal, repaid.PrincipalSd)
+			}
+			if position.InterestSd.Cmp(units(100)) != 0 || position.PrincipalSd.Cmp(units(2000)) != 0 {
+				t.Error("repaying should not change the original position")
+			}
+		})
+	}
+}
+
+func TestWithPriceChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		percent  int64
+		expected *big.Int
+	}{
+		{"no change", 0, big.NewInt(1e15)},
+		{"drop", -25, big.NewInt(75e13)},
+		{"rise", 50, big.NewInt(15e14)},
+		{"wiped out", -100, big.NewInt(0)},
+		{"below zero", -150, big.NewInt(0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changed := testPosition(units(700)).WithPriceChange(test.percent)
+			if changed.SdPriceEth.Cmp(test.expected) != 0 {
+				t.Errorf("expected an SD price of %s, got %s", test.expected, changed.SdPriceEth)
+			}
+		})
+	}
+}
+
+func TestRepayForHealthFactor(t *testing.T) {
+	accruing := testPosition(units(700))
+	accruing.UtilizationRatePerBlock = big.NewInt(1e12)
+
+	tests := []struct {
+		name     string
+		position RiskPosition
+		target   *big.Int
+		blocks   uint64
+		expected *big.Int
+	}{
+		{"already healthy", testPosition(units(350)), units(1), 0, big.NewInt(0)},
+		{"no interest", testPosition(big.NewInt(0)), units(2), BlocksPerDay * 30, big.NewInt(0)},
+		{"exactly at the target", testPosition(units(700)), units(1), 0, big.NewInt(0)},
+		{"down to the liquidation boundary", testPosition(units(1400)), units(1), 0, units(700)},
+		{"down to twice the boundary", testPosition(units(1400)), units(2), 0, units(1050)},
+		{"past the boundary by one wei", testPosition(new(big.Int).Add(units(700), big.NewInt(1))), units(1), 0, big.NewInt(1)},
+		{"interest accruing", accruing, units(1), BlocksPerDay, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repay := test.position.RepayForHealthFactor(test.target, test.blocks)
+			if test.expected != nil && repay.Cmp(test.expected) != 0 {
+				t.Errorf("expected a repay of %s SD wei, got %s", test.expected, repay)
+			}
+
+			// The repay is the smallest one that reaches the target
+			if test.position.Repay(repay).After(test.blocks).HealthFactor().Cmp(test.target) < 0 {
+				t.Errorf("repaying %s SD wei should reach the target health factor", repay)
+			}
+			if repay.Sign() > 0 {
+				less := new(big.Int).Sub(repay, big.NewInt(1))
+				if test.position.Repay(less).After(test.blocks).HealthFactor().Cmp(test.target) >= 0 {
+					t.Errorf("repaying %s SD wei should already reach the target health factor", less)
+				}
+			}
+		})
+	}
+}
+
+func TestCollateralForHealthFactor(t *testing.T) {
+	noThreshold := testPosition(units(1400))
+	noThreshold.LiquidationThreshold = big.NewInt(0)
+
+	tests := []struct {
+		name     string
+		position RiskPosition
+		target   *big.Int
+		expected *big.Int
+	}{
+		{"no interest", testPosition(big.NewInt(0)), units(2), big.NewInt(0)},
+		{"already healthy", testPosition(units(350)), units(1), big.NewInt(0)},
+		{"exactly at the target", testPosition(units(700)), units(1), big.NewInt(0)},
+		{"up to the liquidation boundary", testPosition(units(1400)), units(1), units(1)},
+		{"up to twice the boundary", testPosition(units(1400)), units(2), units(3)},
+		// Rounded up so the added collateral always reaches the target
+		{"past the boundary by one wei", testPosition(new(big.Int).Add(units(700), big.NewInt(1))), units(1), big.NewInt(1)},
+		{"no liquidation threshold", noThreshold, units(1), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			collateral := test.position.CollateralForHealthFactor(test.target, 0)
+			if test.expected == nil {
+				if collateral != nil {
+					t.Errorf("expected no amount of collateral to be enough, got %s", collateral)
+				}
+				return
+			}
+			if collateral.Cmp(test.expected) != 0 {
+				t.Errorf("expected %s ETH wei of collateral, got %s", test.expected, collateral)
+			}
+			if test.position.WithCollateral(collateral).HealthFactor().Cmp(test.target) < 0 {
+				t.Errorf("adding %s ETH wei of collateral should reach the target health factor", collateral)
+			}
+		})
+	}
+}
@@ -30,17 +30,22 @@ func GetPoolAvailableSDBalance(sp *stader.SDUtilityPoolContractManager, opts *bi
 		return nil, err
 	}
 
+	return CalculatePoolAvailableSDBalance(utilityPoolBalance, sdRequestedForWithdraw, accumulatedProtocolFee), nil
+}
+
+// The SD that can be utilized: the pool balance minus the SD requested for withdrawal and the protocol fee
+func CalculatePoolAvailableSDBalance(utilityPoolBalance *big.Int, sdRequestedForWithdraw *big.Int, accumulatedProtocolFee *big.Int) *big.Int {
 	utilityPoolBalanceMinusSdForWithdraw := big.NewInt(0).Sub(utilityPoolBalance, sdRequestedForWithdraw)
 	if utilityPoolBalanceMinusSdForWithdraw.Cmp(big.NewInt(0)) < 0 {
-		return big.NewInt(0), nil
+		return big.NewInt(0)
 	}
 
 	availableSdBalance := big.NewInt(0).Sub(utilityPoolBalanceMinusSdForWithdraw, accumulatedProtocolFee)
 	if availableSdBalance.Cmp(big.NewInt(0)) < 0 {
-		return big.NewInt(0), nil
+		return big.NewInt(0)
 	}
 
-	return availableSdBalance, nil
+	return availableSdBalance
 }
 
 func GetUtilityPoolBalance(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
@@ -153,3 +158,13 @@ func GetTotalTVL(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (
 func GetTotalUtilized(sp *stader.SDUtilityPoolContractManager, opts *bind.CallOpts) (*big.Int, error) {
 	return sp.SDUtilityPool.TotalUtilizedSD(opts)
 }
+
+// The SD utilized by the utilizer without the interest accrued on it
+func GetUtilizerPrincipal(sp *stader.SDUtilityPoolContractManager, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	utilizerData, err := sp.SDUtilityPool.UtilizerData(opts, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return utilizerData.Principal, nil
+}
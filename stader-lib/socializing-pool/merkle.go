@@ -0,0 +1,47 @@
+package socializing_pool
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Get the rewards data reported on chain for a rewards cycle
+func GetCycleRewardsData(sp *stader.SocializingPoolContractManager, index *big.Int, opts *bind.CallOpts) (types.CycleRewardsData, error) {
+	return sp.SocializingPool.RewardsDataMap(opts, index)
+}
+
+// Get the merkle root reported on chain for a rewards cycle. The root is zero if the cycle has not been reported yet
+func GetCycleMerkleRoot(sp *stader.SocializingPoolContractManager, index *big.Int, opts *bind.CallOpts) ([32]byte, error) {
+	rewardsData, err := GetCycleRewardsData(sp, index, opts)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return rewardsData.MerkleRoot, nil
+}
+
+// Compute the leaf of an operator in a cycle's rewards tree, i.e keccak256(abi.encodePacked(operator, amountSd, amountEth))
+func ComputeMerkleLeaf(operator common.Address, amountSd *big.Int, amountEth *big.Int) [32]byte {
+	return crypto.Keccak256Hash(operator.Bytes(), math.U256Bytes(new(big.Int).Set(amountSd)), math.U256Bytes(new(big.Int).Set(amountEth)))
+}
+
+// Walk the proof up from the leaf to the root, hashing sorted pairs like OpenZeppelin's MerkleProof
+func ComputeMerkleRoot(leaf [32]byte, merkleProof [][32]byte) [32]byte {
+	computedHash := leaf
+	for _, proofElement := range merkleProof {
+		if bytes.Compare(computedHash[:], proofElement[:]) <= 0 {
+			computedHash = crypto.Keccak256Hash(computedHash[:], proofElement[:])
+		} else {
+			computedHash = crypto.Keccak256Hash(proofElement[:], computedHash[:])
+		}
+	}
+
+	return computedHash
+}
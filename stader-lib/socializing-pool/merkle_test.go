@@ -0,0 +1,47 @@
+package socializing_pool
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestComputeMerkleRoot(t *testing.T) {
+	operatorA := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	operatorB := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	leafA := ComputeMerkleLeaf(operatorA, big.NewInt(10), big.NewInt(20))
+	leafB := ComputeMerkleLeaf(operatorB, big.NewInt(30), big.NewInt(40))
+
+	var root [32]byte
+	if bytes.Compare(leafA[:], leafB[:]) <= 0 {
+		root = crypto.Keccak256Hash(leafA[:], leafB[:])
+	} else {
+		root = crypto.Keccak256Hash(leafB[:], leafA[:])
+	}
+
+	if ComputeMerkleRoot(leafA, [][32]byte{leafB}) != root {
+		t.Error("proof for operator A should hash to the root")
+	}
+	if ComputeMerkleRoot(leafB, [][32]byte{leafA}) != root {
+		t.Error("proof for operator B should hash to the root")
+	}
+
+	tamperedLeaf := ComputeMerkleLeaf(operatorA, big.NewInt(11), big.NewInt(20))
+	if ComputeMerkleRoot(tamperedLeaf, [][32]byte{leafB}) == root {
+		t.Error("tampered amounts should not hash to the root")
+	}
+}
+
+func TestComputeMerkleLeaf(t *testing.T) {
+	operator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	packed := append(operator.Bytes(), common.LeftPadBytes(big.NewInt(10).Bytes(), 32)...)
+	packed = append(packed, common.LeftPadBytes(big.NewInt(20).Bytes(), 32)...)
+
+	if ComputeMerkleLeaf(operator, big.NewInt(10), big.NewInt(20)) != crypto.Keccak256Hash(packed) {
+		t.Error("leaf should be keccak256 of the packed operator and amounts")
+	}
+}
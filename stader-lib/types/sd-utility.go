@@ -0,0 +1,15 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type DelegatorWithdrawRequest struct {
+	Owner          common.Address
+	AmountOfCToken *big.Int
+	SdExpected     *big.Int
+	SdFinalized    *big.Int
+	RequestBlock   *big.Int
+}
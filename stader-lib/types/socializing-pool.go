@@ -12,3 +12,14 @@ type CurrentRewardCycleDetails struct {
 	StartBlock *big.Int
 	EndBlock   *big.Int
 }
+
+type CycleRewardsData struct {
+	ReportingBlockNumber *big.Int
+	Index                *big.Int
+	MerkleRoot           [32]byte
+	PoolId               uint8
+	OperatorETHRewards   *big.Int
+	UserETHRewards       *big.Int
+	ProtocolETHRewards   *big.Int
+	OperatorSDRewards    *big.Int
+}
@@ -5,6 +5,16 @@ import (
 	"math/big"
 )
 
+// The status of a validator in the pool contracts
+const (
+	ValidatorStatusInitialized      uint8 = 0
+	ValidatorStatusInvalidSignature uint8 = 1
+	ValidatorStatusFrontRun         uint8 = 2
+	ValidatorStatusPreDeposit       uint8 = 3
+	ValidatorStatusDeposited        uint8 = 4
+	ValidatorStatusWithdrawn        uint8 = 5
+)
+
 type ValidatorContractInfo struct {
 	Status               uint8
 	Pubkey               []byte
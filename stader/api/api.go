@@ -30,6 +30,7 @@ import (
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
 	"github.com/stader-labs/stader-node/stader-lib/utils"
 	"github.com/stader-labs/stader-node/stader/api/node"
+	"github.com/stader-labs/stader-node/stader/api/sdpool"
 	apiservice "github.com/stader-labs/stader-node/stader/api/service"
 	"github.com/stader-labs/stader-node/stader/api/wallet"
 )
@@ -75,6 +76,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 	wallet.RegisterSubcommands(&command, "wallet", []string{"w"})
 	apiservice.RegisterSubcommands(&command, "service", []string{"s"})
 	validator.RegisterSubcommands(&command, "validator", []string{"v"})
+	sdpool.RegisterSubcommands(&command, "sd-pool", []string{"sp"})
 
 	// Append a general wait-for-transaction command to support async operations
 	command.Subcommands = append(command.Subcommands, cli.Command{
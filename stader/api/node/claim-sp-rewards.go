@@ -1,12 +1,17 @@
 package node
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	stader_utils "github.com/stader-labs/stader-node/shared/utils/stader"
 	string_utils "github.com/stader-labs/stader-node/shared/utils/string-utils"
 	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
 	"github.com/stader-labs/stader-node/stader-lib/stader"
@@ -159,6 +164,14 @@ func estimateSpRewardsGas(c *cli.Context, stringifiedCycles string, depositSd bo
 		return nil, err
 	}
 
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySpClaimData(cfg, sp, nodeAccount.Address, cycles); err != nil {
+		return nil, err
+	}
+
 	amountSd, amountEth, merkleProofs, err := cfg.StaderNode.GetClaimData(cycles)
 	if err != nil {
 		return nil, err
@@ -207,6 +220,14 @@ func claimSpRewards(c *cli.Context, stringifiedCycles string, depositSd bool) (*
 		return nil, err
 	}
 
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySpClaimData(cfg, sp, nodeAccount.Address, cycles); err != nil {
+		return nil, err
+	}
+
 	response := api.ClaimSpRewardsResponse{}
 	amountSd, amountEth, merkleProofs, err := cfg.StaderNode.GetClaimData(cycles)
 	if err != nil {
@@ -236,3 +257,31 @@ func claimSpRewards(c *cli.Context, stringifiedCycles string, depositSd bool) (*
 
 	return &response, nil
 }
+
+// Check the downloaded proofs of every cycle before they reach the contract, so a bad proof file never turns into a reverted claim
+func verifySpClaimData(cfg *config.StaderConfig, sp *stader.SocializingPoolContractManager, operator common.Address, cycles []*big.Int) error {
+	for _, cycle := range cycles {
+		cycleMerkleProof, exists, err := cfg.StaderNode.ReadCycleCache(cycle.Int64())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("merkle proof for cycle %d has not been downloaded", cycle.Int64())
+		}
+
+		err = stader_utils.VerifyCycleMerkleProofs(sp, operator, &cycleMerkleProof)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, stader_utils.ErrMerkleRootNotReported) {
+			if _, qErr := stader_utils.QuarantineCycleMerkleProofs(cfg, &cycleMerkleProof); qErr != nil {
+				return qErr
+			}
+			return fmt.Errorf("merkle proof for cycle %d failed verification and was quarantined, please download the proofs again: %w", cycle.Int64(), err)
+		}
+
+		return fmt.Errorf("merkle proof for cycle %d could not be verified: %w", cycle.Int64(), err)
+	}
+
+	return nil
+}
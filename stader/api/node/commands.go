@@ -463,6 +463,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "send-signed-tx",
+				Usage:     "Sends a transaction signed by the node's private key on another machine. The TX must be serialized as a hex string.",
+				UsageText: "stader-cli api node send-signed-tx tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					data := c.Args().Get(0)
+
+					// Run
+					api.PrintResponse(sendSignedTx(c, data))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-update-socialize-el",
 				Usage:     "Can opt in or opt out of socializing pool",
@@ -664,6 +683,169 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "tx-list",
+				Usage:     "Get the transactions sent by the node wallet",
+				UsageText: "stader-cli api node tx-list",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getTxList(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-replace-tx",
+				Usage:     "Check whether a pending transaction of the node wallet can be sped up or cancelled",
+				UsageText: "stader-cli api node can-replace-tx tx-hash cancel",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					cancel, err := cliutils.ValidateBool("cancel", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canReplaceTx(c, hash, cancel))
+					return nil
+
+				},
+			},
+			{
+				Name:      "replace-tx",
+				Usage:     "Speed up or cancel a pending transaction of the node wallet",
+				UsageText: "stader-cli api node replace-tx tx-hash cancel",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					hash, err := cliutils.ValidateTxHash("tx-hash", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					cancel, err := cliutils.ValidateBool("cancel", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(replaceTx(c, hash, cancel))
+					return nil
+
+				},
+			},
+			{
+				Name:      "history",
+				Usage:     "Get the guardian's metrics snapshots of the last days",
+				UsageText: "stader-cli api node history days interval",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					days, err := cliutils.ValidatePositiveUint("days", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					interval, err := cliutils.ValidateUint("interval", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getNodeHistory(c, days, interval))
+					return nil
+
+				},
+			},
+			{
+				Name:      "events",
+				Usage:     "Get the indexed contract events of the node between two blocks",
+				UsageText: "stader-cli api node events from-block to-block",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					fromBlock, err := cliutils.ValidateUint("from-block", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					toBlock, err := cliutils.ValidateUint("to-block", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getNodeEvents(c, fromBlock, toBlock))
+					return nil
+
+				},
+			},
+			{
+				Name:      "report",
+				Usage:     "Get the node's realized income, expenses and transfers between two unix times",
+				UsageText: "stader-cli api node report from-time to-time",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					fromTime, err := cliutils.ValidateUint("from-time", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					toTime, err := cliutils.ValidateUint("to-time", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getNodeReport(c, int64(fromTime), int64(toTime)))
+					return nil
+
+				},
+			},
+			{
+				Name:      "generate-sp-tree",
+				Usage:     "Rebuild an approximation of the socializing pool rewards tree of a cycle from chain data",
+				UsageText: "stader-cli api node generate-sp-tree cycle",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					cycle, err := cliutils.ValidatePositiveUint("cycle", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(generateSpTree(c, int64(cycle)))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-claim-sp-rewards",
 				Usage:     "Can we claim the SP rewards",
@@ -806,6 +988,43 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					return nil
 				},
 			},
+			{
+				Name:      "get-confirm-reward-address-tx",
+				Usage:     "Get the unsigned transaction the proposed reward address sends to confirm the reward address change",
+				UsageText: "stader-cli api node get-confirm-reward-address-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getConfirmRewardAddressTx(c))
+					return nil
+				},
+			},
+			{
+				Name:      "get-reward-address-change-status",
+				Usage:     "Check whether the reward address change has been confirmed since a block",
+				UsageText: "stader-cli api node get-reward-address-change-status from-block",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					fromBlock, err := cliutils.ValidateUint("from-block", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getRewardAddressChangeStatus(c, fromBlock))
+					return nil
+				},
+			},
 
 			{
 				Name:      "get-sd-status",
@@ -827,6 +1046,23 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					return nil
 				},
 			},
+			{
+				Name:      "sd-risk",
+				Usage:     "Get the inputs of the node's SD Utility Pool health factor",
+				UsageText: "stader-cli api node sd-risk",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSdRisk(c))
+					return nil
+
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,164 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/urfave/cli"
+)
+
+// Build the confirmRewardAddressChange transaction the proposed reward address has to sign,
+// so reward addresses on hardware wallets or multisigs don't need a web dapp to finish the change
+func getConfirmRewardAddressTx(c *cli.Context) (*api.ConfirmRewardAddressTxResponse, error) {
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.ConfirmRewardAddressTxResponse{
+		OperatorAddress: nodeAccount.Address,
+	}
+
+	currentBlock, err := ec.BlockNumber(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	response.CurrentBlock = currentBlock
+	opts := &bind.CallOpts{BlockNumber: big.NewInt(int64(currentBlock))}
+
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, opts)
+	if err != nil {
+		return nil, err
+	}
+	operatorInfo, err := node.GetOperatorInfo(pnr, operatorId, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.RewardAddress = operatorInfo.OperatorRewardAddress
+
+	proposedRewardAddress, err := node.GetProposedRewardAddress(pnr, operatorId, opts)
+	if err != nil {
+		return nil, err
+	}
+	if eth1.IsZeroAddress(proposedRewardAddress) {
+		response.NoPendingChange = true
+		return &response, nil
+	}
+	response.ProposedRewardAddress = proposedRewardAddress
+
+	data, err := node.GetConfirmRewardAddressChangeData(pnr, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+	gasInfo, err := node.EstimateConfirmRewardAddressChange(pnr, nodeAccount.Address, &bind.TransactOpts{From: proposedRewardAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	registryAddress := *pnr.PermissionlessNodeRegistryContract.Address
+	chainId := new(big.Int).SetUint64(uint64(cfg.StaderNode.GetChainID()))
+	tx, err := eth1.NewUnsignedDynamicFeeTx(ec, chainId, proposedRewardAddress, registryAddress, big.NewInt(0), data, gasInfo.SafeGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	rawTx, err := eth1.EncodeUnsignedDynamicFeeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Transaction = api.UnsignedTransaction{
+		Type:                 hexutil.Uint64(types.DynamicFeeTxType),
+		ChainId:              (*hexutil.Big)(tx.ChainID),
+		From:                 proposedRewardAddress,
+		To:                   registryAddress,
+		Nonce:                hexutil.Uint64(tx.Nonce),
+		Gas:                  hexutil.Uint64(tx.Gas),
+		MaxFeePerGas:         (*hexutil.Big)(tx.GasFeeCap),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tx.GasTipCap),
+		Value:                (*hexutil.Big)(tx.Value),
+		Data:                 tx.Data,
+	}
+	response.RawTransaction = rawTx
+	response.Eip681Uri = fmt.Sprintf("ethereum:%s@%s/confirmRewardAddressChange?address=%s&gasLimit=%d", registryAddress.Hex(), chainId.String(), nodeAccount.Address.Hex(), tx.Gas)
+
+	return &response, nil
+}
+
+// Check whether the reward address change of the node has been confirmed since the given block
+func getRewardAddressChangeStatus(c *cli.Context, fromBlock uint64) (*api.RewardAddressChangeStatusResponse, error) {
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.RewardAddressChangeStatusResponse{}
+
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	operatorInfo, err := node.GetOperatorInfo(pnr, operatorId, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.RewardAddress = operatorInfo.OperatorRewardAddress
+
+	proposedRewardAddress, err := node.GetProposedRewardAddress(pnr, operatorId, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.ProposedRewardAddress = proposedRewardAddress
+
+	events, err := node.GetOperatorRewardAddressUpdatedEvents(pnr, nodeAccount.Address, fromBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > 0 {
+		latest := events[len(events)-1]
+		response.Confirmed = true
+		response.ConfirmedBlock = latest.Raw.BlockNumber
+		response.ConfirmedTxHash = latest.Raw.TxHash
+	}
+
+	return &response, nil
+}
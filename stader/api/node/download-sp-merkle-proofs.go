@@ -2,11 +2,13 @@ package node
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/mitchellh/go-homedir"
 	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/stader"
 	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
@@ -78,6 +80,11 @@ func downloadSpMerkleProofs(c *cli.Context) (*api.DownloadSpMerkleProofsResponse
 		return nil, err
 	}
 
+	sp, err := services.GetSocializingPoolContract(c)
+	if err != nil {
+		return nil, err
+	}
+
 	response := api.DownloadSpMerkleProofsResponse{}
 
 	allMerkleProofs, err := stader.GetAllMerkleProofsForOperator(c, nodeAccount.Address)
@@ -86,6 +93,7 @@ func downloadSpMerkleProofs(c *cli.Context) (*api.DownloadSpMerkleProofsResponse
 	}
 
 	downloadedCycles := []int64{}
+	quarantinedCycles := []int64{}
 
 	merkleFolder := cfg.StaderNode.GetSpRewardsMerkleProofFolder(true)
 	if _, err := os.Stat(merkleFolder); os.IsNotExist(err) {
@@ -102,12 +110,36 @@ func downloadSpMerkleProofs(c *cli.Context) (*api.DownloadSpMerkleProofsResponse
 			return nil, fmt.Errorf("can not expand %v: %w", cycleMerkleProofFile, err)
 		}
 
-		// proof has already been downloaded
-		_, err = os.Stat(cycleMerkleProofFile)
-		if !os.IsNotExist(err) && err != nil {
-			return nil, fmt.Errorf("stat %v: %w", cycleMerkleProofFile, err)
+		// proof has already been downloaded, only replace it if it fails verification
+		existingMerkleProof, exists, err := cfg.StaderNode.ReadCycleCache(cycleMerkleProof.Cycle)
+		if errors.Is(err, config.ErrCorruptCycleCache) {
+			if _, err := stader.QuarantineCorruptCycleMerkleProofs(cfg, cycleMerkleProof.Cycle); err != nil {
+				return nil, err
+			}
+			exists = false
+		} else if err != nil {
+			return nil, fmt.Errorf("read %v: %w", cycleMerkleProofFile, err)
 		}
-		if !os.IsNotExist(err) {
+		if exists {
+			err = stader.VerifyCycleMerkleProofs(sp, nodeAccount.Address, &existingMerkleProof)
+			if err == nil || errors.Is(err, stader.ErrMerkleRootNotReported) {
+				continue
+			}
+			if _, err := stader.QuarantineCycleMerkleProofs(cfg, &existingMerkleProof); err != nil {
+				return nil, err
+			}
+		}
+
+		// never persist a proof which doesn't match the on chain root
+		err = stader.VerifyCycleMerkleProofs(sp, nodeAccount.Address, cycleMerkleProof)
+		if errors.Is(err, stader.ErrMerkleRootNotReported) {
+			continue
+		}
+		if err != nil {
+			if _, err := stader.QuarantineCycleMerkleProofs(cfg, cycleMerkleProof); err != nil {
+				return nil, err
+			}
+			quarantinedCycles = append(quarantinedCycles, cycleMerkleProof.Cycle)
 			continue
 		}
 
@@ -118,6 +150,7 @@ func downloadSpMerkleProofs(c *cli.Context) (*api.DownloadSpMerkleProofsResponse
 
 		encoder := json.NewEncoder(file)
 		err = encoder.Encode(cycleMerkleProof)
+		file.Close()
 		if err != nil {
 			return nil, fmt.Errorf("error encoding JSON: %v", err)
 		}
@@ -126,6 +159,7 @@ func downloadSpMerkleProofs(c *cli.Context) (*api.DownloadSpMerkleProofsResponse
 	}
 
 	response.DownloadedCycles = downloadedCycles
+	response.QuarantinedCycles = quarantinedCycles
 
 	return &response, nil
 }
@@ -0,0 +1,63 @@
+package node
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/indexer"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/urfave/cli"
+)
+
+// The daemon holds the event database while it indexes, so opening it is retried for a while
+const (
+	eventsDbOpenAttempts = 30
+	eventsDbOpenDelay    = time.Second
+)
+
+// Open the event database for reading, waiting for the daemon to release it
+func openEventStore(c *cli.Context) (*indexer.Store, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		store, err := indexer.OpenStore(cfg.StaderNode.GetEventsDbPath(true), true)
+		if err == nil {
+			return store, nil
+		}
+		if errors.Is(err, indexer.ErrStoreNotFound) || attempt == eventsDbOpenAttempts {
+			return nil, err
+		}
+		time.Sleep(eventsDbOpenDelay)
+	}
+}
+
+// Get the indexed events of the node between two blocks; a to-block of 0 means the last indexed block
+func getNodeEvents(c *cli.Context, fromBlock uint64, toBlock uint64) (*api.NodeEventsResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	store, err := openEventStore(c)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	response := api.NodeEventsResponse{}
+	response.IndexedBlock, err = store.GetIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	if toBlock == 0 || toBlock > response.IndexedBlock {
+		toBlock = response.IndexedBlock
+	}
+	response.Events, err = store.GetEvents(fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,91 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+	"github.com/mitchellh/go-homedir"
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/rewards"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/urfave/cli"
+)
+
+func generateSpTree(c *cli.Context, cycle int64) (*api.GenerateSpTreeResponse, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetArchiveEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.GenerateSpTreeResponse{}
+
+	archiveEcUrl, _ := cfg.StaderNode.ArchiveECUrl.Value.(string)
+	response.UsedArchiveEc = archiveEcUrl != ""
+
+	// progress is logged to stderr so it doesn't mix with the API response
+	logger := log.NewColorLogger(color.FgHiBlue)
+	generator, err := rewards.NewSpTreeGenerator(c, ec, &logger)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := generator.GenerateTree(cycle)
+	if err != nil {
+		return nil, err
+	}
+
+	treeFile, err := homedir.Expand(cfg.StaderNode.GetGeneratedSpRewardsTreePath(cycle, true))
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(treeFile), 0755); err != nil {
+		return nil, fmt.Errorf("could not create folder for generated trees: %w", err)
+	}
+	treeBytes, err := json.MarshalIndent(tree, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error encoding JSON: %w", err)
+	}
+	if err := os.WriteFile(treeFile, treeBytes, 0644); err != nil {
+		return nil, fmt.Errorf("could not write generated tree to %s: %w", treeFile, err)
+	}
+
+	response.Cycle = tree.Cycle
+	response.StartBlock = tree.StartBlock
+	response.EndBlock = tree.EndBlock
+	response.Root = tree.Root
+	response.OnChainRoot = tree.OnChainRoot
+	response.RootMatches = tree.Root == tree.OnChainRoot
+	response.OperatorCount = len(tree.Proofs)
+	response.Approximation = tree.Approximation
+	response.TreeFilePath = cfg.StaderNode.GetGeneratedSpRewardsTreePath(cycle, false)
+
+	// compare against the proof downloaded from the backend, if this node has a wallet
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return &response, nil
+	}
+	if nodeProof, ok := tree.Proofs[nodeAccount.Address]; ok {
+		response.NodeRewards = &nodeProof
+	}
+	downloadedProof, exists, err := cfg.StaderNode.ReadCycleCache(cycle)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		response.DownloadedRewards = &downloadedProof
+	}
+
+	return &response, nil
+}
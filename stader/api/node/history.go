@@ -0,0 +1,45 @@
+package node
+
+import (
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/state"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/types/history"
+	"github.com/urfave/cli"
+)
+
+// Get the guardian's metrics snapshots of the last days, keeping the latest snapshot of every interval (in seconds)
+func getNodeHistory(c *cli.Context, days uint64, interval uint64) (*api.NodeHistoryResponse, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.NodeHistoryResponse{
+		RetentionDays: cfg.StaderNode.GetHistoryRetentionDays(),
+	}
+
+	since := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	snapshots, err := state.ReadSnapshots(cfg.StaderNode.GetHistoryFolder(true), since)
+	if err != nil {
+		return nil, err
+	}
+
+	if interval == 0 {
+		response.Snapshots = snapshots
+		return &response, nil
+	}
+
+	response.Snapshots = []history.MetricsSnapshot{}
+	for i, snapshot := range snapshots {
+		// snapshots are sorted, so the last one of a bucket is the one before the next bucket starts
+		bucket := snapshot.Timestamp / int64(interval)
+		if i == len(snapshots)-1 || snapshots[i+1].Timestamp/int64(interval) != bucket {
+			response.Snapshots = append(response.Snapshots, snapshot)
+		}
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,358 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/types/events"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	penalty_tracker "github.com/stader-labs/stader-node/stader-lib/penalty-tracker"
+	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Report entry kinds: transfers move funds that were already accounted for, like rewards credited to the collector or a returned bond
+const (
+	reportKindIncome   = "income"
+	reportKindExpense  = "expense"
+	reportKindTransfer = "transfer"
+)
+
+const (
+	reportAssetEth = "ETH"
+	reportAssetSd  = "SD"
+)
+
+// Turns the operator's indexed events into report entries, reading historical state from the archive EC
+type reportBuilder struct {
+	nodeAddress common.Address
+	pnr         *stader.PermissionlessNodeRegistryContractManager
+	sdc         *stader.SdCollateralContractManager
+	sdu         *stader.SDUtilityPoolContractManager
+	prn         *stader.PenaltyTrackerContractManager
+
+	// The penalty deducted by each settlement transaction
+	settlementPenalties map[common.Hash]*big.Int
+
+	// Transactions that report the interest they cleared themselves
+	clearedInterestTxs map[common.Hash]bool
+
+	entries []api.ReportEntry
+}
+
+// Get the operator's realized income, expenses and transfers between two unix times, from the indexed events
+func getNodeReport(c *cli.Context, fromTime int64, toTime int64) (*api.NodeReportResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetArchiveEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Bind the contracts to the archive EC, since every value is read at the block of its event
+	pnrAddress, err := services.GetPermissionlessNodeRegistryAddress(c)
+	if err != nil {
+		return nil, err
+	}
+	sdcAddress, err := services.GetSdCollateralAddress(c)
+	if err != nil {
+		return nil, err
+	}
+	sduAddress, err := services.GetSdUtilityAddress(c)
+	if err != nil {
+		return nil, err
+	}
+	prnAddress, err := services.GetPenaltyTrackerAddress(c)
+	if err != nil {
+		return nil, err
+	}
+	b := reportBuilder{
+		nodeAddress:         nodeAccount.Address,
+		settlementPenalties: map[common.Hash]*big.Int{},
+		clearedInterestTxs:  map[common.Hash]bool{},
+		entries:             []api.ReportEntry{},
+	}
+	if b.pnr, err = stader.NewPermissionlessNodeRegistry(ec, pnrAddress); err != nil {
+		return nil, err
+	}
+	if b.sdc, err = stader.NewSdCollateralContract(ec, sdcAddress); err != nil {
+		return nil, err
+	}
+	if b.sdu, err = stader.NewSDUtilityPool(ec, sduAddress); err != nil {
+		return nil, err
+	}
+	if b.prn, err = stader.NewPenaltyTracker(ec, prnAddress); err != nil {
+		return nil, err
+	}
+
+	response := api.NodeReportResponse{}
+	archiveEcUrl, _ := cfg.StaderNode.ArchiveECUrl.Value.(string)
+	response.UsedArchiveEc = archiveEcUrl != ""
+
+	// Read the events and release the database right away, valuing them can take a while
+	store, err := openEventStore(c)
+	if err != nil {
+		return nil, err
+	}
+	response.IndexedBlock, err = store.GetIndexedBlock()
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	indexedEvents, err := store.GetEvents(0, response.IndexedBlock)
+	store.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IndexedBlock == 0 {
+		response.Entries = b.entries
+		return &response, nil
+	}
+	header, err := ec.HeaderByNumber(context.Background(), new(big.Int).SetUint64(response.IndexedBlock))
+	if err != nil {
+		return nil, fmt.Errorf("error getting block %d: %w", response.IndexedBlock, err)
+	}
+	response.IndexedTime = int64(header.Time)
+
+	reportEvents := []events.Event{}
+	for _, event := range indexedEvents {
+		if event.Timestamp >= fromTime && event.Timestamp < toTime {
+			reportEvents = append(reportEvents, event)
+		}
+	}
+
+	// Settlements and interest clearings are looked up first, the entries of their transaction depend on them
+	for _, event := range reportEvents {
+		switch event.Name {
+		case "ValidatorMarkedAsSettled":
+			penalty, err := b.getSettlementPenalty(event)
+			if err != nil {
+				return nil, err
+			}
+			b.settlementPenalties[event.TxHash] = penalty
+		case "ClearedUtilizerInterest":
+			b.clearedInterestTxs[event.TxHash] = true
+		}
+	}
+
+	for _, event := range reportEvents {
+		if err := b.addEntries(event); err != nil {
+			return nil, err
+		}
+	}
+
+	response.Entries = b.entries
+	return &response, nil
+}
+
+// Add the entries of an event, if it moved funds of the operator
+func (b *reportBuilder) addEntries(event events.Event) error {
+	switch event.Contract + "." + event.Name {
+	case "ValidatorWithdrawVault.DistributedRewards":
+		return b.addEthEntry(event, "operatorShare", "cl-rewards", reportKindIncome, "Consensus layer rewards distributed from the withdraw vault "+event.Address.Hex())
+
+	case "ValidatorWithdrawVault.SettledFunds":
+		return b.addSettlementEntries(event)
+
+	case "NodeElRewardVault.Withdrawal":
+		return b.addEthEntry(event, "operatorAmount", "el-rewards", reportKindIncome, "Execution layer rewards withdrawn from the node's fee recipient vault")
+
+	case "SocializingPool.OperatorRewardsClaimed":
+		if err := b.addEthEntry(event, "ethRewards", "sp-rewards", reportKindIncome, "Socializing pool ETH rewards claimed"); err != nil {
+			return err
+		}
+		return b.addSdEntry(event, "sdRewards", "sp-rewards", reportKindIncome, "Socializing pool SD rewards claimed")
+
+	case "OperatorRewardsCollector.Claimed":
+		return b.addEthEntry(event, "amount", "collector-withdrawal", reportKindTransfer, "Rewards already credited to the operator withdrawn from the rewards collector")
+
+	case "SDUtilityPool.ClearedUtilizerInterest":
+		return b.addSdEntry(event, "sdInterest", "sd-interest", reportKindExpense, "Interest on utilized SD cleared")
+
+	case "SDUtilityPool.Repaid":
+		if b.clearedInterestTxs[event.TxHash] {
+			return nil
+		}
+		return b.addRepaidInterestEntry(event)
+
+	case "SDUtilityPool.LiquidationCall":
+		return b.addEthEntry(event, "totalLiquidationAmountInEth", "liquidation", reportKindExpense, "SD collateral liquidated to cover the utilized SD, valued in ETH by the pool")
+
+	case "SdCollateral.SDSlashed":
+		return b.addSdEntry(event, "sdSlashed", "penalty", reportKindExpense, "SD collateral slashed")
+
+	case "SdCollateral.UtilizedSDSlashed":
+		return b.addSdEntry(event, "sdSlashFromUtilized", "penalty", reportKindExpense, "Utilized SD slashed")
+	}
+	return nil
+}
+
+// Split a settlement into the returned bond, the rewards before penalties and the penalties.
+// The vault reports the operator's share net of both penalties and the bond.
+func (b *reportBuilder) addSettlementEntries(event events.Event) error {
+	operatorShare, err := getEventAmount(event, "operatorShare")
+	if err != nil {
+		return err
+	}
+	collateral, err := node.GetCollateralEth(b.pnr, atBlock(event.BlockNumber))
+	if err != nil {
+		return fmt.Errorf("error getting the validator bond at block %d: %w", event.BlockNumber, err)
+	}
+	penalty, exists := b.settlementPenalties[event.TxHash]
+	if !exists {
+		penalty = big.NewInt(0)
+	}
+
+	grossShare := new(big.Int).Add(operatorShare, penalty)
+	bondReturned := collateral
+	if grossShare.Cmp(collateral) < 0 {
+		bondReturned = grossShare
+	}
+	note := "Validator settled by the withdraw vault " + event.Address.Hex()
+	b.addEntry(event, "bond-return", reportKindTransfer, reportAssetEth, bondReturned, bondReturned, note)
+
+	if rewards := new(big.Int).Sub(grossShare, collateral); rewards.Sign() > 0 {
+		b.addEntry(event, "cl-rewards", reportKindIncome, reportAssetEth, rewards, rewards, note)
+	} else if rewards.Sign() < 0 {
+		loss := rewards.Neg(rewards)
+		b.addEntry(event, "bond-loss", reportKindExpense, reportAssetEth, loss, loss, note+"; the validator's balance didn't cover its bond")
+	}
+
+	if penalty.Sign() > 0 {
+		b.addEntry(event, "penalty", reportKindExpense, reportAssetEth, penalty, penalty, note+"; penalties deducted from the operator's share")
+	}
+	return nil
+}
+
+// Add the part of a repayment that paid interest. The pool charges interest first,
+// so it's the interest accrued up to the block before, capped at the amount repaid.
+func (b *reportBuilder) addRepaidInterestEntry(event events.Event) error {
+	repaid, err := getEventAmount(event, "repayAmount")
+	if err != nil {
+		return err
+	}
+	opts := atBlock(event.BlockNumber - 1)
+	balance, err := sdutility.GetUtilizerLatestBalance(b.sdu, b.nodeAddress, opts)
+	if err != nil {
+		return fmt.Errorf("error getting the utilized SD balance at block %d: %w", event.BlockNumber-1, err)
+	}
+	principal, err := sdutility.GetUtilizerPrincipal(b.sdu, b.nodeAddress, opts)
+	if err != nil {
+		return fmt.Errorf("error getting the utilized SD principal at block %d: %w", event.BlockNumber-1, err)
+	}
+
+	interest := new(big.Int).Sub(balance, principal)
+	if interest.Cmp(repaid) > 0 {
+		interest = repaid
+	}
+	if interest.Sign() <= 0 {
+		return nil
+	}
+	ethValue, err := b.sdToEth(interest, event.BlockNumber)
+	if err != nil {
+		return err
+	}
+	b.addEntry(event, "sd-interest", reportKindExpense, reportAssetSd, interest, ethValue, "Interest paid by a utilized SD repayment")
+	return nil
+}
+
+func (b *reportBuilder) addEthEntry(event events.Event, arg string, category string, kind string, note string) error {
+	amount, err := getEventAmount(event, arg)
+	if err != nil {
+		return err
+	}
+	if amount.Sign() == 0 {
+		return nil
+	}
+	b.addEntry(event, category, kind, reportAssetEth, amount, amount, note)
+	return nil
+}
+
+func (b *reportBuilder) addSdEntry(event events.Event, arg string, category string, kind string, note string) error {
+	amount, err := getEventAmount(event, arg)
+	if err != nil {
+		return err
+	}
+	if amount.Sign() == 0 {
+		return nil
+	}
+	ethValue, err := b.sdToEth(amount, event.BlockNumber)
+	if err != nil {
+		return err
+	}
+	b.addEntry(event, category, kind, reportAssetSd, amount, ethValue, note)
+	return nil
+}
+
+func (b *reportBuilder) addEntry(event events.Event, category string, kind string, asset string, amount *big.Int, ethValue *big.Int, note string) {
+	b.entries = append(b.entries, api.ReportEntry{
+		BlockNumber: event.BlockNumber,
+		Timestamp:   event.Timestamp,
+		TxHash:      event.TxHash,
+		Category:    category,
+		Kind:        kind,
+		Asset:       asset,
+		Amount:      new(big.Int).Set(amount),
+		EthValue:    new(big.Int).Set(ethValue),
+		Note:        note,
+	})
+}
+
+// Value an SD amount with the SD/ETH conversion of the collateral contract at the given block
+func (b *reportBuilder) sdToEth(amount *big.Int, blockNumber uint64) (*big.Int, error) {
+	ethValue, err := sd_collateral.ConvertSdToEth(b.sdc, amount, atBlock(blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("error converting SD to ETH at block %d: %w", blockNumber, err)
+	}
+	return ethValue, nil
+}
+
+// The validator's penalty as it stood before it was settled
+func (b *reportBuilder) getSettlementPenalty(event events.Event) (*big.Int, error) {
+	pubkey, err := hexutil.Decode(event.Args["pubkey"])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding the pubkey of the settlement in tx %s: %w", event.TxHash.Hex(), err)
+	}
+	penalty, err := penalty_tracker.GetCumulativeValidatorPenalty(b.prn, types.BytesToValidatorPubkey(pubkey), atBlock(event.BlockNumber-1))
+	if err != nil {
+		return nil, fmt.Errorf("error getting the penalty of validator %s at block %d: %w", event.Args["pubkey"], event.BlockNumber-1, err)
+	}
+	return penalty, nil
+}
+
+func getEventAmount(event events.Event, arg string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(event.Args[arg], 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s value '%s' in the %s event of tx %s", arg, event.Args[arg], event.Name, event.TxHash.Hex())
+	}
+	return amount, nil
+}
+
+func atBlock(blockNumber uint64) *bind.CallOpts {
+	return &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+}
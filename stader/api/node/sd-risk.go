@@ -0,0 +1,62 @@
+package node
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+)
+
+// Get the inputs of the node's health factor, for the CLI to project
+func getSdRisk(c *cli.Context) (*api.SdRiskResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	sdc, err := services.GetSdCollateralContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdt, err := services.GetSdTokenContract(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.SdRiskResponse{}
+	response.Position, err = sdutility.GetRiskPosition(sdu, sdc, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	userData, err := sdutility.GetUserData(sdu, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.HealthFactor = userData.HealthFactor
+	response.ConservativeEthPerKey, err = sdutility.GetConservativeEthPerKey(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.SdBalance, err = sdt.Erc20Token.BalanceOf(nil, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+	response.AlreadyLiquidated, err = sdutility.AlreadyLiquidated(sdu, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,48 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	hexutils "github.com/stader-labs/stader-node/shared/utils/hex"
+)
+
+func sendSignedTx(c *cli.Context, serializedTx string) (*api.NodeSendSignedTxResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeSendSignedTxResponse{}
+
+	serializedTx = hexutils.RemovePrefix(serializedTx)
+	bytes, err := hex.DecodeString(serializedTx)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing TX bytes [%s]: %w", serializedTx, err)
+	}
+	tx := types.Transaction{}
+	if err := tx.UnmarshalBinary(bytes); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling TX: %w", err)
+	}
+
+	if err := ec.SendTransaction(context.Background(), &tx); err != nil {
+		return nil, fmt.Errorf("Error sending TX %s: %w", tx.Hash().Hex(), err)
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
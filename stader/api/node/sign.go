@@ -34,7 +34,8 @@ import (
 func sign(c *cli.Context, serializedTx string) (*api.NodeSignResponse, error) {
 
 	// Get services
-	if err := services.RequireNodeRegistered(c); err != nil {
+	// Only the wallet is needed, so transactions can be signed on a machine without a connection to the chain
+	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
 	w, err := services.GetWallet(c)
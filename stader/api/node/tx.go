@@ -0,0 +1,246 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/types/transactions"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+)
+
+// Gas used by a plain ETH transfer, which is what a cancellation sends
+const cancelGasLimit = 21000
+
+func getTxList(c *cli.Context) (*api.TxListResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	journal, err := services.GetTxJournal(c)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := journal.Reconcile(ec)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.TxListResponse{
+		Transactions: []transactions.Transaction{},
+	}
+	for _, entry := range entries {
+		if entry.From == nodeAccount.Address {
+			response.Transactions = append(response.Transactions, entry)
+		}
+	}
+	response.LatestNonce, err = ec.NonceAt(context.Background(), nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.PendingNonce, err = ec.PendingNonceAt(context.Background(), nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func canReplaceTx(c *cli.Context, hash common.Hash, cancel bool) (*api.CanReplaceTxResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+
+	return getReplacementPlan(c, hash, cancel)
+}
+
+func replaceTx(c *cli.Context, hash common.Hash, cancel bool) (*api.ReplaceTxResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := getReplacementPlan(c, hash, cancel)
+	if err != nil {
+		return nil, err
+	}
+	if plan.NotFound {
+		return nil, fmt.Errorf("transaction %s was not sent by the node wallet", hash.Hex())
+	}
+	if plan.NotPending {
+		return nil, fmt.Errorf("transaction %s is no longer pending, its status is %s", hash.Hex(), plan.Transaction.Status)
+	}
+	if plan.FeesTooLow {
+		return nil, fmt.Errorf("the requested fees are too low to replace transaction %s", hash.Hex())
+	}
+
+	original := plan.Transaction
+	txData := &types.DynamicFeeTx{
+		ChainID:   w.GetChainID(),
+		Nonce:     original.Nonce,
+		GasTipCap: plan.MaxPriorityFee,
+		GasFeeCap: plan.MaxFee,
+		Gas:       plan.GasLimit,
+		To:        original.To,
+		Value:     original.Value,
+		Data:      original.Data,
+	}
+	if cancel {
+		txData.To = &original.From
+		txData.Value = big.NewInt(0)
+		txData.Data = nil
+	}
+
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := opts.Signer(opts.From, types.NewTx(txData))
+	if err != nil {
+		return nil, fmt.Errorf("could not sign the replacement transaction: %w", err)
+	}
+	if err := ec.SendTransaction(context.Background(), signedTx); err != nil {
+		return nil, fmt.Errorf("could not send the replacement transaction: %w", err)
+	}
+
+	return &api.ReplaceTxResponse{
+		TxHash:         signedTx.Hash(),
+		MaxFee:         plan.MaxFee,
+		MaxPriorityFee: plan.MaxPriorityFee,
+	}, nil
+}
+
+// Work out the fees of a replacement for a pending transaction. Nodes only accept a replacement whose max fee and
+// priority fee are both at least 10% above those of every transaction already pending with its nonce, so both are
+// bumped by 12.5%. The result is also raised to the current network fees, and the fees requested with the gas flags
+// are used instead when they are high enough.
+func getReplacementPlan(c *cli.Context, hash common.Hash, cancel bool) (*api.CanReplaceTxResponse, error) {
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	journal, err := services.GetTxJournal(c)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.CanReplaceTxResponse{}
+
+	entries, err := journal.Reconcile(ec)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, entry := range entries {
+		if entry.Hash == hash && entry.From == nodeAccount.Address {
+			response.Transaction = entry
+			found = true
+		}
+	}
+	if !found {
+		response.NotFound = true
+		return &response, nil
+	}
+	if !response.Transaction.IsPending() {
+		response.NotPending = true
+		return &response, nil
+	}
+
+	// Bump the highest fees of the transactions pending with this nonce
+	previousMaxFee := big.NewInt(0)
+	previousPriorityFee := big.NewInt(0)
+	for _, entry := range entries {
+		if entry.From != nodeAccount.Address || entry.Nonce != response.Transaction.Nonce || !entry.IsPending() {
+			continue
+		}
+		if entry.MaxFee.Cmp(previousMaxFee) > 0 {
+			previousMaxFee = entry.MaxFee
+		}
+		if entry.MaxPriorityFee.Cmp(previousPriorityFee) > 0 {
+			previousPriorityFee = entry.MaxPriorityFee
+		}
+	}
+	response.MinMaxFee = bumpFee(previousMaxFee)
+	response.MinMaxPriorityFee = bumpFee(previousPriorityFee)
+
+	// Use the requested fees if there are any, otherwise keep up with the network
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	if opts.GasTipCap != nil && opts.GasTipCap.Sign() > 0 {
+		response.MaxPriorityFee = opts.GasTipCap
+	} else {
+		suggestedPriorityFee, err := ec.SuggestGasTipCap(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		response.MaxPriorityFee = maxBigInt(response.MinMaxPriorityFee, suggestedPriorityFee)
+	}
+	if opts.GasFeeCap != nil && opts.GasFeeCap.Sign() > 0 {
+		response.MaxFee = opts.GasFeeCap
+	} else {
+		baseFee, err := eth1.GetBaseFee(ec)
+		if err != nil {
+			return nil, err
+		}
+		response.MaxFee = maxBigInt(response.MinMaxFee, new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), response.MaxPriorityFee))
+	}
+	if response.MaxFee.Cmp(response.MinMaxFee) < 0 || response.MaxPriorityFee.Cmp(response.MinMaxPriorityFee) < 0 || response.MaxPriorityFee.Cmp(response.MaxFee) > 0 {
+		response.FeesTooLow = true
+	}
+
+	response.GasLimit = response.Transaction.GasLimit
+	if cancel {
+		response.GasLimit = cancelGasLimit
+	}
+
+	return &response, nil
+}
+
+// Raise a fee by 12.5%, rounded up
+func bumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(9))
+	bumped.Add(bumped, big.NewInt(7))
+	return bumped.Div(bumped, big.NewInt(8))
+}
+
+func maxBigInt(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
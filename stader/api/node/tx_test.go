@@ -0,0 +1,40 @@
+package node
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBumpFee(t *testing.T) {
+	tests := []struct {
+		name     string
+		fee      *big.Int
+		expected *big.Int
+	}{
+		{"exact", big.NewInt(8), big.NewInt(9)},
+		{"rounded up", big.NewInt(10), big.NewInt(12)},
+		{"one wei", big.NewInt(1), big.NewInt(2)},
+		{"zero", big.NewInt(0), big.NewInt(0)},
+		{"gwei", big.NewInt(20e9), big.NewInt(22.5e9)},
+		{"odd wei", big.NewInt(20e9 + 1), big.NewInt(22.5e9 + 2)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fee := new(big.Int).Set(test.fee)
+			bumped := bumpFee(fee)
+			if bumped.Cmp(test.expected) != 0 {
+				t.Errorf("expected %s, got %s", test.expected, bumped)
+			}
+			if fee.Cmp(test.fee) != 0 {
+				t.Error("the original fee should not be changed")
+			}
+
+			// Nodes only accept a replacement that pays at least 10% more
+			minimum := new(big.Int).Mul(test.fee, big.NewInt(110))
+			if new(big.Int).Mul(bumped, big.NewInt(100)).Cmp(minimum) < 0 {
+				t.Errorf("a bump to %s is less than 10%% above %s", bumped, test.fee)
+			}
+		})
+	}
+}
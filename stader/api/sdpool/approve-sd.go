@@ -0,0 +1,121 @@
+package sdpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+)
+
+// Delegators don't need to be registered operators, so these only require the node wallet
+
+func getSdAllowance(c *cli.Context) (*api.SdAllowanceResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdt, err := services.GetSdTokenContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sduAddress, err := services.GetSdUtilityAddress(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdAllowanceResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.Allowance, err = tokens.Allowance(sdt, nodeAccount.Address, sduAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func getSdApprovalGas(c *cli.Context, amountWei *big.Int) (*api.SdApproveGasResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdt, err := services.GetSdTokenContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sduAddress, err := services.GetSdUtilityAddress(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdApproveGasResponse{}
+
+	// Get gas estimates
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	gasInfo, err := tokens.EstimateApproveGas(sdt, sduAddress, amountWei, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.GasInfo = gasInfo
+
+	return &response, nil
+}
+
+func approveSd(c *cli.Context, amountWei *big.Int) (*api.SdApproveResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdt, err := services.GetSdTokenContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sduAddress, err := services.GetSdUtilityAddress(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdApproveResponse{}
+
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	response.ApproveTxHash, err = tokens.Approve(sdt, sduAddress, amountWei, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
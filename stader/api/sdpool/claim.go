@@ -0,0 +1,214 @@
+package sdpool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+)
+
+func canFinalize(c *cli.Context) (*api.CanSdPoolFinalizeResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanSdPoolFinalizeResponse{}
+
+	response.Paused, err = sdutility.IsPaused(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests are finalized in order, so only the oldest open one needs checking
+	nextRequestIdToFinalize, err := sdutility.GetNextRequestIdToFinalize(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	nextRequestId, err := sdutility.GetNextRequestId(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	if nextRequestIdToFinalize.Cmp(nextRequestId) >= 0 {
+		response.NothingToFinalize = true
+	} else {
+		request, err := sdutility.GetDelegatorWithdrawRequest(sdu, nextRequestIdToFinalize, nil)
+		if err != nil {
+			return nil, err
+		}
+		minBlockDelay, err := sdutility.GetMinBlockDelayToFinalizeRequest(sdu, nil)
+		if err != nil {
+			return nil, err
+		}
+		currentBlock, err := ec.BlockNumber(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		finalizableBlock := new(big.Int).Add(request.RequestBlock, minBlockDelay)
+		response.NothingToFinalize = finalizableBlock.Cmp(new(big.Int).SetUint64(currentBlock)) > 0
+	}
+	if response.Paused || response.NothingToFinalize {
+		return &response, nil
+	}
+
+	// Get gas estimates
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	gasInfo, err := sdutility.EstimateFinalizeDelegatorWithdrawalRequest(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.GasInfo = gasInfo
+
+	return &response, nil
+}
+
+func finalize(c *cli.Context) (*api.SdPoolFinalizeResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdPoolFinalizeResponse{}
+
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	tx, err := sdutility.FinalizeDelegatorWithdrawalRequest(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.TxHash = tx.Hash()
+
+	return &response, nil
+}
+
+func canClaim(c *cli.Context, requestId *big.Int) (*api.CanSdPoolClaimResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanSdPoolClaimResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Paused, err = sdutility.IsPaused(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claimed requests are deleted
+	request, err := sdutility.GetDelegatorWithdrawRequest(sdu, requestId, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.RequestMissing = request.Owner == common.Address{}
+	response.NotOwner = !response.RequestMissing && request.Owner != nodeAccount.Address
+	nextRequestIdToFinalize, err := sdutility.GetNextRequestIdToFinalize(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.NotFinalized = requestId.Cmp(nextRequestIdToFinalize) >= 0
+	response.SdAmount = request.SdFinalized
+	if response.Paused || response.RequestMissing || response.NotOwner || response.NotFinalized {
+		return &response, nil
+	}
+
+	// Get gas estimates
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	gasInfo, err := sdutility.EstimateClaim(sdu, requestId, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.GasInfo = gasInfo
+
+	return &response, nil
+}
+
+func claim(c *cli.Context, requestId *big.Int) (*api.SdPoolClaimResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdPoolClaimResponse{}
+
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	tx, err := sdutility.Claim(sdu, requestId, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.TxHash = tx.Hash()
+
+	return &response, nil
+}
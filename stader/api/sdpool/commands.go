@@ -0,0 +1,256 @@
+package sdpool
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/utils/api"
+	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+)
+
+// Register subcommands
+func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
+	command.Subcommands = append(command.Subcommands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "SD Utility Pool delegator commands",
+		Subcommands: []cli.Command{
+
+			{
+				Name:      "status",
+				Usage:     "Get the SD Utility Pool delegation status of the node wallet",
+				UsageText: "stader-cli api sd-pool status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getStatus(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "sd-allowance",
+				Usage:     "Get the SD the SD Utility Pool may spend from the node wallet",
+				UsageText: "stader-cli api sd-pool sd-allowance",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSdAllowance(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "get-sd-approval-gas",
+				Usage:     "Estimate the gas cost of approving the SD Utility Pool to spend SD from the node wallet",
+				UsageText: "stader-cli api sd-pool get-sd-approval-gas amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidatePositiveWeiAmount("approve amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSdApprovalGas(c, amountWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "approve-sd",
+				Usage:     "Approve the SD Utility Pool to spend SD from the node wallet",
+				UsageText: "stader-cli api sd-pool approve-sd amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidatePositiveWeiAmount("approve amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(approveSd(c, amountWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-delegate",
+				Usage:     "Check whether the node wallet can delegate SD to the SD Utility Pool",
+				UsageText: "stader-cli api sd-pool can-delegate amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidatePositiveWeiAmount("delegate amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canDelegate(c, amountWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "delegate",
+				Usage:     "Delegate SD to the SD Utility Pool",
+				UsageText: "stader-cli api sd-pool delegate amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidatePositiveWeiAmount("delegate amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(delegate(c, amountWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-request-withdraw",
+				Usage:     "Check whether the node wallet can request a withdrawal of delegated SD (0 for the whole balance)",
+				UsageText: "stader-cli api sd-pool can-request-withdraw amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidateWeiAmount("withdraw amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canRequestWithdraw(c, amountWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "request-withdraw",
+				Usage:     "Request a withdrawal of delegated SD (0 for the whole balance)",
+				UsageText: "stader-cli api sd-pool request-withdraw amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					amountWei, err := cliutils.ValidateWeiAmount("withdraw amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(requestWithdraw(c, amountWei))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-finalize",
+				Usage:     "Check whether there are withdraw requests ready to be finalized",
+				UsageText: "stader-cli api sd-pool can-finalize",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canFinalize(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "finalize",
+				Usage:     "Finalize the withdraw requests that are ready",
+				UsageText: "stader-cli api sd-pool finalize",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(finalize(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-claim",
+				Usage:     "Check whether the node wallet can claim a finalized withdraw request",
+				UsageText: "stader-cli api sd-pool can-claim request-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					requestId, err := cliutils.ValidateBigInt("request-id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canClaim(c, requestId))
+					return nil
+
+				},
+			},
+			{
+				Name:      "claim",
+				Usage:     "Claim the SD of a finalized withdraw request",
+				UsageText: "stader-cli api sd-pool claim request-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					requestId, err := cliutils.ValidateBigInt("request-id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(claim(c, requestId))
+					return nil
+
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package sdpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+)
+
+func canDelegate(c *cli.Context, amountWei *big.Int) (*api.CanSdPoolDelegateResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdt, err := services.GetSdTokenContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanSdPoolDelegateResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Paused, err = sdutility.IsPaused(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	sdBalance, err := tokens.BalanceOf(sdt, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.InsufficientBalance = amountWei.Cmp(sdBalance) > 0
+	minDelegateAmount, err := sdutility.GetMinSDDelegateLimit(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.BelowMinimum = amountWei.Cmp(minDelegateAmount) < 0
+	if response.Paused || response.InsufficientBalance || response.BelowMinimum {
+		return &response, nil
+	}
+
+	// Get gas estimates
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	gasInfo, err := sdutility.EstimateDelegate(sdu, amountWei, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.GasInfo = gasInfo
+
+	return &response, nil
+}
+
+func delegate(c *cli.Context, amountWei *big.Int) (*api.SdPoolDelegateResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdPoolDelegateResponse{}
+
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	tx, err := sdutility.Delegate(sdu, amountWei, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.TxHash = tx.Hash()
+
+	return &response, nil
+}
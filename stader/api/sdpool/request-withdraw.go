@@ -0,0 +1,149 @@
+package sdpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+)
+
+// A zero amount requests the withdrawal of the whole delegated balance
+func canRequestWithdraw(c *cli.Context, amountWei *big.Int) (*api.CanSdPoolRequestWithdrawResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanSdPoolRequestWithdrawResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Paused, err = sdutility.IsPaused(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	delegatedSdBalance, err := sdutility.GetDelegatorLatestSDBalance(sdu, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	sdAmount := amountWei
+	if sdAmount.Sign() == 0 {
+		sdAmount = delegatedSdBalance
+	}
+	response.InsufficientBalance = delegatedSdBalance.Sign() == 0 || sdAmount.Cmp(delegatedSdBalance) > 0
+	minWithdrawAmount, err := sdutility.GetMinSDWithdrawLimit(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.BelowMinimum = sdAmount.Cmp(minWithdrawAmount) < 0
+	requestIds, err := sdutility.GetRequestIdsByDelegator(sdu, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	maxOpenRequests, err := sdutility.GetMaxNonRedeemedDelegatorRequestCount(sdu, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.TooManyRequests = big.NewInt(int64(len(requestIds))).Cmp(maxOpenRequests) >= 0
+	if response.Paused || response.InsufficientBalance || response.BelowMinimum || response.TooManyRequests {
+		return &response, nil
+	}
+
+	// Get gas estimates
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	var gasInfo stader.GasInfo
+	if amountWei.Sign() == 0 {
+		cTokenBalance, err := sdutility.GetDelegatorCTokenBalance(sdu, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+		gasInfo, err = sdutility.EstimateRequestWithdraw(sdu, cTokenBalance, opts)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		gasInfo, err = sdutility.EstimateRequestWithdrawWithSDAmount(sdu, amountWei, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response.GasInfo = gasInfo
+
+	return &response, nil
+}
+
+// A zero amount requests the withdrawal of the whole delegated balance
+func requestWithdraw(c *cli.Context, amountWei *big.Int) (*api.SdPoolRequestWithdrawResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdPoolRequestWithdrawResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Withdrawing everything goes through the cToken balance, so no dust is left behind by the exchange rate
+	if amountWei.Sign() == 0 {
+		cTokenBalance, err := sdutility.GetDelegatorCTokenBalance(sdu, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := sdutility.RequestWithdraw(sdu, cTokenBalance, opts)
+		if err != nil {
+			return nil, err
+		}
+		response.TxHash = tx.Hash()
+		return &response, nil
+	}
+
+	tx, err := sdutility.RequestWithdrawWithSDAmount(sdu, amountWei, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.TxHash = tx.Hash()
+
+	return &response, nil
+}
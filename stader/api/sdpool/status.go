@@ -0,0 +1,144 @@
+package sdpool
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+)
+
+func getStatus(c *cli.Context) (*api.SdPoolStatusResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	sdu, err := services.GetSdUtilityContract(c)
+	if err != nil {
+		return nil, err
+	}
+	sdt, err := services.GetSdTokenContract(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SdPoolStatusResponse{}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.AccountAddress = nodeAccount.Address
+
+	// Pin every read to the same block
+	currentBlock, err := ec.BlockNumber(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	response.CurrentBlock = currentBlock
+	opts := &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(currentBlock)}
+
+	response.SdBalance, err = tokens.BalanceOf(sdt, nodeAccount.Address, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.CTokenBalance, err = sdutility.GetDelegatorCTokenBalance(sdu, nodeAccount.Address, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.DelegatedSdBalance, err = sdutility.GetDelegatorLatestSDBalance(sdu, nodeAccount.Address, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.WithdrawRequestedCToken, err = sdutility.GetDelegatorWithdrawRequestedCTokenCount(sdu, nodeAccount.Address, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.ExchangeRate, err = sdutility.ExchangeRateCurrent(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.DelegationRate, err = sdutility.GetDelegationRatePercent(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.UtilizationRate, err = sdutility.GetUtilizationRatePercent(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.PoolAvailableSDBalance, err = sdutility.GetPoolAvailableSDBalance(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.TotalUtilizedSd, err = sdutility.GetTotalUtilized(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.MinDelegateAmount, err = sdutility.GetMinSDDelegateLimit(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.MinWithdrawAmount, err = sdutility.GetMinSDWithdrawLimit(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.MaxOpenRequests, err = sdutility.GetMaxNonRedeemedDelegatorRequestCount(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.Paused, err = sdutility.IsPaused(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The yield of the delegated SD over a year at the current delegation rate
+	yearlyYield := new(big.Float).Mul(new(big.Float).SetInt(response.DelegatedSdBalance), response.DelegationRate)
+	yearlyYield.Quo(yearlyYield, big.NewFloat(100))
+	response.EstimatedYearlyYield, _ = yearlyYield.Int(nil)
+
+	// Open withdraw requests
+	nextRequestIdToFinalize, err := sdutility.GetNextRequestIdToFinalize(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	minBlockDelay, err := sdutility.GetMinBlockDelayToFinalizeRequest(sdu, opts)
+	if err != nil {
+		return nil, err
+	}
+	requestIds, err := sdutility.GetRequestIdsByDelegator(sdu, nodeAccount.Address, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.WithdrawRequests = []api.SdPoolWithdrawRequest{}
+	for _, requestId := range requestIds {
+		request, err := sdutility.GetDelegatorWithdrawRequest(sdu, requestId, opts)
+		if err != nil {
+			return nil, err
+		}
+		response.WithdrawRequests = append(response.WithdrawRequests, api.SdPoolWithdrawRequest{
+			RequestId:        requestId,
+			AmountOfCToken:   request.AmountOfCToken,
+			SdExpected:       request.SdExpected,
+			SdFinalized:      request.SdFinalized,
+			RequestBlock:     request.RequestBlock,
+			FinalizableBlock: new(big.Int).Add(request.RequestBlock, minBlockDelay),
+			Finalized:        requestId.Cmp(nextRequestIdToFinalize) < 0,
+		})
+	}
+
+	return &response, nil
+}
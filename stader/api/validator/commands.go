@@ -24,6 +24,7 @@ import (
 
 	"github.com/stader-labs/stader-node/shared/utils/api"
 	cliutils "github.com/stader-labs/stader-node/shared/utils/cli"
+	"github.com/stader-labs/stader-node/stader-lib/types"
 )
 
 // Register subcommands
@@ -151,6 +152,93 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "export-exits",
+				Usage:     "Sign voluntary exit messages and save them for offline storage",
+				UsageText: "stader-cli api validator export-exits epoch validator-pub-keys password",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 3); err != nil {
+						return err
+					}
+					exitEpoch, err := cliutils.ValidateUint("epoch", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					var validatorPubKeys []types.ValidatorPubkey
+					if c.Args().Get(1) != "all" {
+						validatorPubKeys, err = cliutils.ValidatePubkeys("validator-pub-keys", c.Args().Get(1))
+						if err != nil {
+							return err
+						}
+					}
+
+					api.PrintResponse(exportExits(c, exitEpoch, validatorPubKeys, c.Args().Get(2)))
+					return nil
+
+				},
+			},
+			{
+				Name:      "presign-status",
+				Usage:     "Get the presign daemon's ledger of exit messages",
+				UsageText: "stader-cli api validator presign-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					api.PrintResponse(getPresignStatus(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-exit-validators",
+				Usage:     "Check which of several validators can exit",
+				UsageText: "stader-cli api validator can-exit-validators validator-pub-keys",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					var validatorPubKeys []types.ValidatorPubkey
+					if c.Args().Get(0) != "all" {
+						var err error
+						validatorPubKeys, err = cliutils.ValidatePubkeys("validator-pub-keys", c.Args().Get(0))
+						if err != nil {
+							return err
+						}
+					}
+
+					api.PrintResponse(canExitValidators(c, validatorPubKeys))
+					return nil
+
+				},
+			},
+			{
+				Name:      "exit-validators",
+				Usage:     "Exit several validators",
+				UsageText: "stader-cli api validator exit-validators validator-pub-keys",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					validatorPubKeys, err := cliutils.ValidatePubkeys("validator-pub-keys", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					api.PrintResponse(exitValidators(c, validatorPubKeys))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-send-cl-rewards",
 				Usage:     "Can send cl rewards of a validator to the operator claim vault",
@@ -183,6 +271,46 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "can-sweep-cl-rewards",
+				Usage:     "Check which withdraw vaults of the operator a CL rewards sweep would distribute",
+				UsageText: "stader-cli api validator can-sweep-cl-rewards min-amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minimum, err := cliutils.ValidateWeiAmount("min-amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					api.PrintResponse(canSweepClRewards(c, minimum))
+					return nil
+
+				},
+			},
+			{
+				Name:      "sweep-cl-rewards",
+				Usage:     "Send the cl rewards of every withdraw vault of the operator holding at least min-amount to the operator claim vault",
+				UsageText: "stader-cli api validator sweep-cl-rewards min-amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minimum, err := cliutils.ValidateWeiAmount("min-amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					api.PrintResponse(sweepClRewards(c, minimum))
+					return nil
+
+				},
+			},
 		},
 	})
 }
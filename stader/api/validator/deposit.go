@@ -16,6 +16,7 @@ import (
 	_ "golang.org/x/sync/errgroup"
 
 	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/signer"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/utils/eth1"
 	"github.com/stader-labs/stader-node/shared/utils/validator"
@@ -115,6 +116,9 @@ func canNodeDeposit(c *cli.Context, baseAmountWei, utilityAmountWei, numValidato
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
+	if err := services.RequireValidatorKeys(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -249,14 +253,18 @@ func canNodeDeposit(c *cli.Context, baseAmountWei, utilityAmountWei, numValidato
 			return nil, err
 		}
 
+		// The key isn't stored anywhere yet, so sign the deposits for the gas estimate locally
+		keySigner := signer.NewKeySigner(validatorKey)
+		validatorPubkey := stadertypes.BytesToValidatorPubkey(validatorKey.PublicKey().Marshal())
+
 		// Get validator deposit data for 1 eth
-		preDepositData, _, err := validator.GetDepositData(validatorKey, withdrawCredentials, eth2Config, 1000000000)
+		preDepositData, _, err := validator.GetDepositData(keySigner, validatorPubkey, withdrawCredentials, eth2Config, 1000000000)
 		if err != nil {
 			return nil, err
 		}
 		preDepositSignature := stadertypes.BytesToValidatorSignature(preDepositData.Signature)
 
-		depositData, _, err := validator.GetDepositData(validatorKey, withdrawCredentials, eth2Config, 31000000000)
+		depositData, _, err := validator.GetDepositData(keySigner, validatorPubkey, withdrawCredentials, eth2Config, 31000000000)
 		if err != nil {
 			return nil, err
 		}
@@ -297,6 +305,9 @@ func canNodeDeposit(c *cli.Context, baseAmountWei, utilityAmountWei, numValidato
 }
 
 func nodeDeposit(c *cli.Context, baseAmountWei, utilityAmountWei, numValidators *big.Int, reloadKeys bool) (*api.NodeDepositResponse, error) {
+	if err := services.RequireValidatorKeys(c); err != nil {
+		return nil, err
+	}
 
 	cfg, err := services.GetConfig(c)
 	if err != nil {
@@ -325,6 +336,10 @@ func nodeDeposit(c *cli.Context, baseAmountWei, utilityAmountWei, numValidators
 	if err != nil {
 		return nil, err
 	}
+	validatorSigner, err := services.GetValidatorSigner(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get eth2 config
 	eth2Config, err := bc.GetEth2Config()
@@ -389,8 +404,10 @@ func nodeDeposit(c *cli.Context, baseAmountWei, utilityAmountWei, numValidators
 			return nil, err
 		}
 
+		validatorPubkey := stadertypes.BytesToValidatorPubkey(validatorKey.PublicKey().Marshal())
+
 		// Get validator deposit data for 1 eth
-		preDepositData, _, err := validator.GetDepositData(validatorKey, withdrawCredentials, eth2Config, 1000000000)
+		preDepositData, _, err := validator.GetDepositData(validatorSigner, validatorPubkey, withdrawCredentials, eth2Config, 1000000000)
 		if err != nil {
 			return nil, err
 		}
@@ -398,7 +415,7 @@ func nodeDeposit(c *cli.Context, baseAmountWei, utilityAmountWei, numValidators
 
 		pubKey := stadertypes.BytesToValidatorPubkey(preDepositData.PublicKey)
 
-		depositData, _, err := validator.GetDepositData(validatorKey, withdrawCredentials, eth2Config, 31000000000)
+		depositData, _, err := validator.GetDepositData(validatorSigner, validatorPubkey, withdrawCredentials, eth2Config, 31000000000)
 		if err != nil {
 			return nil, err
 		}
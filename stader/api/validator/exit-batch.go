@@ -0,0 +1,228 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+	"github.com/urfave/cli"
+)
+
+// Check exit eligibility for several validators at once; no pubkeys means every non terminal validator of the operator
+func canExitValidators(c *cli.Context, validatorPubKeys []types.ValidatorPubkey) (*api.CanExitValidatorsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireValidatorSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+
+	response, err := getExitPlan(c, validatorPubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func exitValidators(c *cli.Context, validatorPubKeys []types.ValidatorPubkey) (*api.ExitValidatorsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireValidatorSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	validatorSigner, err := services.GetValidatorSigner(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	network, ok := cfg.StaderNode.Network.Value.(config.Network)
+	if !ok {
+		return nil, fmt.Errorf("invalid network configuration: %s", cfg.StaderNode.Network.Value)
+	}
+
+	// Response
+	response := api.ExitValidatorsResponse{
+		Exited:  []types.ValidatorPubkey{},
+		Skipped: map[string]string{},
+		Failed:  map[string]string{},
+	}
+
+	// the chain may have moved since the plan was shown, so check again before broadcasting
+	plan, err := getExitPlan(c, validatorPubKeys)
+	if err != nil {
+		return nil, err
+	}
+	response.ExitEpoch = plan.CurrentEpoch
+
+	// Get the fork voluntary exits are signed for
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	forkInfo, err := validator.GetExitForkInfo(eth2Config, network)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, validatorPlan := range plan.Validators {
+		if !validatorPlan.CanExit {
+			response.Skipped[validatorPlan.ValidatorPubKey.String()] = validatorPlan.Reason
+			continue
+		}
+
+		// Get signed voluntary exit message
+		signature, _, err := validator.GetSignedExitMessage(validatorSigner, validatorPlan.ValidatorPubKey, validatorPlan.ValidatorIndex, plan.CurrentEpoch, forkInfo)
+		if err != nil {
+			response.Failed[validatorPlan.ValidatorPubKey.String()] = err.Error()
+			continue
+		}
+
+		// Broadcast voluntary exit message
+		if err := bc.ExitValidator(validatorPlan.ValidatorIndex, plan.CurrentEpoch, signature); err != nil {
+			response.Failed[validatorPlan.ValidatorPubKey.String()] = err.Error()
+			continue
+		}
+		response.Exited = append(response.Exited, validatorPlan.ValidatorPubKey)
+	}
+
+	response.BeaconChainUrl = cfg.StaderNode.GetBeaconChainUrl()
+
+	// Return response
+	return &response, nil
+
+}
+
+func getExitPlan(c *cli.Context, validatorPubKeys []types.ValidatorPubkey) (*api.CanExitValidatorsResponse, error) {
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	registeredValidators, operatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatorPubKeys) == 0 {
+		for _, validatorPubKey := range operatorPubKeys {
+			if !stdr.IsValidatorTerminal(registeredValidators[validatorPubKey]) {
+				validatorPubKeys = append(validatorPubKeys, validatorPubKey)
+			}
+		}
+	}
+
+	beaconHead, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	validatorStatuses, err := bc.GetValidatorStatuses(validatorPubKeys, nil)
+	if err != nil {
+		return nil, err
+	}
+	validatorSigner, err := services.GetValidatorSigner(c)
+	if err != nil {
+		return nil, err
+	}
+	hasKeys, err := validatorSigner.HasKeys(validatorPubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.CanExitValidatorsResponse{
+		CurrentEpoch: beaconHead.Epoch,
+		Validators:   []api.ValidatorExitPlan{},
+	}
+	for _, validatorPubKey := range validatorPubKeys {
+		validatorPlan := api.ValidatorExitPlan{
+			ValidatorPubKey: validatorPubKey,
+		}
+
+		if _, ok := registeredValidators[validatorPubKey]; !ok {
+			validatorPlan.NotRegisteredWithOperator = true
+			validatorPlan.Reason = getExitPlanReason(validatorPlan)
+			response.Validators = append(response.Validators, validatorPlan)
+			continue
+		}
+		if !hasKeys[validatorPubKey] {
+			validatorPlan.ValidatorKeyMissing = true
+			validatorPlan.Reason = getExitPlanReason(validatorPlan)
+			response.Validators = append(response.Validators, validatorPlan)
+			continue
+		}
+
+		validatorStatus := validatorStatuses[validatorPubKey]
+		validatorPlan.ValidatorIndex = validatorStatus.Index
+		validatorPlan.ActivationEpoch = validatorStatus.ActivationEpoch
+
+		eligibility := getExitEligibility(validatorStatus, beaconHead.Epoch)
+		validatorPlan.ValidatorNotRegistered = eligibility.ValidatorNotRegistered
+		validatorPlan.ValidatorTooYoung = eligibility.ValidatorTooYoung
+		validatorPlan.ValidatorExiting = eligibility.ValidatorExiting
+		validatorPlan.ValidatorNotActive = eligibility.ValidatorNotActive
+		validatorPlan.CanExit = !(eligibility.ValidatorNotRegistered || eligibility.ValidatorTooYoung || eligibility.ValidatorExiting || eligibility.ValidatorNotActive)
+		validatorPlan.Reason = getExitPlanReason(validatorPlan)
+
+		response.Validators = append(response.Validators, validatorPlan)
+	}
+
+	return &response, nil
+}
+
+// Get why a validator can't exit, or an empty string if it can
+func getExitPlanReason(validatorPlan api.ValidatorExitPlan) string {
+	switch {
+	case validatorPlan.NotRegisteredWithOperator:
+		return "not registered with the operator"
+	case validatorPlan.ValidatorKeyMissing:
+		return "validator key not found in the wallet"
+	case validatorPlan.ValidatorNotRegistered:
+		return "not registered on the beacon chain"
+	case validatorPlan.ValidatorNotActive:
+		return "not active"
+	case validatorPlan.ValidatorExiting:
+		return "already exiting"
+	case validatorPlan.ValidatorTooYoung:
+		return "too young, validators need to be active for 256 epochs before they can exit"
+	}
+	return ""
+}
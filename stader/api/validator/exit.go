@@ -4,13 +4,13 @@ import (
 	"fmt"
 
 	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
 	"github.com/stader-labs/stader-node/shared/types/api"
 	"github.com/stader-labs/stader-node/shared/types/config"
 	"github.com/stader-labs/stader-node/shared/utils/eth2"
 	"github.com/stader-labs/stader-node/shared/utils/validator"
 	"github.com/stader-labs/stader-node/stader-lib/types"
 	"github.com/urfave/cli"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
 )
 
 func canExitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.CanExitValidatorResponse, error) {
@@ -19,6 +19,9 @@ func canExitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*a
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
+	if err := services.RequireValidatorSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -26,54 +29,65 @@ func canExitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*a
 	if err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
+	validatorSigner, err := services.GetValidatorSigner(c)
 	if err != nil {
 		return nil, err
 	}
-	// Response
-	response := api.CanExitValidatorResponse{}
-
 	// check if the validator is key is available to sign the exit message
-	_, err = w.GetValidatorKeyByPubkey(validatorPubKey)
+	hasKeys, err := validatorSigner.HasKeys([]types.ValidatorPubkey{validatorPubKey})
 	if err != nil {
 		return nil, err
 	}
+	if !hasKeys[validatorPubKey] {
+		return nil, fmt.Errorf("Validator %s key not found", validatorPubKey.Hex())
+	}
 
 	res, err := bc.GetValidatorStatus(validatorPubKey, nil)
 	if err != nil {
 		return nil, err
 	}
-	if !res.Exists {
+
+	beaconHead, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := getExitEligibility(res, beaconHead.Epoch)
+
+	return &response, nil
+}
+
+// Check whether a validator can exit; the Beacon chain only accepts exits once a validator has been active for 256 epochs
+func getExitEligibility(validatorStatus beacon.ValidatorStatus, currentEpoch uint64) api.CanExitValidatorResponse {
+	response := api.CanExitValidatorResponse{}
+
+	if !validatorStatus.Exists {
 		response.ValidatorNotRegistered = true
-		return &response, nil
+		return response
 	}
-	if !eth2.IsValidatorActive(res) {
+
+	if !eth2.IsValidatorActive(validatorStatus) {
 		response.ValidatorNotActive = true
-		return &response, nil
+		return response
 	}
 
-	if eth2.IsValidatorExiting(res) {
+	if eth2.IsValidatorExiting(validatorStatus) {
 		response.ValidatorExiting = true
-		return &response, nil
+		return response
 	}
 
-	beaconHead, err := bc.GetBeaconHead()
-	if err != nil {
-		return nil, err
-	}
-	currentEpoch := beaconHead.Epoch
-
-	if res.ActivationEpoch+256 > currentEpoch {
+	if validatorStatus.ActivationEpoch+256 > currentEpoch {
 		response.ValidatorTooYoung = true
-		return &response, nil
+		return response
 	}
 
-	return &response, nil
+	return response
 }
 
 func exitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.ExitValidatorResponse, error) {
 
-	w, err := services.GetWallet(c)
+	validatorSigner, err := services.GetValidatorSigner(c)
 	if err != nil {
 		return nil, err
 	}
@@ -100,25 +114,24 @@ func exitValidator(c *cli.Context, validatorPubKey types.ValidatorPubkey) (*api.
 		return nil, err
 	}
 
-	// Get voluntary exit signature domain
-	signatureDomain, err := bc.GetExitDomainData(eth2types.DomainVoluntaryExit[:], network)
+	// Get the fork voluntary exits are signed for
+	eth2Config, err := bc.GetEth2Config()
 	if err != nil {
 		return nil, err
 	}
-
-	// Get validator index
-	validatorIndex, err := bc.GetValidatorIndex(validatorPubKey)
+	forkInfo, err := validator.GetExitForkInfo(eth2Config, network)
 	if err != nil {
 		return nil, err
 	}
 
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubKey)
+	// Get validator index
+	validatorIndex, err := bc.GetValidatorIndex(validatorPubKey)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get signed voluntary exit message
-	signature, _, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, head.Epoch, signatureDomain)
+	signature, _, err := validator.GetSignedExitMessage(validatorSigner, validatorPubKey, validatorIndex, head.Epoch, forkInfo)
 	if err != nil {
 		return nil, err
 	}
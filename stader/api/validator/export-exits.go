@@ -0,0 +1,184 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/types/config"
+	"github.com/stader-labs/stader-node/shared/types/eth2"
+	hexutils "github.com/stader-labs/stader-node/shared/utils/hex"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+	"github.com/urfave/cli"
+)
+
+// Sign voluntary exits for the given keys (all non terminal validators of the operator if none are given)
+// and write them as beacon API JSON, either as plain files or as one password protected archive
+func exportExits(c *cli.Context, exitEpoch uint64, validatorPubKeys []types.ValidatorPubkey, password string) (*api.ExportExitsResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireValidatorSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	validatorSigner, err := services.GetValidatorSigner(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+
+	network, ok := cfg.StaderNode.Network.Value.(config.Network)
+	if !ok {
+		return nil, fmt.Errorf("invalid network configuration: %s", cfg.StaderNode.Network.Value)
+	}
+
+	// Response
+	response := api.ExportExitsResponse{
+		Exported: []api.ExportedExit{},
+		Skipped:  map[string]string{},
+	}
+
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	registeredValidators, operatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatorPubKeys) == 0 {
+		for _, validatorPubKey := range operatorPubKeys {
+			if !stdr.IsValidatorTerminal(registeredValidators[validatorPubKey]) {
+				validatorPubKeys = append(validatorPubKeys, validatorPubKey)
+			}
+		}
+	}
+
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	response.CurrentEpoch = head.Epoch
+	if exitEpoch == 0 {
+		exitEpoch = head.Epoch
+	}
+	response.ExitEpoch = exitEpoch
+
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	forkInfo, err := validator.GetExitForkInfo(eth2Config, network)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorStatuses, err := bc.GetValidatorStatuses(validatorPubKeys, nil)
+	if err != nil {
+		return nil, err
+	}
+	hasKeys, err := validatorSigner.HasKeys(validatorPubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	for _, validatorPubKey := range validatorPubKeys {
+		if _, ok := registeredValidators[validatorPubKey]; !ok {
+			response.Skipped[validatorPubKey.String()] = "not registered with the operator"
+			continue
+		}
+		validatorStatus, ok := validatorStatuses[validatorPubKey]
+		if !ok || !validatorStatus.Exists {
+			response.Skipped[validatorPubKey.String()] = "not found on the beacon chain yet"
+			continue
+		}
+		if !hasKeys[validatorPubKey] {
+			response.Skipped[validatorPubKey.String()] = "validator key not found in the wallet or remote signer"
+			continue
+		}
+
+		signature, _, err := validator.GetSignedExitMessage(validatorSigner, validatorPubKey, validatorStatus.Index, exitEpoch, forkInfo)
+		if err != nil {
+			return nil, fmt.Errorf("could not sign the exit message of validator %s: %w", validatorPubKey, err)
+		}
+		exitBytes, err := json.MarshalIndent(eth2.SignedVoluntaryExit{
+			Message: eth2.SignedVoluntaryExitMessage{
+				Epoch:          strconv.FormatUint(exitEpoch, 10),
+				ValidatorIndex: strconv.FormatUint(validatorStatus.Index, 10),
+			},
+			Signature: hexutils.AddPrefix(signature.Hex()),
+		}, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error encoding JSON: %w", err)
+		}
+
+		fileName := fmt.Sprintf("exit-%d-%s.json", validatorStatus.Index, validatorPubKey.String())
+		files[fileName] = exitBytes
+		response.Exported = append(response.Exported, api.ExportedExit{
+			ValidatorPubKey: validatorPubKey,
+			ValidatorIndex:  validatorStatus.Index,
+			FileName:        fileName,
+		})
+	}
+
+	if len(files) == 0 {
+		return &response, nil
+	}
+
+	timestamp := time.Now().Unix()
+	if password != "" {
+		response.Encrypted = true
+		response.OutputPath = cfg.StaderNode.GetExitArchivePath(timestamp, false)
+		if err := validator.WriteExitArchive(cfg.StaderNode.GetExitArchivePath(timestamp, true), files, password); err != nil {
+			return nil, err
+		}
+		return &response, nil
+	}
+
+	folderName := fmt.Sprintf("%s-%d", string(network), timestamp)
+	response.OutputPath = filepath.Join(cfg.StaderNode.GetExitMessagesFolder(false), folderName)
+	exitFolder := filepath.Join(cfg.StaderNode.GetExitMessagesFolder(true), folderName)
+	if err := os.MkdirAll(exitFolder, 0700); err != nil {
+		return nil, fmt.Errorf("could not create the exit messages folder: %w", err)
+	}
+	for fileName, exitBytes := range files {
+		if err := os.WriteFile(filepath.Join(exitFolder, fileName), exitBytes, 0600); err != nil {
+			return nil, fmt.Errorf("could not write exit message %s: %w", fileName, err)
+		}
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/presign"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/urfave/cli"
+)
+
+func getPresignStatus(c *cli.Context) (*api.PresignStatusResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.PresignStatusResponse{}
+	response.LedgerPath = cfg.StaderNode.GetPresignLedgerPath(false)
+
+	ledger, err := presign.LoadLedger(cfg.StaderNode.GetPresignLedgerPath(true))
+	if err != nil {
+		return nil, err
+	}
+
+	lastPass := ledger.LastPass()
+	response.LastPassStartedAt = lastPass.StartedAt
+	response.LastPassFinishedAt = lastPass.FinishedAt
+	response.LastPassKeysChecked = lastPass.KeysChecked
+	response.LastPassKeysSigned = lastPass.KeysSigned
+	response.LastPassKeysSent = lastPass.KeysSent
+	response.LastPassBackendError = lastPass.BackendError
+
+	response.Entries = []api.PresignLedgerEntry{}
+	for _, entry := range ledger.Entries() {
+		response.Entries = append(response.Entries, api.PresignLedgerEntry{
+			Pubkey:         entry.Pubkey,
+			ValidatorIndex: entry.ValidatorIndex,
+			ExitEpoch:      entry.ExitEpoch,
+			Status:         string(entry.Status),
+			Retries:        entry.Retries,
+			LastError:      entry.LastError,
+			SignedAt:       entry.SignedAt,
+			LastAttemptAt:  entry.LastAttemptAt,
+			AcknowledgedAt: entry.AcknowledgedAt,
+		})
+	}
+
+	// non terminal validators the daemon has never recorded
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	registeredValidators, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.UntrackedValidatorKeys = []string{}
+	for _, validatorPubKey := range validatorPubKeys {
+		if stdr.IsValidatorTerminal(registeredValidators[validatorPubKey]) {
+			continue
+		}
+		if _, ok := ledger.Get(validatorPubKey.String()); !ok {
+			response.UntrackedValidatorKeys = append(response.UntrackedValidatorKeys, validatorPubKey.String())
+		}
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/types/api"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/urfave/cli"
+)
+
+// Plan a sweep of the CL rewards of every withdraw vault of the operator holding at least minimum for the operator
+func canSweepClRewards(c *cli.Context, minimum *big.Int) (*api.CanSweepClRewardsResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+
+	vaults, rewardsThreshold, err := getClRewardsVaults(c, minimum)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.CanSweepClRewardsResponse{
+		RewardsThreshold: rewardsThreshold,
+		Vaults:           vaults,
+		TotalRewards:     big.NewInt(0),
+	}
+	for _, vault := range vaults {
+		if !vault.Distribute {
+			continue
+		}
+		response.TotalRewards.Add(response.TotalRewards, vault.OperatorRewards)
+		response.GasInfo.EstGasLimit += vault.GasInfo.EstGasLimit
+		response.GasInfo.SafeGasLimit += vault.GasInfo.SafeGasLimit
+	}
+
+	return &response, nil
+}
+
+func sweepClRewards(c *cli.Context, minimum *big.Int) (*api.SweepClRewardsResponse, error) {
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.SweepClRewardsResponse{
+		Swept:  []api.SweptClRewards{},
+		Failed: map[string]string{},
+	}
+
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	operatorInfo, err := node.GetOperatorInfo(pnr, operatorId, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.OperatorRewardAddress = operatorInfo.OperatorRewardAddress
+
+	// the balances may have moved since the plan was shown, so scan again before sending
+	vaults, _, err := getClRewardsVaults(c, minimum)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the distributions back to back, so set the nonces here rather than rely on the pending state of the client
+	nonce, err := ec.PendingNonceAt(context.Background(), nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+	for _, vault := range vaults {
+		if !vault.Distribute {
+			continue
+		}
+		opts, err := w.GetNodeAccountTransactor()
+		if err != nil {
+			return nil, err
+		}
+		opts.Nonce = new(big.Int).SetUint64(nonce)
+
+		tx, err := node.DistributeRewards(pnr.Client, vault.WithdrawVaultAddress, opts)
+		if err != nil {
+			response.Failed[vault.ValidatorPubKey.String()] = err.Error()
+			continue
+		}
+		nonce++
+		response.Swept = append(response.Swept, api.SweptClRewards{
+			ValidatorPubKey: vault.ValidatorPubKey,
+			ClRewardsAmount: vault.OperatorRewards,
+			TxHash:          tx.Hash(),
+		})
+	}
+
+	return &response, nil
+}
+
+func getClRewardsVaults(c *cli.Context, minimum *big.Int) ([]stdr.ClRewardsVault, *big.Int, error) {
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	sdcfg, err := services.GetStaderConfigContract(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	putils, err := services.GetPoolUtilsContract(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, nil, err
+	}
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stdr.GetClRewardsVaults(pnr, putils, sdcfg, operatorId, nodeAccount.Address, minimum, opts)
+}
@@ -0,0 +1,211 @@
+package guardian
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/stader-labs/stader-node/shared/services/alerting"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/state"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+)
+
+// Name of the file in the guardian folder that keeps the alerts across restarts
+const alertStateFile = "alerts.json"
+
+// Name of the alerts file of a watched operator
+const operatorAlertStateFormat = "alerts-%s.json"
+
+// An alert that is firing
+type activeAlert struct {
+	Alert        alerting.Alert `json:"alert"`
+	Repeat       bool           `json:"repeat"`
+	LastNotified time.Time      `json:"lastNotified"`
+}
+
+// Persisted alert state
+type alertState struct {
+	Active            map[string]*activeAlert          `json:"active"`
+	ValidatorStatuses map[string]beacon.ValidatorState `json:"validatorStatuses"`
+}
+
+// Turns alert conditions into notifications: new conditions fire once, reminders follow the repeat interval
+// and conditions that clear are reported as resolved
+type alertManager struct {
+	cfg       *config.AlertingConfig
+	notifiers []alerting.Notifier
+	operator  string
+	statePath string
+	state     alertState
+	log       log.ColorLogger
+	errorLog  log.ColorLogger
+}
+
+// Create an alert manager for the node account, or for a watched operator if one is given; their alerts name the operator
+func newAlertManager(cfg *config.StaderConfig, operator string, logger log.ColorLogger, errorLog log.ColorLogger) (*alertManager, error) {
+	stateFile := alertStateFile
+	if operator != "" {
+		stateFile = fmt.Sprintf(operatorAlertStateFormat, operator)
+	}
+	statePath := filepath.Join(cfg.StaderNode.GetGuardianFolder(true), stateFile)
+	state, err := loadAlertState(statePath)
+	if err != nil {
+		return nil, err
+	}
+	m := &alertManager{
+		cfg:       cfg.Alerting,
+		notifiers: alerting.NewNotifiers(cfg.Alerting),
+		operator:  operator,
+		statePath: statePath,
+		state:     state,
+		log:       logger,
+		errorLog:  errorLog,
+	}
+
+	if len(m.notifiers) == 0 {
+		m.errorLog.Println("Alerting is enabled but no notifiers are configured, alerts will only be logged.")
+	}
+
+	return m, nil
+}
+
+// Read the alert state saved by a previous run, or an empty state if there is none
+func loadAlertState(statePath string) (alertState, error) {
+	state := alertState{
+		Active:            map[string]*activeAlert{},
+		ValidatorStatuses: map[string]beacon.ValidatorState{},
+	}
+
+	bytes, err := os.ReadFile(statePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return alertState{}, fmt.Errorf("error reading alert state %s: %w", statePath, err)
+	}
+	if err := json.Unmarshal(bytes, &state); err != nil {
+		return alertState{}, fmt.Errorf("error decoding alert state %s: %w", statePath, err)
+	}
+	if state.Active == nil {
+		state.Active = map[string]*activeAlert{}
+	}
+	if state.ValidatorStatuses == nil {
+		state.ValidatorStatuses = map[string]beacon.ValidatorState{}
+	}
+	return state, nil
+}
+
+// Evaluate the alert rules against the latest metrics and send the notifications that are due
+func (m *alertManager) run(metrics *state.MetricsCache) error {
+	now := time.Now()
+	repeatInterval := time.Duration(m.cfg.RepeatInterval.Value.(uint64)) * time.Hour
+
+	activeKeys := map[string]bool{}
+	for key := range m.state.Active {
+		if m.operator != "" {
+			key = strings.TrimPrefix(key, m.operator+"-")
+		}
+		activeKeys[key] = true
+	}
+	conditions := getAlertConditions(m.cfg, metrics, m.state.ValidatorStatuses, activeKeys)
+	if m.operator != "" {
+		for i := range conditions {
+			conditions[i].key = fmt.Sprintf("%s-%s", m.operator, conditions[i].key)
+			conditions[i].title = fmt.Sprintf("%s (operator %s)", conditions[i].title, m.operator)
+		}
+	}
+
+	firing := map[string]bool{}
+	for _, condition := range conditions {
+		firing[condition.key] = true
+
+		active, exists := m.state.Active[condition.key]
+		escalated := false
+		if !exists {
+			active = &activeAlert{
+				Alert: alerting.Alert{
+					Key:     condition.key,
+					FiredAt: now,
+				},
+			}
+			m.state.Active[condition.key] = active
+		} else {
+			escalated = active.Alert.Severity != condition.severity && condition.severity == alerting.Severity_Critical
+		}
+		active.Alert.Severity = condition.severity
+		active.Alert.Title = condition.title
+		active.Alert.Message = condition.message
+		active.Repeat = condition.repeat
+
+		due := active.LastNotified.IsZero() || escalated ||
+			(active.Repeat && repeatInterval > 0 && now.Sub(active.LastNotified) >= repeatInterval)
+		if due && m.notify(active.Alert) {
+			active.LastNotified = now
+		}
+	}
+
+	for key, active := range m.state.Active {
+		if firing[key] {
+			continue
+		}
+		// Only report the resolution of alerts the operator heard about
+		if !active.LastNotified.IsZero() {
+			resolved := active.Alert
+			resolved.Resolved = true
+			resolved.ResolvedAt = now
+			m.notify(resolved)
+		}
+		delete(m.state.Active, key)
+	}
+
+	statuses := map[string]beacon.ValidatorState{}
+	for pubkey, status := range metrics.ValidatorDetails {
+		if status.Exists {
+			statuses[pubkey.String()] = status.Status
+		}
+	}
+	m.state.ValidatorStatuses = statuses
+
+	return m.save()
+}
+
+// Send an alert to every notifier; returns true if at least one of them delivered it
+func (m *alertManager) notify(alert alerting.Alert) bool {
+	m.log.Printlnf("Alert: %s - %s", alert.Subject(), alert.Message)
+
+	delivered := false
+	for _, notifier := range m.notifiers {
+		if err := notifier.Notify(alert); err != nil {
+			m.errorLog.Printlnf("Could not send alert %s with the %s notifier: %s", alert.Key, notifier.GetName(), err.Error())
+			continue
+		}
+		delivered = true
+	}
+	return delivered
+}
+
+// Write the alert state to disk
+func (m *alertManager) save() error {
+	bytes, err := json.MarshalIndent(m.state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding alert state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(m.statePath), 0755); err != nil {
+		return fmt.Errorf("error creating the guardian folder: %w", err)
+	}
+	tmpPath := m.statePath + ".tmp"
+	if err := os.WriteFile(tmpPath, bytes, 0600); err != nil {
+		return fmt.Errorf("error writing alert state: %w", err)
+	}
+	if err := os.Rename(tmpPath, m.statePath); err != nil {
+		return fmt.Errorf("error writing alert state: %w", err)
+	}
+	return nil
+}
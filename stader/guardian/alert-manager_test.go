@@ -0,0 +1,184 @@
+package guardian
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/stader-labs/stader-node/shared/services/alerting"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/state"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Records the alerts it is given, and refuses them while failing is set
+type fakeNotifier struct {
+	failing bool
+	sent    []string
+}
+
+func (n *fakeNotifier) GetName() string {
+	return "fake"
+}
+
+func (n *fakeNotifier) Notify(alert alerting.Alert) error {
+	if n.failing {
+		return fmt.Errorf("notifier is down")
+	}
+	n.sent = append(n.sent, alert.Subject())
+	return nil
+}
+
+var testPubkey = types.BytesToValidatorPubkey([]byte{1, 2, 3})
+
+func newTestAlertManager(t *testing.T, statePath string, operator string, notifier *fakeNotifier) *alertManager {
+	cfg := &config.AlertingConfig{}
+	cfg.HealthFactorThreshold.Value = 1.2
+	cfg.UnclaimedCycleAge.Value = uint64(0)
+	cfg.RepeatInterval.Value = uint64(24)
+
+	alertState, err := loadAlertState(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &alertManager{
+		cfg:       cfg,
+		notifiers: []alerting.Notifier{notifier},
+		operator:  operator,
+		statePath: statePath,
+		state:     alertState,
+		log:       log.NewColorLogger(color.FgWhite),
+		errorLog:  log.NewColorLogger(color.FgRed),
+	}
+}
+
+func healthFactorMetrics(healthFactor float64) *state.MetricsCache {
+	return &state.MetricsCache{
+		StaderNetworkDetails: state.MetricDetails{
+			OperatorSDUtilized: 100,
+			HealthFactor:       healthFactor,
+		},
+	}
+}
+
+func validatorMetrics(status beacon.ValidatorState) *state.MetricsCache {
+	return &state.MetricsCache{
+		ValidatorDetails: map[types.ValidatorPubkey]beacon.ValidatorStatus{
+			testPubkey: {Pubkey: testPubkey, Index: 7, Status: status, Exists: true},
+		},
+	}
+}
+
+// One run of the alert manager
+type alertRun struct {
+	metrics *state.MetricsCache
+
+	// Time since the firing alerts were last notified, to reach the repeat interval
+	elapsed time.Duration
+	// Start a new alert manager from the saved state first, as after a restart
+	restart bool
+	// Whether the notifier refuses the alerts of this run
+	failing bool
+
+	expected []string
+}
+
+func TestAlertManagerRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator string
+		runs     []alertRun
+	}{
+		{"fires once while the condition lasts", "", []alertRun{
+			{metrics: healthFactorMetrics(1.1), expected: []string{"[WARNING] Low health factor"}},
+			{metrics: healthFactorMetrics(1.1)},
+			{metrics: healthFactorMetrics(1.15), elapsed: time.Hour},
+		}},
+		{"reminds after the repeat interval", "", []alertRun{
+			{metrics: healthFactorMetrics(1.1), expected: []string{"[WARNING] Low health factor"}},
+			{metrics: healthFactorMetrics(1.1), elapsed: 25 * time.Hour, expected: []string{"[WARNING] Low health factor"}},
+			{metrics: healthFactorMetrics(1.1)},
+		}},
+		{"escalates straight away", "", []alertRun{
+			{metrics: healthFactorMetrics(1.1), expected: []string{"[WARNING] Low health factor"}},
+			{metrics: healthFactorMetrics(0.9), expected: []string{"[CRITICAL] Low health factor"}},
+			{metrics: healthFactorMetrics(1.1)},
+		}},
+		{"resolves once", "", []alertRun{
+			{metrics: healthFactorMetrics(1.1), expected: []string{"[WARNING] Low health factor"}},
+			{metrics: healthFactorMetrics(2), expected: []string{"[RESOLVED] Low health factor"}},
+			{metrics: healthFactorMetrics(2)},
+		}},
+		{"retries undelivered alerts", "", []alertRun{
+			{metrics: healthFactorMetrics(1.1), failing: true},
+			{metrics: healthFactorMetrics(1.1), expected: []string{"[WARNING] Low health factor"}},
+		}},
+		{"doesn't resolve alerts that were never delivered", "", []alertRun{
+			{metrics: healthFactorMetrics(1.1), failing: true},
+			{metrics: healthFactorMetrics(2)},
+		}},
+		{"keeps firing alerts across restarts", "", []alertRun{
+			{metrics: healthFactorMetrics(1.1), expected: []string{"[WARNING] Low health factor"}},
+			{metrics: healthFactorMetrics(1.1), restart: true},
+			{metrics: healthFactorMetrics(2), restart: true, expected: []string{"[RESOLVED] Low health factor"}},
+		}},
+		{"follows a validator exit", "", []alertRun{
+			{metrics: validatorMetrics(beacon.ValidatorState_ActiveOngoing)},
+			{metrics: validatorMetrics(beacon.ValidatorState_ActiveExiting), expected: []string{"[WARNING] Validator 7 is exiting"}},
+			{metrics: validatorMetrics(beacon.ValidatorState_ExitedUnslashed), restart: true},
+			{metrics: validatorMetrics(beacon.ValidatorState_WithdrawalDone), expected: []string{"[RESOLVED] Validator 7 is exiting"}},
+		}},
+		{"ignores validators that were already exiting", "", []alertRun{
+			{metrics: validatorMetrics(beacon.ValidatorState_ActiveExiting)},
+			{metrics: validatorMetrics(beacon.ValidatorState_ExitedUnslashed)},
+		}},
+		{"names the watched operator", "0x1234", []alertRun{
+			{metrics: validatorMetrics(beacon.ValidatorState_ActiveOngoing)},
+			{metrics: validatorMetrics(beacon.ValidatorState_ActiveExiting), expected: []string{"[WARNING] Validator 7 is exiting (operator 0x1234)"}},
+			{metrics: validatorMetrics(beacon.ValidatorState_ExitedUnslashed)},
+			{metrics: validatorMetrics(beacon.ValidatorState_WithdrawalDone), expected: []string{"[RESOLVED] Validator 7 is exiting (operator 0x1234)"}},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			statePath := filepath.Join(t.TempDir(), alertStateFile)
+			notifier := &fakeNotifier{}
+			m := newTestAlertManager(t, statePath, test.operator, notifier)
+
+			for i, run := range test.runs {
+				if run.restart {
+					m = newTestAlertManager(t, statePath, test.operator, notifier)
+				}
+				for _, active := range m.state.Active {
+					if !active.LastNotified.IsZero() {
+						active.LastNotified = active.LastNotified.Add(-run.elapsed)
+					}
+				}
+				notifier.failing = run.failing
+				notifier.sent = nil
+
+				if err := m.run(run.metrics); err != nil {
+					t.Fatalf("run %d: %s", i, err.Error())
+				}
+				if fmt.Sprint(notifier.sent) != fmt.Sprint(run.expected) {
+					t.Errorf("run %d: expected %v to be sent, got %v", i, run.expected, notifier.sent)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadAlertStateMissingFile(t *testing.T) {
+	alertState, err := loadAlertState(filepath.Join(t.TempDir(), alertStateFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alertState.Active == nil || alertState.ValidatorStatuses == nil {
+		t.Error("expected an empty state that can be written to")
+	}
+}
@@ -0,0 +1,119 @@
+package guardian
+
+import (
+	"fmt"
+
+	"github.com/stader-labs/stader-node/shared/services/alerting"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/state"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+)
+
+// A condition that currently needs the operator's attention
+type alertCondition struct {
+	key      string
+	severity alerting.Severity
+	title    string
+	message  string
+
+	// Whether reminders are sent while the condition lasts
+	repeat bool
+}
+
+// Evaluate every alert rule against the latest metrics.
+// previousStatuses are the beacon statuses of the last run and activeKeys the alerts that are still firing,
+// so transitions such as a validator starting to exit keep firing until they are over.
+func getAlertConditions(cfg *config.AlertingConfig, metrics *state.MetricsCache, previousStatuses map[string]beacon.ValidatorState, activeKeys map[string]bool) []alertCondition {
+	conditions := []alertCondition{}
+	details := metrics.StaderNetworkDetails
+
+	// SD Utility Pool position
+	healthFactorThreshold := cfg.HealthFactorThreshold.Value.(float64)
+	if details.OperatorSDUtilized > 0 && details.HealthFactor < healthFactorThreshold {
+		severity := alerting.Severity_Warning
+		if details.HealthFactor < 1 {
+			severity = alerting.Severity_Critical
+		}
+		conditions = append(conditions, alertCondition{
+			key:      "health-factor",
+			severity: severity,
+			title:    "Low health factor",
+			message:  fmt.Sprintf("The health factor of your SD Utility Pool position is %.4f, below your threshold of %.4f. Add SD collateral or repay utilized SD to avoid liquidation.", details.HealthFactor, healthFactorThreshold),
+			repeat:   true,
+		})
+	}
+	if details.LiquidationStatus > 0 {
+		conditions = append(conditions, alertCondition{
+			key:      "liquidation",
+			severity: alerting.Severity_Critical,
+			title:    "Position in liquidation",
+			message:  "Your SD Utility Pool position is being liquidated.",
+			repeat:   true,
+		})
+	}
+
+	// SD collateral, against the minimum of the permissionless pool threshold read from chain
+	nonTerminalKeys := 0
+	for _, validatorInfo := range details.ValidatorInfoMap {
+		if !stdr.IsValidatorTerminal(validatorInfo) {
+			nonTerminalKeys++
+		}
+	}
+	minSdCollateral := details.CollateralRatioInSd * float64(nonTerminalKeys)
+	if nonTerminalKeys > 0 && minSdCollateral > 0 && details.OperatorStakedSd < minSdCollateral {
+		conditions = append(conditions, alertCondition{
+			key:      "sd-collateral",
+			severity: alerting.Severity_Warning,
+			title:    "SD collateral below the minimum",
+			message:  fmt.Sprintf("Your SD collateral is %.2f SD, below the %.2f SD minimum for your %d validators. You won't earn SD rewards until you add SD collateral.", details.OperatorStakedSd, minSdCollateral, nonTerminalKeys),
+			repeat:   true,
+		})
+	}
+
+	// Socializing Pool rewards
+	unclaimedCycleAge := cfg.UnclaimedCycleAge.Value.(uint64)
+	oldestUnclaimedCycle := details.OldestUnclaimedSocializingPoolCycle
+	if unclaimedCycleAge > 0 && oldestUnclaimedCycle > 0 && details.NextSocializingPoolRewardCycle.CurrentIndex != nil {
+		// Cycles before the current index have ended
+		cyclesSinceEnd := details.NextSocializingPoolRewardCycle.CurrentIndex.Int64() - 1 - oldestUnclaimedCycle
+		if cyclesSinceEnd >= int64(unclaimedCycleAge) {
+			conditions = append(conditions, alertCondition{
+				key:      "unclaimed-cycle",
+				severity: alerting.Severity_Warning,
+				title:    "Unclaimed Socializing Pool rewards",
+				message:  fmt.Sprintf("The rewards of cycle %d ended %d cycles ago and are still unclaimed (%.6f ETH and %.6f SD unclaimed in total). Claim them with `stader-cli node claim-sp-rewards`.", oldestUnclaimedCycle, cyclesSinceEnd, details.UnclaimedSocializingPoolElRewards, details.UnclaimedSocializingPoolSDRewards),
+				repeat:   true,
+			})
+		}
+	}
+
+	// Validators
+	for pubkey, status := range metrics.ValidatorDetails {
+		if !status.Exists {
+			continue
+		}
+		if status.Slashed {
+			conditions = append(conditions, alertCondition{
+				key:      fmt.Sprintf("validator-slashed-%s", pubkey.String()),
+				severity: alerting.Severity_Critical,
+				title:    fmt.Sprintf("Validator %d slashed", status.Index),
+				message:  fmt.Sprintf("Validator %s (index %d) has been slashed.", pubkey.String(), status.Index),
+			})
+			continue
+		}
+
+		exitingKey := fmt.Sprintf("validator-exiting-%s", pubkey.String())
+		exiting := status.Status == beacon.ValidatorState_ActiveExiting || status.Status == beacon.ValidatorState_ExitedUnslashed
+		if exiting && (previousStatuses[pubkey.String()] == beacon.ValidatorState_ActiveOngoing || activeKeys[exitingKey]) {
+			conditions = append(conditions, alertCondition{
+				key:      exitingKey,
+				severity: alerting.Severity_Warning,
+				title:    fmt.Sprintf("Validator %d is exiting", status.Index),
+				message:  fmt.Sprintf("Validator %s (index %d) started exiting at epoch %d. If you didn't request this exit, check where its keys are used.", pubkey.String(), status.Index, status.ExitEpoch),
+			})
+		}
+	}
+
+	return conditions
+}
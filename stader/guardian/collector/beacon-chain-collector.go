@@ -27,6 +27,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/state"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
@@ -49,9 +50,6 @@ type BeaconCollector struct {
 	// The eth1 client
 	ec stader.ExecutionClient
 
-	// The node's address
-	nodeAddress common.Address
-
 	// The thread-safe locker for the network state
 	stateLocker *MetricsCacheContainer
 
@@ -60,24 +58,24 @@ type BeaconCollector struct {
 }
 
 // Create a new NetworkCollector instance
-func NewBeaconCollector(bc beacon.Client, ec stader.ExecutionClient, nodeAddress common.Address, stateLocker *MetricsCacheContainer) *BeaconCollector {
+func NewBeaconCollector(bc beacon.Client, ec stader.ExecutionClient, stateLocker *MetricsCacheContainer) *BeaconCollector {
 	subsystem := "beacon"
+	labels := []string{"operator"}
 	return &BeaconCollector{
 		activeSyncCommittee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "active_sync_committee"),
 			"The number of validators on a current sync committee",
-			nil, nil,
+			labels, nil,
 		),
 		upcomingSyncCommittee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "upcoming_sync_committee"),
 			"The number of validators on the next sync committee",
-			nil, nil,
+			labels, nil,
 		),
 		upcomingProposals: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "upcoming_proposals"),
 			"The number of proposals assigned to validators in this epoch and the next",
-			nil, nil,
+			labels, nil,
 		),
 		bc:          bc,
 		ec:          ec,
-		nodeAddress: nodeAddress,
 		stateLocker: stateLocker,
 		logPrefix:   "Beacon Collector",
 	}
@@ -92,16 +90,27 @@ func (collector *BeaconCollector) Describe(channel chan<- *prometheus.Desc) {
 
 // Collect the latest metric values and pass them to Prometheus
 func (collector *BeaconCollector) Collect(channel chan<- prometheus.Metric) {
-	// Get the latest state
-	state := collector.stateLocker.GetMetricsContainer()
+	head, err := collector.bc.GetBeaconHead()
+	if err != nil {
+		collector.logError(fmt.Errorf("error getting Beacon chain head: %w", err))
+		return
+	}
 
+	for operator, state := range collector.stateLocker.GetOperatorMetricsContainers() {
+		if err := collector.collectOperator(channel, operator, state, head); err != nil {
+			collector.logError(fmt.Errorf("error getting duties of operator %s: %w", operator.Hex(), err))
+		}
+	}
+}
+
+// Collect the duties of one operator's validators
+func (collector *BeaconCollector) collectOperator(channel chan<- prometheus.Metric, operator common.Address, state *state.MetricsCache, head beacon.BeaconHead) error {
 	var wg errgroup.Group
 	activeSyncCommittee := float64(0)
 	upcomingSyncCommittee := float64(0)
 	upcomingProposals := float64(0)
 
 	var validatorIndices []uint64
-	var head beacon.BeaconHead
 
 	// Get sync committee duties
 	for _, validator := range state.ValidatorDetails {
@@ -110,12 +119,6 @@ func (collector *BeaconCollector) Collect(channel chan<- prometheus.Metric) {
 		}
 	}
 
-	head, err := collector.bc.GetBeaconHead()
-	if err != nil {
-		collector.logError(fmt.Errorf("error getting Beacon chain head: %w", err))
-		return
-	}
-
 	wg.Go(func() error {
 		// Get current duties
 		duties, err := collector.bc.GetValidatorSyncDuties(validatorIndices, head.Epoch)
@@ -167,17 +170,18 @@ func (collector *BeaconCollector) Collect(channel chan<- prometheus.Metric) {
 
 	// Wait for data
 	if err := wg.Wait(); err != nil {
-		collector.logError(err)
-		return
+		return err
 	}
 
+	label := operator.Hex()
 	channel <- prometheus.MustNewConstMetric(
-		collector.activeSyncCommittee, prometheus.GaugeValue, activeSyncCommittee)
+		collector.activeSyncCommittee, prometheus.GaugeValue, activeSyncCommittee, label)
 	channel <- prometheus.MustNewConstMetric(
-		collector.upcomingSyncCommittee, prometheus.GaugeValue, upcomingSyncCommittee)
+		collector.upcomingSyncCommittee, prometheus.GaugeValue, upcomingSyncCommittee, label)
 	channel <- prometheus.MustNewConstMetric(
-		collector.upcomingProposals, prometheus.GaugeValue, upcomingProposals)
+		collector.upcomingProposals, prometheus.GaugeValue, upcomingProposals, label)
 
+	return nil
 }
 
 // Log error messages
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/state"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -51,9 +52,6 @@ type OperatorCollector struct {
 	// The eth1 client
 	ec stader.ExecutionClient
 
-	// The node's address
-	nodeAddress common.Address
-
 	// The thread-safe locker for the network state
 	stateLocker *MetricsCacheContainer
 
@@ -65,89 +63,88 @@ type OperatorCollector struct {
 func NewOperatorCollector(
 	bc beacon.Client,
 	ec stader.ExecutionClient,
-	nodeAddress common.Address,
 	stateLocker *MetricsCacheContainer,
 ) *OperatorCollector {
+	labels := []string{"operator"}
 	return &OperatorCollector{
 		ActiveValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, ActiveValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, ActiveValidators), "", labels, nil,
 		),
 		BeaconChainQueuedValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, BeaconChainQueuedValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, BeaconChainQueuedValidators), "", labels, nil,
 		),
 		StaderQueuedValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, StaderQueuedValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, StaderQueuedValidators), "", labels, nil,
 		),
 		SlashedValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, SlashedValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, SlashedValidators), "", labels, nil,
 		),
 		ExitingValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, ExitingValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, ExitingValidators), "", labels, nil,
 		),
 		WithdrawnValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, WithdrawnValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, WithdrawnValidators), "", labels, nil,
 		),
 		InvalidSignatureValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, InvalidSignatureValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, InvalidSignatureValidators), "", labels, nil,
 		),
 		FrontRunValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, FrontRunValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, FrontRunValidators), "", labels, nil,
 		),
 		IntializedValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, InitializedValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, InitializedValidators), "", labels, nil,
 		),
 		FundsSettledValidators: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, FundsSettledValidators), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, FundsSettledValidators), "", labels, nil,
 		),
 		UnclaimedClRewards: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedCLRewards), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedCLRewards), "", labels, nil,
 		),
 		UnclaimedNonSocializingPoolElRewards: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedNonSocializingPoolELRewards), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedNonSocializingPoolELRewards), "", labels, nil,
 		),
 		CumulativePenalty: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, CumulativePenalty), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, CumulativePenalty), "", labels, nil),
 		UnclaimedSocializingPoolELRewards: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedSocializingPoolELRewards), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedSocializingPoolELRewards), "", labels, nil,
 		),
 		UnclaimedSocializingPoolSdRewards: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedSocializingPoolSdRewards), "", nil, nil,
+			prometheus.BuildFQName(namespace, OperatorSub, UnclaimedSocializingPoolSdRewards), "", labels, nil,
 		),
 		ClaimedSocializingPoolSdRewards: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, ClaimedSocializingPoolSDrewards), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, ClaimedSocializingPoolSDrewards), "", labels, nil),
 		ClaimedSocializingPoolElRewards: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, ClaimedSocializingPoolELRewards), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, ClaimedSocializingPoolELRewards), "", labels, nil),
 		TotalSdCollateral: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, SdCollateral), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, SdCollateral), "", labels, nil),
 		TotalSdCollateralInEth: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, SdCollateralInEth), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, SdCollateralInEth), "", labels, nil),
 		TotalEthColateral: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, EthCollateral), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, EthCollateral), "", labels, nil),
 		TotalSDUtilized: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, SDUtilized), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, SDUtilized), "", labels, nil),
 		TotalSDUtilizedInterest: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, SDUtilizedInterest), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, SDUtilizedInterest), "", labels, nil),
 		SdCollateralPct: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, SdCollateralPct), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, SdCollateralPct), "", labels, nil),
 		LockedEth: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, LockedEth), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, LockedEth), "", labels, nil),
 		HealthFactor: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, HeathFactor), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, HeathFactor), "", labels, nil),
 		TotalSDUtilizationPosition: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, TotalSDUtilizationPosition), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, TotalSDUtilizationPosition), "", labels, nil),
 		TotalSDSelfBond: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, TotalSDSelfBonded), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, TotalSDSelfBonded), "", labels, nil),
 		LiquidationStatus: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, LiquidationStatus), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, LiquidationStatus), "", labels, nil),
 		ClaimVaultBalance: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, OperatorSub, ClaimVaultBalance), "", nil, nil),
+			prometheus.BuildFQName(namespace, OperatorSub, ClaimVaultBalance), "", labels, nil),
 		SDSelfBond: prometheus.NewDesc(prometheus.BuildFQName(namespace, OperatorSub, "sd_self_bond"),
 			"The current balance of the SD utility pool",
-			nil, nil,
+			labels, nil,
 		),
 		bc:          bc,
 		ec:          ec,
-		nodeAddress: nodeAddress,
 		stateLocker: stateLocker,
 		logPrefix:   "Operator Collector",
 	}
@@ -189,38 +186,44 @@ func (collector *OperatorCollector) Describe(channel chan<- *prometheus.Desc) {
 
 // Collect the latest metric values and pass them to Prometheus
 func (collector *OperatorCollector) Collect(channel chan<- prometheus.Metric) {
-	// Get the latest state
-	state := collector.stateLocker.GetMetricsContainer()
-
-	channel <- prometheus.MustNewConstMetric(collector.ActiveValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.ActiveValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.BeaconChainQueuedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.BeaconChainQueuedValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.StaderQueuedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.StaderQueuedValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.SlashedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.SlashedValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.ExitingValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.ExitingValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.WithdrawnValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.WithdrawnValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.InvalidSignatureValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.InvalidSignatureValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.IntializedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.InitializedValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.FrontRunValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.FrontRunValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.FundsSettledValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.FundsSettledValidators.Int64()))
-	channel <- prometheus.MustNewConstMetric(collector.UnclaimedClRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedClRewards)
-	channel <- prometheus.MustNewConstMetric(collector.CumulativePenalty, prometheus.GaugeValue, state.StaderNetworkDetails.CumulativePenalty)
-	channel <- prometheus.MustNewConstMetric(collector.UnclaimedNonSocializingPoolElRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedNonSocializingPoolElRewards)
-	channel <- prometheus.MustNewConstMetric(collector.UnclaimedSocializingPoolELRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedSocializingPoolElRewards)
-	channel <- prometheus.MustNewConstMetric(collector.UnclaimedSocializingPoolSdRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedSocializingPoolSDRewards)
-	channel <- prometheus.MustNewConstMetric(collector.ClaimedSocializingPoolSdRewards, prometheus.GaugeValue, state.StaderNetworkDetails.ClaimedSocializingPoolSdRewards)
-	channel <- prometheus.MustNewConstMetric(collector.ClaimedSocializingPoolElRewards, prometheus.GaugeValue, state.StaderNetworkDetails.ClaimedSocializingPoolElRewards)
-	channel <- prometheus.MustNewConstMetric(collector.TotalSdCollateral, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorStakedSd)
-	channel <- prometheus.MustNewConstMetric(collector.TotalSdCollateralInEth, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorStakedSdInEth)
-	channel <- prometheus.MustNewConstMetric(collector.TotalEthColateral, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorEthCollateral)
-	channel <- prometheus.MustNewConstMetric(collector.TotalSDUtilized, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDUtilized)
-	channel <- prometheus.MustNewConstMetric(collector.TotalSDUtilizedInterest, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDInterest)
-	channel <- prometheus.MustNewConstMetric(collector.SdCollateralPct, prometheus.GaugeValue, state.StaderNetworkDetails.SdCollateralPct)
-	channel <- prometheus.MustNewConstMetric(collector.LockedEth, prometheus.GaugeValue, state.StaderNetworkDetails.LockedEth)
-	channel <- prometheus.MustNewConstMetric(collector.HealthFactor, prometheus.GaugeValue, state.StaderNetworkDetails.HealthFactor)
-	channel <- prometheus.MustNewConstMetric(collector.TotalSDUtilizationPosition, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDUtilizationPosition)
-	channel <- prometheus.MustNewConstMetric(collector.TotalSDSelfBond, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDSelfBond)
-	channel <- prometheus.MustNewConstMetric(collector.LiquidationStatus, prometheus.GaugeValue, state.StaderNetworkDetails.LiquidationStatus)
-	channel <- prometheus.MustNewConstMetric(collector.ClaimVaultBalance, prometheus.GaugeValue, state.StaderNetworkDetails.ClaimVaultBalance)
+	for operator, state := range collector.stateLocker.GetOperatorMetricsContainers() {
+		collector.collectOperator(channel, operator, state)
+	}
+}
+
+// Collect the metrics of one operator
+func (collector *OperatorCollector) collectOperator(channel chan<- prometheus.Metric, operator common.Address, state *state.MetricsCache) {
+	label := operator.Hex()
+
+	channel <- prometheus.MustNewConstMetric(collector.ActiveValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.ActiveValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.BeaconChainQueuedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.BeaconChainQueuedValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.StaderQueuedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.StaderQueuedValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.SlashedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.SlashedValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.ExitingValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.ExitingValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.WithdrawnValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.WithdrawnValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.InvalidSignatureValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.InvalidSignatureValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.IntializedValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.InitializedValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.FrontRunValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.FrontRunValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.FundsSettledValidators, prometheus.GaugeValue, float64(state.StaderNetworkDetails.FundsSettledValidators.Int64()), label)
+	channel <- prometheus.MustNewConstMetric(collector.UnclaimedClRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedClRewards, label)
+	channel <- prometheus.MustNewConstMetric(collector.CumulativePenalty, prometheus.GaugeValue, state.StaderNetworkDetails.CumulativePenalty, label)
+	channel <- prometheus.MustNewConstMetric(collector.UnclaimedNonSocializingPoolElRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedNonSocializingPoolElRewards, label)
+	channel <- prometheus.MustNewConstMetric(collector.UnclaimedSocializingPoolELRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedSocializingPoolElRewards, label)
+	channel <- prometheus.MustNewConstMetric(collector.UnclaimedSocializingPoolSdRewards, prometheus.GaugeValue, state.StaderNetworkDetails.UnclaimedSocializingPoolSDRewards, label)
+	channel <- prometheus.MustNewConstMetric(collector.ClaimedSocializingPoolSdRewards, prometheus.GaugeValue, state.StaderNetworkDetails.ClaimedSocializingPoolSdRewards, label)
+	channel <- prometheus.MustNewConstMetric(collector.ClaimedSocializingPoolElRewards, prometheus.GaugeValue, state.StaderNetworkDetails.ClaimedSocializingPoolElRewards, label)
+	channel <- prometheus.MustNewConstMetric(collector.TotalSdCollateral, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorStakedSd, label)
+	channel <- prometheus.MustNewConstMetric(collector.TotalSdCollateralInEth, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorStakedSdInEth, label)
+	channel <- prometheus.MustNewConstMetric(collector.TotalEthColateral, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorEthCollateral, label)
+	channel <- prometheus.MustNewConstMetric(collector.TotalSDUtilized, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDUtilized, label)
+	channel <- prometheus.MustNewConstMetric(collector.TotalSDUtilizedInterest, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDInterest, label)
+	channel <- prometheus.MustNewConstMetric(collector.SdCollateralPct, prometheus.GaugeValue, state.StaderNetworkDetails.SdCollateralPct, label)
+	channel <- prometheus.MustNewConstMetric(collector.LockedEth, prometheus.GaugeValue, state.StaderNetworkDetails.LockedEth, label)
+	channel <- prometheus.MustNewConstMetric(collector.HealthFactor, prometheus.GaugeValue, state.StaderNetworkDetails.HealthFactor, label)
+	channel <- prometheus.MustNewConstMetric(collector.TotalSDUtilizationPosition, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDUtilizationPosition, label)
+	channel <- prometheus.MustNewConstMetric(collector.TotalSDSelfBond, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDSelfBond, label)
+	channel <- prometheus.MustNewConstMetric(collector.LiquidationStatus, prometheus.GaugeValue, state.StaderNetworkDetails.LiquidationStatus, label)
+	channel <- prometheus.MustNewConstMetric(collector.ClaimVaultBalance, prometheus.GaugeValue, state.StaderNetworkDetails.ClaimVaultBalance, label)
 	channel <- prometheus.MustNewConstMetric(collector.SDSelfBond, prometheus.GaugeValue, state.StaderNetworkDetails.OperatorSDSelfBond)
 }
 
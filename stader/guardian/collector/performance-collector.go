@@ -0,0 +1,453 @@
+package collector
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Number of concurrent beacon requests when loading the blocks of an epoch
+	performanceRequestWorkers = 8
+
+	// Number of epochs checked per update when the tracker fell behind the chain
+	maxPerformanceEpochsPerUpdate = 8
+)
+
+// Running attestation and proposal counters of one validator
+type ValidatorPerformance struct {
+	Pubkey   string
+	Operator string
+
+	AttestationsExpected uint64
+	AttestationsIncluded uint64
+	AttestationsMissed   uint64
+	HeadCorrect          uint64
+	TargetCorrect        uint64
+	SourceCorrect        uint64
+	InclusionDelaySum    uint64
+	LastInclusionDelay   uint64
+	ProposalsMade        uint64
+	ProposalsMissed      uint64
+}
+
+// A block or an empty slot
+type slotBlock struct {
+	block  beacon.BeaconBlock
+	exists bool
+}
+
+// Tracks the attestation and proposal performance of the node's validators, one finished epoch at a time
+type PerformanceCollector struct {
+	attestationsExpected *prometheus.Desc
+	attestationsIncluded *prometheus.Desc
+	attestationsMissed   *prometheus.Desc
+	headCorrect          *prometheus.Desc
+	targetCorrect        *prometheus.Desc
+	sourceCorrect        *prometheus.Desc
+	inclusionDelay       *prometheus.Desc
+	inclusionDelaySum    *prometheus.Desc
+	proposalsMade        *prometheus.Desc
+	proposalsMissed      *prometheus.Desc
+	checkedEpoch         *prometheus.Desc
+
+	// The beacon client
+	bc beacon.Client
+
+	// The thread-safe locker for the network state
+	stateLocker *MetricsCacheContainer
+
+	// Performance per validator index, guarded by lock
+	lock        *sync.Mutex
+	validators  map[uint64]*ValidatorPerformance
+	lastEpoch   uint64
+	initialized bool
+
+	// Blocks and block roots by slot, kept between epochs since the inclusion windows overlap
+	blocks map[uint64]slotBlock
+	roots  map[uint64]common.Hash
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new PerformanceCollector instance
+func NewPerformanceCollector(bc beacon.Client, stateLocker *MetricsCacheContainer) *PerformanceCollector {
+	subsystem := "performance"
+	labels := []string{"validator_index", "pubkey", "operator"}
+	return &PerformanceCollector{
+		attestationsExpected: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestations_expected"),
+			"The number of attestation duties of the validator since the guardian started",
+			labels, nil,
+		),
+		attestationsIncluded: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestations_included"),
+			"The number of attestations of the validator that were included on chain",
+			labels, nil,
+		),
+		attestationsMissed: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestations_missed"),
+			"The number of attestations of the validator that were never included on chain",
+			labels, nil,
+		),
+		headCorrect: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestations_head_correct"),
+			"The number of included attestations that voted for the canonical head",
+			labels, nil,
+		),
+		targetCorrect: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestations_target_correct"),
+			"The number of included attestations that voted for the canonical target checkpoint",
+			labels, nil,
+		),
+		sourceCorrect: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestations_source_correct"),
+			"The number of included attestations that voted for the justified source checkpoint",
+			labels, nil,
+		),
+		inclusionDelay: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestation_inclusion_delay"),
+			"The inclusion delay in slots of the last included attestation",
+			labels, nil,
+		),
+		inclusionDelaySum: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestation_inclusion_delay_sum"),
+			"The sum of the inclusion delays in slots of all included attestations",
+			labels, nil,
+		),
+		proposalsMade: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "proposals_made"),
+			"The number of blocks proposed by the validator",
+			labels, nil,
+		),
+		proposalsMissed: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "proposals_missed"),
+			"The number of proposal duties of the validator without a block",
+			labels, nil,
+		),
+		checkedEpoch: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_epoch"),
+			"The last epoch checked by the performance tracker",
+			nil, nil,
+		),
+		bc:          bc,
+		stateLocker: stateLocker,
+		lock:        &sync.Mutex{},
+		validators:  map[uint64]*ValidatorPerformance{},
+		blocks:      map[uint64]slotBlock{},
+		roots:       map[uint64]common.Hash{},
+		logPrefix:   "Performance Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *PerformanceCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.attestationsExpected
+	channel <- collector.attestationsIncluded
+	channel <- collector.attestationsMissed
+	channel <- collector.headCorrect
+	channel <- collector.targetCorrect
+	channel <- collector.sourceCorrect
+	channel <- collector.inclusionDelay
+	channel <- collector.inclusionDelaySum
+	channel <- collector.proposalsMade
+	channel <- collector.proposalsMissed
+	channel <- collector.checkedEpoch
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *PerformanceCollector) Collect(channel chan<- prometheus.Metric) {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+
+	for index, performance := range collector.validators {
+		labels := []string{strconv.FormatUint(index, 10), performance.Pubkey, performance.Operator}
+		channel <- prometheus.MustNewConstMetric(
+			collector.attestationsExpected, prometheus.CounterValue, float64(performance.AttestationsExpected), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.attestationsIncluded, prometheus.CounterValue, float64(performance.AttestationsIncluded), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.attestationsMissed, prometheus.CounterValue, float64(performance.AttestationsMissed), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.headCorrect, prometheus.CounterValue, float64(performance.HeadCorrect), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.targetCorrect, prometheus.CounterValue, float64(performance.TargetCorrect), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.sourceCorrect, prometheus.CounterValue, float64(performance.SourceCorrect), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.inclusionDelay, prometheus.GaugeValue, float64(performance.LastInclusionDelay), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.inclusionDelaySum, prometheus.CounterValue, float64(performance.InclusionDelaySum), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.proposalsMade, prometheus.CounterValue, float64(performance.ProposalsMade), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.proposalsMissed, prometheus.CounterValue, float64(performance.ProposalsMissed), labels...)
+	}
+	channel <- prometheus.MustNewConstMetric(
+		collector.checkedEpoch, prometheus.GaugeValue, float64(collector.lastEpoch))
+}
+
+// Check every epoch whose attestation inclusion window closed since the last update
+func (collector *PerformanceCollector) UpdatePerformance() error {
+	state := collector.stateLocker.GetMetricsContainer()
+	slotsPerEpoch := state.BeaconConfig.SlotsPerEpoch
+	if slotsPerEpoch == 0 {
+		// The metrics cache hasn't been loaded yet
+		return nil
+	}
+
+	validators := map[uint64]ValidatorPerformance{}
+	for operator, operatorState := range collector.stateLocker.GetOperatorMetricsContainers() {
+		for pubkey, validator := range operatorState.ValidatorDetails {
+			if validator.Exists {
+				validators[validator.Index] = ValidatorPerformance{Pubkey: pubkey.String(), Operator: operator.Hex()}
+			}
+		}
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	head, err := collector.bc.GetBeaconHead()
+	if err != nil {
+		return fmt.Errorf("error getting Beacon chain head: %w", err)
+	}
+	// Attestations of an epoch can be included until the end of the next one
+	if head.Epoch < 2 {
+		return nil
+	}
+	lastClosedEpoch := head.Epoch - 2
+
+	collector.lock.Lock()
+	nextEpoch := collector.lastEpoch + 1
+	if !collector.initialized {
+		nextEpoch = lastClosedEpoch
+	}
+	collector.lock.Unlock()
+
+	if lastClosedEpoch >= maxPerformanceEpochsPerUpdate && nextEpoch+maxPerformanceEpochsPerUpdate <= lastClosedEpoch {
+		collector.logError(fmt.Errorf("skipping epochs %d to %d, the tracker fell too far behind", nextEpoch, lastClosedEpoch-maxPerformanceEpochsPerUpdate))
+		nextEpoch = lastClosedEpoch - maxPerformanceEpochsPerUpdate + 1
+	}
+
+	for epoch := nextEpoch; epoch <= lastClosedEpoch; epoch++ {
+		if err := collector.processEpoch(epoch, slotsPerEpoch, validators); err != nil {
+			return fmt.Errorf("error checking the performance of epoch %d: %w", epoch, err)
+		}
+	}
+
+	return nil
+}
+
+// Check the attestations and proposals of the given validators for one epoch
+func (collector *PerformanceCollector) processEpoch(epoch uint64, slotsPerEpoch uint64, validators map[uint64]ValidatorPerformance) error {
+	firstSlot := epoch * slotsPerEpoch
+
+	// Find the attestation duties of our validators
+	committees, err := collector.bc.GetCommitteesForEpoch(&epoch)
+	if err != nil {
+		return err
+	}
+	type attestationDuty struct {
+		validatorIndex uint64
+		position       int
+		included       bool
+	}
+	duties := map[uint64]map[uint64][]*attestationDuty{}
+	committeeSizes := map[uint64]map[uint64]uint64{}
+	for _, committee := range committees {
+		if committeeSizes[committee.Slot] == nil {
+			committeeSizes[committee.Slot] = map[uint64]uint64{}
+		}
+		committeeSizes[committee.Slot][committee.Index] = uint64(len(committee.Validators))
+		for position, validatorIndex := range committee.Validators {
+			if _, ok := validators[validatorIndex]; !ok {
+				continue
+			}
+			if duties[committee.Slot] == nil {
+				duties[committee.Slot] = map[uint64][]*attestationDuty{}
+			}
+			duties[committee.Slot][committee.Index] = append(duties[committee.Slot][committee.Index], &attestationDuty{
+				validatorIndex: validatorIndex,
+				position:       position,
+			})
+		}
+	}
+
+	proposers, err := collector.bc.GetProposerSlots(epoch)
+	if err != nil {
+		return err
+	}
+
+	// The blocks of this epoch and the next one carry all the attestations of this epoch
+	if err := collector.loadBlocks(firstSlot, firstSlot+2*slotsPerEpoch); err != nil {
+		return err
+	}
+	targetRoot, err := collector.getCanonicalRoot(firstSlot, slotsPerEpoch)
+	if err != nil {
+		return err
+	}
+	// The justified checkpoint only changes at epoch boundaries, so the one at the start of the epoch is the correct source
+	checkpoints, err := collector.bc.GetFinalityCheckpoints(strconv.FormatUint(firstSlot, 10))
+	if err != nil {
+		return fmt.Errorf("error getting the justified checkpoint of epoch %d: %w", epoch, err)
+	}
+	source := checkpoints.CurrentJustified
+	headRoots := map[uint64]common.Hash{}
+	for slot := range duties {
+		headRoots[slot], err = collector.getCanonicalRoot(slot, slotsPerEpoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+
+	getPerformance := func(validatorIndex uint64) *ValidatorPerformance {
+		performance, ok := collector.validators[validatorIndex]
+		if !ok {
+			performance = &ValidatorPerformance{Pubkey: validators[validatorIndex].Pubkey, Operator: validators[validatorIndex].Operator}
+			collector.validators[validatorIndex] = performance
+		}
+		return performance
+	}
+
+	// Walk the blocks in order so the first inclusion of an attestation gives its inclusion delay
+	for slot := firstSlot + 1; slot < firstSlot+2*slotsPerEpoch; slot++ {
+		block := collector.blocks[slot]
+		if !block.exists {
+			continue
+		}
+		for _, attestation := range block.block.Attestations {
+			slotDuties := duties[attestation.SlotIndex]
+			if len(slotDuties) == 0 {
+				continue
+			}
+
+			// The aggregation bits run over all the committees of the attestation, one after another
+			offset := uint64(0)
+			for _, committeeIndex := range attestation.CommitteeIndices() {
+				for _, duty := range slotDuties[committeeIndex] {
+					bit := offset + uint64(duty.position)
+					if duty.included || bit >= attestation.AggregationBits.Len() || !attestation.AggregationBits.BitAt(bit) {
+						continue
+					}
+					duty.included = true
+
+					performance := getPerformance(duty.validatorIndex)
+					performance.AttestationsIncluded++
+					performance.LastInclusionDelay = block.block.Slot - attestation.SlotIndex
+					performance.InclusionDelaySum += performance.LastInclusionDelay
+					if attestation.SourceEpoch == source.Epoch && attestation.SourceRoot == source.Root {
+						performance.SourceCorrect++
+					}
+					if attestation.TargetEpoch == epoch && attestation.TargetRoot == targetRoot {
+						performance.TargetCorrect++
+					}
+					if attestation.BeaconBlockRoot == headRoots[attestation.SlotIndex] {
+						performance.HeadCorrect++
+					}
+				}
+				offset += committeeSizes[attestation.SlotIndex][committeeIndex]
+			}
+		}
+	}
+
+	for _, slotDuties := range duties {
+		for _, committeeDuties := range slotDuties {
+			for _, duty := range committeeDuties {
+				performance := getPerformance(duty.validatorIndex)
+				performance.AttestationsExpected++
+				if !duty.included {
+					performance.AttestationsMissed++
+				}
+			}
+		}
+	}
+
+	for slot, proposerIndex := range proposers {
+		if _, ok := validators[proposerIndex]; !ok {
+			continue
+		}
+		performance := getPerformance(proposerIndex)
+		block := collector.blocks[slot]
+		if block.exists && block.block.ProposerIndex == proposerIndex {
+			performance.ProposalsMade++
+		} else {
+			performance.ProposalsMissed++
+		}
+	}
+
+	collector.lastEpoch = epoch
+	collector.initialized = true
+
+	// The next epoch only needs its own blocks and the roots just before it
+	for slot := range collector.blocks {
+		if slot < firstSlot+slotsPerEpoch {
+			delete(collector.blocks, slot)
+		}
+	}
+	for slot := range collector.roots {
+		if slot < firstSlot {
+			delete(collector.roots, slot)
+		}
+	}
+
+	return nil
+}
+
+// Load the blocks of the slots in [startSlot, endSlot) that aren't cached yet
+func (collector *PerformanceCollector) loadBlocks(startSlot uint64, endSlot uint64) error {
+	var wg errgroup.Group
+	wg.SetLimit(performanceRequestWorkers)
+
+	results := make([]slotBlock, endSlot-startSlot)
+	for slot := startSlot; slot < endSlot; slot++ {
+		if _, ok := collector.blocks[slot]; ok {
+			continue
+		}
+		slot := slot
+		wg.Go(func() error {
+			block, exists, err := collector.bc.GetBeaconBlock(strconv.FormatUint(slot, 10))
+			if err != nil {
+				return fmt.Errorf("error getting the block of slot %d: %w", slot, err)
+			}
+			results[slot-startSlot] = slotBlock{block: block, exists: exists}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+
+	for slot := startSlot; slot < endSlot; slot++ {
+		if _, ok := collector.blocks[slot]; !ok {
+			collector.blocks[slot] = results[slot-startSlot]
+		}
+	}
+	return nil
+}
+
+// Get the root of the canonical block at the slot, which is the last block at or before it
+func (collector *PerformanceCollector) getCanonicalRoot(slot uint64, slotsPerEpoch uint64) (common.Hash, error) {
+	if root, ok := collector.roots[slot]; ok {
+		return root, nil
+	}
+
+	for candidate := slot; candidate+2*slotsPerEpoch > slot; candidate-- {
+		root, exists, err := collector.bc.GetBeaconBlockRoot(strconv.FormatUint(candidate, 10))
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("error getting the block root of slot %d: %w", candidate, err)
+		}
+		if exists {
+			collector.roots[slot] = root
+			return root, nil
+		}
+		if candidate == 0 {
+			break
+		}
+	}
+
+	return common.Hash{}, fmt.Errorf("no block found in the %d slots before slot %d", 2*slotsPerEpoch, slot)
+}
+
+// Log error messages
+func (collector *PerformanceCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
@@ -0,0 +1,139 @@
+package collector
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+)
+
+// Beacon client serving a fixed epoch
+type fakeBeaconClient struct {
+	beacon.Client
+	committees  []beacon.Committee
+	blocks      map[uint64]beacon.BeaconBlock
+	checkpoints beacon.FinalityCheckpoints
+}
+
+func (c *fakeBeaconClient) GetCommitteesForEpoch(epoch *uint64) ([]beacon.Committee, error) {
+	return c.committees, nil
+}
+
+func (c *fakeBeaconClient) GetProposerSlots(epoch uint64) (map[uint64]uint64, error) {
+	return map[uint64]uint64{}, nil
+}
+
+func (c *fakeBeaconClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock, bool, error) {
+	slot, _ := strconv.ParseUint(blockId, 10, 64)
+	block, exists := c.blocks[slot]
+	return block, exists, nil
+}
+
+func (c *fakeBeaconClient) GetBeaconBlockRoot(blockId string) (common.Hash, bool, error) {
+	slot, _ := strconv.ParseUint(blockId, 10, 64)
+	return slotRoot(slot), true, nil
+}
+
+func (c *fakeBeaconClient) GetFinalityCheckpoints(stateId string) (beacon.FinalityCheckpoints, error) {
+	return c.checkpoints, nil
+}
+
+func slotRoot(slot uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(slot + 1))
+}
+
+func newBitlist(length uint64, bits ...uint64) bitfield.Bitlist {
+	bitlist := bitfield.NewBitlist(length)
+	for _, bit := range bits {
+		bitlist.SetBitAt(bit, true)
+	}
+	return bitlist
+}
+
+func TestProcessEpochAttestations(t *testing.T) {
+	const (
+		slotsPerEpoch = 4
+		epoch         = 1
+		dutySlot      = 4
+	)
+	source := beacon.Checkpoint{Epoch: 0, Root: common.HexToHash("0x01")}
+
+	// Validators 11 and 21 attest, 22 misses its attestation
+	committees := []beacon.Committee{
+		{Index: 0, Slot: dutySlot, Validators: []uint64{10, 11, 12}},
+		{Index: 1, Slot: dutySlot, Validators: []uint64{20, 21, 22}},
+	}
+	validators := map[uint64]ValidatorPerformance{11: {}, 21: {}, 22: {}}
+
+	vote := beacon.AttestationInfo{
+		SlotIndex:       dutySlot,
+		BeaconBlockRoot: slotRoot(dutySlot),
+		SourceEpoch:     source.Epoch,
+		SourceRoot:      source.Root,
+		TargetEpoch:     epoch,
+		TargetRoot:      slotRoot(dutySlot),
+	}
+	preElectra := []beacon.AttestationInfo{vote, vote}
+	preElectra[0].CommitteeIndex = 0
+	preElectra[0].AggregationBits = newBitlist(3, 1)
+	preElectra[1].CommitteeIndex = 1
+	preElectra[1].AggregationBits = newBitlist(3, 1)
+
+	// Electra aggregates both committees into one attestation whose data index is always 0
+	electra := []beacon.AttestationInfo{vote}
+	electra[0].CommitteeBits = bitfield.NewBitvector64()
+	electra[0].CommitteeBits.SetBitAt(0, true)
+	electra[0].CommitteeBits.SetBitAt(1, true)
+	electra[0].AggregationBits = newBitlist(6, 1, 4)
+
+	wrongSource := []beacon.AttestationInfo{vote, vote}
+	wrongSource[0].AggregationBits = newBitlist(3, 1)
+	wrongSource[1].CommitteeIndex = 1
+	wrongSource[1].AggregationBits = newBitlist(3, 1)
+	wrongSource[1].SourceRoot = common.HexToHash("0x02")
+
+	tests := []struct {
+		name          string
+		attestations  []beacon.AttestationInfo
+		sourceCorrect map[uint64]uint64
+	}{
+		{name: "pre-Electra", attestations: preElectra, sourceCorrect: map[uint64]uint64{11: 1, 21: 1}},
+		{name: "Electra", attestations: electra, sourceCorrect: map[uint64]uint64{11: 1, 21: 1}},
+		{name: "wrong source", attestations: wrongSource, sourceCorrect: map[uint64]uint64{11: 1, 21: 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bc := &fakeBeaconClient{
+				committees: committees,
+				blocks: map[uint64]beacon.BeaconBlock{
+					dutySlot + 1: {Slot: dutySlot + 1, Attestations: test.attestations},
+				},
+				checkpoints: beacon.FinalityCheckpoints{CurrentJustified: source},
+			}
+			collector := NewPerformanceCollector(bc, nil)
+			if err := collector.processEpoch(epoch, slotsPerEpoch, validators); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, index := range []uint64{11, 21} {
+				performance := collector.validators[index]
+				if performance.AttestationsExpected != 1 || performance.AttestationsIncluded != 1 || performance.AttestationsMissed != 0 {
+					t.Errorf("validator %d should have its attestation included, got %+v", index, *performance)
+				}
+				if performance.HeadCorrect != 1 || performance.TargetCorrect != 1 || performance.LastInclusionDelay != 1 {
+					t.Errorf("validator %d should have a correct head and target with delay 1, got %+v", index, *performance)
+				}
+				if performance.SourceCorrect != test.sourceCorrect[index] {
+					t.Errorf("validator %d should have %d correct sources, got %d", index, test.sourceCorrect[index], performance.SourceCorrect)
+				}
+			}
+			if performance := collector.validators[22]; performance.AttestationsExpected != 1 || performance.AttestationsMissed != 1 {
+				t.Errorf("validator 22 should have missed its attestation, got %+v", *performance)
+			}
+		})
+	}
+}
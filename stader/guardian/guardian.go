@@ -22,6 +22,7 @@ package guardian
 import (
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -46,6 +47,7 @@ const (
 	ErrorColor   = color.FgRed
 	UpdateColor  = color.FgBlue
 	MetricsColor = color.FgHiYellow
+	AlertColor   = color.FgHiMagenta
 )
 
 // Register guardian command
@@ -83,19 +85,47 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	metricsCache := collector.NewMetricsCacheContainer()
-	w, err := services.GetWallet(c)
+	// Watch the configured operators, or the node account if there are none
+	watchedOperators, err := cfg.StaderNode.GetWatchedOperators()
 	if err != nil {
 		return err
 	}
+	operators := watchedOperators
+	if operators == nil {
+		w, err := services.GetWallet(c)
+		if err != nil {
+			return err
+		}
+		nodeAccount, err := w.GetNodeAccount()
+		if err != nil {
+			return err
+		}
+		operators = []common.Address{nodeAccount.Address}
+	} else {
+		updateLog.Printlnf("Watching %d operators.", len(operators))
+	}
+	isWatching := watchedOperators != nil
 
-	nodeAccount, err := w.GetNodeAccount()
-	if err != nil {
-		return err
+	metricsCache := collector.NewMetricsCacheContainer(operators)
+
+	performanceCollector := collector.NewPerformanceCollector(bc, metricsCache)
+
+	alerts := map[common.Address]*alertManager{}
+	if cfg.Alerting.EnableAlerting.Value == true {
+		for _, operator := range operators {
+			label := ""
+			if isWatching {
+				label = operator.Hex()
+			}
+			alerts[operator], err = newAlertManager(cfg, label, log.NewColorLogger(AlertColor), errorLog)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	wg := new(sync.WaitGroup)
-	wg.Add(2)
+	wg.Add(3)
 
 	// Run metrics loop
 	go func() {
@@ -121,13 +151,53 @@ func run(c *cli.Context) error {
 				continue
 			}
 
-			networkStateCache, err := updateMetricsCache(m, nodeAccount.Address)
+			for _, operator := range operators {
+				networkStateCache, err := updateMetricsCache(m, operator)
+				if err != nil {
+					errorLog.Printlnf("updateMetricsCache %s %s", operator.Hex(), err)
+					continue
+				}
+				metricsCache.UpdateMetricsContainer(operator, networkStateCache)
+
+				if retentionDays := cfg.StaderNode.GetHistoryRetentionDays(); retentionDays > 0 {
+					// Watched operators each get their own history
+					historyFolder := cfg.StaderNode.GetHistoryFolder(true)
+					if isWatching {
+						historyFolder = filepath.Join(historyFolder, operator.Hex())
+					}
+					err := state.SaveSnapshot(historyFolder, networkStateCache.Snapshot(time.Now()), retentionDays)
+					if err != nil {
+						errorLog.Println("saveSnapshot ", err)
+					}
+				}
+
+				if alerts[operator] != nil {
+					if err := alerts[operator].run(networkStateCache); err != nil {
+						errorLog.Println("runAlerts ", err)
+					}
+				}
+			}
+			time.Sleep(tasksInterval)
+		}
+
+		wg.Done()
+	}()
+
+	// Run validator performance loop
+	go func() {
+		for {
+			err := services.WaitBeaconClientSynced(c, false)
 			if err != nil {
-				errorLog.Println("updateMetricsCache ", err)
+				errorLog.Println("WaitBeaconClientSynced ", err)
+				time.Sleep(taskCooldown)
+				continue
+			}
+
+			if err := performanceCollector.UpdatePerformance(); err != nil {
+				errorLog.Println("updatePerformance ", err)
 				time.Sleep(taskCooldown)
 				continue
 			}
-			metricsCache.UpdateMetricsContainer(networkStateCache)
 			time.Sleep(tasksInterval)
 		}
 
@@ -135,7 +205,7 @@ func run(c *cli.Context) error {
 	}()
 
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), metricsCache)
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), operators[0], metricsCache, performanceCollector)
 		if err != nil {
 			errorLog.Println(err)
 		}
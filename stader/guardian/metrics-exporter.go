@@ -25,6 +25,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stader-labs/stader-node/stader/guardian/collector"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,7 +35,7 @@ import (
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collector.MetricsCacheContainer) error {
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, nodeAddress common.Address, stateLocker *collector.MetricsCacheContainer, performanceCollector *collector.PerformanceCollector) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -60,24 +61,16 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 		}
 	}
 
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return err
-	}
+	beaconCollector := collector.NewBeaconCollector(bc, ec, stateLocker)
+	networkCollector := collector.NewNetworkCollector(bc, ec, nodeAddress, stateLocker)
+	operatorCollector := collector.NewOperatorCollector(bc, ec, stateLocker)
 
-	nodeAccount, err := w.GetNodeAccount()
-	if err != nil {
-		return err
-	}
-	nodeAccountAddr := nodeAccount.Address
-	beaconCollector := collector.NewBeaconCollector(bc, ec, nodeAccountAddr, stateLocker)
-	networkCollector := collector.NewNetworkCollector(bc, ec, nodeAccountAddr, stateLocker)
-	operatorCollector := collector.NewOperatorCollector(bc, ec, nodeAccountAddr, stateLocker)
 	// Set up Prometheus
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(beaconCollector)
 	registry.MustRegister(networkCollector)
 	registry.MustRegister(operatorCollector)
+	registry.MustRegister(performanceCollector)
 
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 
@@ -0,0 +1,308 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/automation"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	stader_utils "github.com/stader-labs/stader-node/shared/utils/stader"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	pool_utils "github.com/stader-labs/stader-node/stader-lib/pool-utils"
+	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
+	socializing_pool "github.com/stader-labs/stader-node/stader-lib/socializing-pool"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+	"github.com/stader-labs/stader-node/stader-lib/utils"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Config
+var autoClaimInterval, _ = time.ParseDuration("1h")
+
+const autoClaimTask = "auto-claim"
+
+// Auto claim task
+type autoClaim struct {
+	c           *cli.Context
+	log         log.ColorLogger
+	cfg         *config.StaderConfig
+	w           *wallet.Wallet
+	nodeAddress common.Address
+	ledger      *automation.Ledger
+}
+
+// Create auto claim task
+func newAutoClaim(c *cli.Context, logger log.ColorLogger, nodeAddress common.Address, ledger *automation.Ledger) (*autoClaim, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &autoClaim{
+		c:           c,
+		log:         logger,
+		cfg:         cfg,
+		w:           w,
+		nodeAddress: nodeAddress,
+		ledger:      ledger,
+	}, nil
+}
+
+// Claim the EL rewards vault, then the Socializing Pool cycles, then the operator rewards collector they both pay into
+func (t *autoClaim) run() error {
+	if t.cfg.Automation.EnableAutoClaim.Value != true {
+		return nil
+	}
+	automationTxLock.Lock()
+	defer automationTxLock.Unlock()
+
+	if err := t.claimElVault(); err != nil {
+		t.log.Printlnf("Could not claim the EL rewards vault: %s", err.Error())
+	}
+	if err := t.claimSpRewards(); err != nil {
+		t.log.Printlnf("Could not claim the Socializing Pool rewards: %s", err.Error())
+	}
+	if err := t.claimOperatorRewards(); err != nil {
+		t.log.Printlnf("Could not claim the operator rewards collector: %s", err.Error())
+	}
+
+	return nil
+}
+
+// Move the operator's share of the EL rewards vault to the operator rewards collector
+func (t *autoClaim) claimElVault() error {
+	pnr, err := services.GetPermissionlessNodeRegistry(t.c)
+	if err != nil {
+		return err
+	}
+	putils, err := services.GetPoolUtilsContract(t.c)
+	if err != nil {
+		return err
+	}
+
+	operatorId, err := node.GetOperatorId(pnr, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	vaultAddress, err := node.GetNodeElRewardAddress(pnr, 1, operatorId, nil)
+	if err != nil {
+		return err
+	}
+	vaultBalance, err := tokens.GetEthBalance(pnr.Client, vaultAddress, nil)
+	if err != nil {
+		return err
+	}
+	if vaultBalance.Sign() == 0 {
+		return nil
+	}
+	rewardShare, err := pool_utils.CalculateRewardShare(putils, 1, vaultBalance, nil)
+	if err != nil {
+		return err
+	}
+
+	return t.claim("el-vault", "the EL rewards vault", rewardShare.OperatorShare,
+		func(opts *bind.TransactOpts) (stader.GasInfo, error) {
+			return node.EstimateWithdrawFromNodeElVault(pnr.Client, vaultAddress, opts)
+		},
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return node.WithdrawFromNodeElVault(pnr.Client, vaultAddress, opts)
+		})
+}
+
+// Claim every unclaimed Socializing Pool cycle whose merkle proof has been downloaded and checks out
+func (t *autoClaim) claimSpRewards() error {
+	sp, err := services.GetSocializingPoolContract(t.c)
+	if err != nil {
+		return err
+	}
+	sdc, err := services.GetSdCollateralContract(t.c)
+	if err != nil {
+		return err
+	}
+
+	isPaused, err := socializing_pool.IsSocializingPoolPaused(sp, nil)
+	if err != nil {
+		return err
+	}
+	if isPaused {
+		return nil
+	}
+	rewardDetails, err := socializing_pool.GetRewardDetails(sp, nil)
+	if err != nil {
+		return err
+	}
+
+	cycles := []*big.Int{}
+	amountsSd := []*big.Int{}
+	amountsEth := []*big.Int{}
+	merkleProofs := [][][32]byte{}
+	totalSd := big.NewInt(0)
+	totalEth := big.NewInt(0)
+	for i := int64(1); i < rewardDetails.CurrentIndex.Int64(); i++ {
+		cycle := big.NewInt(i)
+		isClaimed, err := socializing_pool.HasClaimedRewards(sp, t.nodeAddress, cycle, nil)
+		if err != nil {
+			return err
+		}
+		if isClaimed {
+			continue
+		}
+		cycleMerkleProof, exists, err := t.cfg.StaderNode.ReadCycleCache(i)
+		if err != nil {
+			// a bad file shouldn't hold back the other cycles, the downloader replaces it
+			t.log.Printlnf("Skipping cycle %d: %s", i, err.Error())
+			continue
+		}
+		if !exists {
+			continue
+		}
+		if err := stader_utils.VerifyCycleMerkleProofs(sp, t.nodeAddress, &cycleMerkleProof); err != nil {
+			t.log.Printlnf("Skipping cycle %d: %s", i, err.Error())
+			continue
+		}
+		amountSd, amountEth, merkleProof, _, err := stader_utils.DecodeCycleMerkleProofs(&cycleMerkleProof)
+		if err != nil {
+			return err
+		}
+
+		cycles = append(cycles, cycle)
+		amountsSd = append(amountsSd, amountSd)
+		amountsEth = append(amountsEth, amountEth)
+		merkleProofs = append(merkleProofs, merkleProof)
+		totalSd.Add(totalSd, amountSd)
+		totalEth.Add(totalEth, amountEth)
+	}
+	if len(cycles) == 0 {
+		return nil
+	}
+
+	value := new(big.Int).Set(totalEth)
+	if totalSd.Sign() > 0 {
+		sdInEth, err := sd_collateral.ConvertSdToEth(sdc, totalSd, nil)
+		if err != nil {
+			return err
+		}
+		value.Add(value, sdInEth)
+	}
+
+	name := fmt.Sprintf("the Socializing Pool rewards of %d cycles (%s and %s)", len(cycles), eth.DisplayAmountInUnits(totalEth, "eth"), eth.DisplayAmountInUnits(totalSd, "sd"))
+	return t.claim("sp-rewards", name, value,
+		func(opts *bind.TransactOpts) (stader.GasInfo, error) {
+			return socializing_pool.EstimateClaimRewards(sp, cycles, amountsSd, amountsEth, merkleProofs, opts)
+		},
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return socializing_pool.ClaimRewards(sp, cycles, amountsSd, amountsEth, merkleProofs, opts)
+		})
+}
+
+// Claim the withdrawable balance of the operator rewards collector to the operator's reward address
+func (t *autoClaim) claimOperatorRewards() error {
+	orc, err := services.GetOperatorRewardsCollectorContract(t.c)
+	if err != nil {
+		return err
+	}
+
+	balance, err := node.GetOperatorRewardsCollectorBalance(orc, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	withdrawableInEth, err := node.WithdrawableInEth(orc, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	withdrawable := balance
+	if balance.Cmp(withdrawableInEth) > 0 {
+		withdrawable = withdrawableInEth
+	}
+	if withdrawable.Sign() == 0 {
+		return nil
+	}
+
+	return t.claim("operator-rewards", "the operator rewards collector", withdrawable,
+		func(opts *bind.TransactOpts) (stader.GasInfo, error) {
+			return node.EstimateClaimOperatorRewards(orc, opts)
+		},
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return node.ClaimOperatorRewards(orc, opts)
+		})
+}
+
+// Send a claim worth the given value (in ETH) if it is above the threshold and its gas cost is a small enough share of it
+func (t *autoClaim) claim(
+	kind string,
+	name string,
+	value *big.Int,
+	estimate func(opts *bind.TransactOpts) (stader.GasInfo, error),
+	send func(opts *bind.TransactOpts) (*types.Transaction, error),
+) error {
+	threshold := eth.EthToWei(t.cfg.Automation.AutoClaimThreshold.Value.(float64))
+	if value.Cmp(threshold) < 0 {
+		return nil
+	}
+
+	ec, err := services.GetEthClient(t.c)
+	if err != nil {
+		return err
+	}
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	gasInfo, err := estimate(opts)
+	if err != nil {
+		return fmt.Errorf("could not estimate the gas to claim %s: %w", name, err)
+	}
+	opts, err = getAutomationTransactor(t.cfg, t.w, ec, gasInfo, nil)
+	if errors.Is(err, errFeeTooHigh) {
+		t.log.Printlnf("Waiting to claim %s: %s.", name, err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Compare the expected gas cost to the claimed value, both in wei
+	cost, err := getExpectedTxCost(ec, opts, gasInfo)
+	if err != nil {
+		return err
+	}
+	maxGasShare := t.cfg.Automation.AutoClaimMaxGasShare.Value.(float64)
+	if cost.Cmp(getMaxGasCost(value, maxGasShare)) > 0 {
+		t.log.Printlnf("Waiting to claim %s worth %s: the gas would cost about %s, above %.2f%% of it.", name, eth.DisplayAmountInUnits(value, "eth"), eth.DisplayAmountInUnits(cost, "eth"), maxGasShare)
+		return nil
+	}
+
+	tx, err := send(opts)
+	if err != nil {
+		return err
+	}
+	t.log.Printlnf("Claiming %s worth %s in transaction %s.", name, eth.DisplayAmountInUnits(value, "eth"), tx.Hash().Hex())
+	if err := t.ledger.Record(automation.Action{Task: autoClaimTask, Kind: kind, Asset: "ETH", Amount: value, TxHash: tx.Hash(), Reason: fmt.Sprintf("claimed %s", name)}); err != nil {
+		t.log.Println(err)
+	}
+
+	receipt, err := utils.WaitForTransaction(ec, tx.Hash())
+	if err != nil {
+		return fmt.Errorf("error waiting for transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("the claim transaction %s failed", tx.Hash().Hex())
+	}
+	t.log.Printlnf("Claimed %s.", name)
+	return nil
+}
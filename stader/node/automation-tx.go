@@ -0,0 +1,92 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/utils/eth1"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Returned when the network fees are above the limits set for the daemon's own transactions
+var errFeeTooHigh = errors.New("network fees are above the configured limits")
+
+// Held by a task for as long as it sends transactions from the node account and waits for them, so tasks running in
+// their own loops never pick the same pending nonce and replace each other's transactions
+var automationTxLock sync.Mutex
+
+// Get a transactor for a transaction the daemon sends on its own. It uses the configured priority fee,
+// and the manual max fee or twice the base fee plus the priority fee, capped at maxFeeCap (nil for no cap).
+// Fails with errFeeTooHigh when the base fee is above the cap or the transaction could cost more than the Tx Fee Cap setting.
+func getAutomationTransactor(cfg *config.StaderConfig, w *wallet.Wallet, ec stader.ExecutionClient, gasInfo stader.GasInfo, maxFeeCap *big.Int) (*bind.TransactOpts, error) {
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	tip := eth.GweiToWei(cfg.StaderNode.PriorityFee.Value.(float64))
+	if tip == nil || tip.Sign() == 0 {
+		tip, err = ec.SuggestGasTipCap(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("could not get the suggested priority fee: %w", err)
+		}
+	}
+	baseFee, err := eth1.GetBaseFee(ec)
+	if err != nil {
+		return nil, err
+	}
+	maxFee := eth.GweiToWei(cfg.StaderNode.ManualMaxFee.Value.(float64))
+	if maxFee == nil || maxFee.Sign() == 0 {
+		maxFee = new(big.Int).Mul(baseFee, big.NewInt(2))
+		maxFee.Add(maxFee, tip)
+	}
+
+	if maxFeeCap != nil {
+		if new(big.Int).Add(baseFee, tip).Cmp(maxFeeCap) > 0 {
+			return nil, fmt.Errorf("%w: the base fee of %.2f gwei plus the priority fee is above the %.2f gwei limit", errFeeTooHigh, eth.WeiToGwei(baseFee), eth.WeiToGwei(maxFeeCap))
+		}
+		if maxFee.Cmp(maxFeeCap) > 0 {
+			maxFee = maxFeeCap
+		}
+	}
+	if tip.Cmp(maxFee) > 0 {
+		tip = maxFee
+	}
+
+	maxCost := new(big.Int).Mul(maxFee, new(big.Int).SetUint64(gasInfo.SafeGasLimit))
+	txFeeCap := eth.EthToWei(cfg.StaderNode.TxFeeCap.Value.(float64))
+	if txFeeCap.Sign() > 0 && maxCost.Cmp(txFeeCap) > 0 {
+		return nil, fmt.Errorf("%w: the transaction could cost up to %.6f ETH, above the Tx Fee Cap of %.6f ETH", errFeeTooHigh, eth.WeiToEth(maxCost), eth.WeiToEth(txFeeCap))
+	}
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = tip
+	opts.GasLimit = gasInfo.SafeGasLimit
+	return opts, nil
+}
+
+// The expected cost of a transaction sent with a transactor from getAutomationTransactor, at the current base fee
+func getExpectedTxCost(ec stader.ExecutionClient, opts *bind.TransactOpts, gasInfo stader.GasInfo) (*big.Int, error) {
+	baseFee, err := eth1.GetBaseFee(ec)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice := new(big.Int).Add(baseFee, opts.GasTipCap)
+	if gasPrice.Cmp(opts.GasFeeCap) > 0 {
+		gasPrice = opts.GasFeeCap
+	}
+	return gasPrice.Mul(gasPrice, new(big.Int).SetUint64(gasInfo.EstGasLimit)), nil
+}
+
+// The most gas a transaction moving the given value may cost, given a max share of that value in percent
+func getMaxGasCost(value *big.Int, maxGasShare float64) *big.Int {
+	return eth.EthToWei(eth.WeiToEth(value) * maxGasShare / 100)
+}
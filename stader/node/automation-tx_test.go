@@ -0,0 +1,157 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/passwords"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Serves a fixed base fee and suggested priority fee
+type fakeFeeClient struct {
+	stader.ExecutionClient
+
+	baseFee *big.Int
+	tip     *big.Int
+}
+
+func (f *fakeFeeClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: f.baseFee}, nil
+}
+
+func (f *fakeFeeClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return f.tip, nil
+}
+
+func gwei(value float64) *big.Int {
+	return eth.GweiToWei(value)
+}
+
+func newTestFeeConfig(priorityFee float64, manualMaxFee float64, txFeeCap float64) *config.StaderConfig {
+	cfg := &config.StaderConfig{StaderNode: &config.StaderNodeConfig{}}
+	cfg.StaderNode.PriorityFee.Value = priorityFee
+	cfg.StaderNode.ManualMaxFee.Value = manualMaxFee
+	cfg.StaderNode.TxFeeCap.Value = txFeeCap
+	return cfg
+}
+
+// A wallet whose node account is kept offline, so transactors can be made without a key
+func newTestWallet(t *testing.T) *wallet.Wallet {
+	dir := t.TempDir()
+	w, err := wallet.NewWallet(filepath.Join(dir, "wallet"), 1, nil, nil, 0, passwords.NewPasswordManager(filepath.Join(dir, "password")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.SetOfflineNodeAccount(common.HexToAddress("0x1000000000000000000000000000000000000001"))
+	return w
+}
+
+func TestGetAutomationTransactor(t *testing.T) {
+	gasInfo := stader.GasInfo{EstGasLimit: 80000, SafeGasLimit: 100000}
+
+	tests := []struct {
+		name         string
+		priorityFee  float64
+		manualMaxFee float64
+		txFeeCap     float64
+		baseFee      *big.Int
+		maxFeeCap    *big.Int
+		expectedTip  *big.Int
+		expectedMax  *big.Int
+		tooHigh      bool
+	}{
+		{"twice the base fee plus the tip", 2, 0, 0, gwei(10), nil, gwei(2), gwei(22), false},
+		{"suggested tip", 0, 0, 0, gwei(10), nil, gwei(1.5), gwei(21.5), false},
+		{"manual max fee", 2, 50, 0, gwei(10), nil, gwei(2), gwei(50), false},
+		{"max fee capped", 2, 0, 0, gwei(10), gwei(15), gwei(2), gwei(15), false},
+		{"tip capped at the max fee", 20, 10, 0, gwei(1), nil, gwei(10), gwei(10), false},
+		{"base fee above the cap", 2, 0, 0, gwei(14), gwei(15), nil, nil, true},
+		{"within the Tx Fee Cap", 2, 0, 0.0022, gwei(10), nil, gwei(2), gwei(22), false},
+		{"above the Tx Fee Cap", 2, 0, 0.002, gwei(10), nil, nil, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestFeeConfig(test.priorityFee, test.manualMaxFee, test.txFeeCap)
+			ec := &fakeFeeClient{baseFee: test.baseFee, tip: gwei(1.5)}
+
+			opts, err := getAutomationTransactor(cfg, newTestWallet(t), ec, gasInfo, test.maxFeeCap)
+			if test.tooHigh {
+				if !errors.Is(err, errFeeTooHigh) {
+					t.Fatalf("expected errFeeTooHigh, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if opts.GasTipCap.Cmp(test.expectedTip) != 0 || opts.GasFeeCap.Cmp(test.expectedMax) != 0 {
+				t.Errorf("expected a tip of %s and a max fee of %s, got %s and %s", test.expectedTip, test.expectedMax, opts.GasTipCap, opts.GasFeeCap)
+			}
+			if opts.GasLimit != gasInfo.SafeGasLimit {
+				t.Errorf("expected a gas limit of %d, got %d", gasInfo.SafeGasLimit, opts.GasLimit)
+			}
+		})
+	}
+}
+
+func TestGetExpectedTxCost(t *testing.T) {
+	gasInfo := stader.GasInfo{EstGasLimit: 80000, SafeGasLimit: 100000}
+	cfg := newTestFeeConfig(2, 0, 0)
+
+	opts, err := getAutomationTransactor(cfg, newTestWallet(t), &fakeFeeClient{baseFee: gwei(10)}, gasInfo, gwei(15))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// base fee plus tip, at the estimated gas
+	cost, err := getExpectedTxCost(&fakeFeeClient{baseFee: gwei(10)}, opts, gasInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := new(big.Int).Mul(gwei(12), big.NewInt(80000)); cost.Cmp(expected) != 0 {
+		t.Errorf("expected a cost of %s, got %s", expected, cost)
+	}
+
+	// never more than the max fee
+	cost, err = getExpectedTxCost(&fakeFeeClient{baseFee: gwei(20)}, opts, gasInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := new(big.Int).Mul(gwei(15), big.NewInt(80000)); cost.Cmp(expected) != 0 {
+		t.Errorf("expected a cost of %s, got %s", expected, cost)
+	}
+}
+
+func TestGetMaxGasCost(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       *big.Int
+		maxGasShare float64
+		expected    *big.Int
+	}{
+		{"ten percent", eth.EthToWei(1), 10, eth.EthToWei(0.1)},
+		{"fraction of a percent", eth.EthToWei(2), 0.5, eth.EthToWei(0.01)},
+		{"whole value", eth.EthToWei(0.3), 100, eth.EthToWei(0.3)},
+		{"no share", eth.EthToWei(1), 0, big.NewInt(0)},
+		{"no value", big.NewInt(0), 10, big.NewInt(0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if cost := getMaxGasCost(test.value, test.maxGasShare); cost.Cmp(test.expected) != 0 {
+				t.Errorf("expected %s, got %s", test.expected, cost)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package node
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/indexer"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+)
+
+// Config
+var eventIndexerInterval, _ = time.ParseDuration("5m")
+var eventIndexerCatchUpCooldown, _ = time.ParseDuration("5s")
+
+// Block ranges indexed per run, so the database is released regularly for the API while catching up
+const eventIndexerChunksPerRun = 20
+
+// Index events task
+type indexEvents struct {
+	c           *cli.Context
+	log         log.ColorLogger
+	cfg         *config.StaderConfig
+	nodeAddress common.Address
+}
+
+// Create index events task
+func newIndexEvents(c *cli.Context, logger log.ColorLogger, nodeAddress common.Address) (*indexEvents, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexEvents{
+		c:           c,
+		log:         logger,
+		cfg:         cfg,
+		nodeAddress: nodeAddress,
+	}, nil
+}
+
+// Index the operator's new events; returns true once the index has caught up with the chain
+func (t *indexEvents) run() (bool, error) {
+	ec, err := services.GetEthClient(t.c)
+	if err != nil {
+		return false, err
+	}
+
+	store, err := indexer.OpenStore(t.cfg.StaderNode.GetEventsDbPath(true), false)
+	if err != nil {
+		return false, err
+	}
+	defer store.Close()
+
+	indexedBlock, err := store.GetIndexedBlock()
+	if err != nil {
+		return false, err
+	}
+	indexedEvents, err := store.GetEvents(0, indexedBlock)
+	if err != nil {
+		return false, err
+	}
+	sources, err := indexer.GetOperatorSources(t.c, t.nodeAddress, indexer.GetPastRewardAddresses(indexedEvents))
+	if err != nil {
+		return false, err
+	}
+
+	caughtUp, err := indexer.NewIndexer(ec, store, sources, t.cfg.StaderNode.GetEventIndexerStartBlock()).Run(eventIndexerChunksPerRun)
+	if err != nil {
+		return false, err
+	}
+
+	newIndexedBlock, err := store.GetIndexedBlock()
+	if err != nil {
+		return false, err
+	}
+	if newIndexedBlock != indexedBlock {
+		t.log.Printlnf("Indexed events up to block %d.", newIndexedBlock)
+	}
+	return caughtUp, nil
+}
+
+// The delay before the next run; short while the index is catching up
+func (t *indexEvents) nextRunDelay(caughtUp bool) time.Duration {
+	if caughtUp {
+		return eventIndexerInterval
+	}
+	return eventIndexerCatchUpCooldown
+}
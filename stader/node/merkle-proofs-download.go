@@ -2,6 +2,7 @@ package node
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/mitchellh/go-homedir"
 	"github.com/stader-labs/stader-node/shared/services"
@@ -49,6 +50,11 @@ func (m *MerkleProofsDownloader) run() error {
 		return err
 	}
 
+	sp, err := services.GetSocializingPoolContract(m.c)
+	if err != nil {
+		return err
+	}
+
 	allMerkleProofs, err := stader.GetAllMerkleProofsForOperator(m.c, nodeAccount.Address)
 	if err != nil {
 		return err
@@ -63,12 +69,44 @@ func (m *MerkleProofsDownloader) run() error {
 			return err
 		}
 
-		_, err = os.Stat(cycleMerkleProofFile)
-		if !os.IsNotExist(err) && err != nil {
+		existingMerkleProof, exists, err := m.cfg.StaderNode.ReadCycleCache(cycleMerkleProof.Cycle)
+		if errors.Is(err, config.ErrCorruptCycleCache) {
+			m.log.Printlnf("Existing merkle proof for cycle %d could not be read: %s", cycleMerkleProof.Cycle, err.Error())
+			quarantinePath, err := stader.QuarantineCorruptCycleMerkleProofs(m.cfg, cycleMerkleProof.Cycle)
+			if err != nil {
+				return err
+			}
+			m.log.Printlnf("Moved the existing merkle proof for cycle %d to %s", cycleMerkleProof.Cycle, quarantinePath)
+			exists = false
+		} else if err != nil {
 			return err
 		}
-		if !os.IsNotExist(err) {
-			m.log.Printlnf("Merkle proof for cycle %d already exists, skipping", cycleMerkleProof.Cycle)
+		if exists {
+			err = stader.VerifyCycleMerkleProofs(sp, nodeAccount.Address, &existingMerkleProof)
+			if err == nil || errors.Is(err, stader.ErrMerkleRootNotReported) {
+				m.log.Printlnf("Merkle proof for cycle %d already exists, skipping", cycleMerkleProof.Cycle)
+				continue
+			}
+			m.log.Printlnf("Existing merkle proof for cycle %d failed verification: %s", cycleMerkleProof.Cycle, err.Error())
+			quarantinePath, err := stader.QuarantineCycleMerkleProofs(m.cfg, &existingMerkleProof)
+			if err != nil {
+				return err
+			}
+			m.log.Printlnf("Moved the existing merkle proof for cycle %d to %s", cycleMerkleProof.Cycle, quarantinePath)
+		}
+
+		err = stader.VerifyCycleMerkleProofs(sp, nodeAccount.Address, cycleMerkleProof)
+		if errors.Is(err, stader.ErrMerkleRootNotReported) {
+			m.log.Printlnf("Merkle root for cycle %d is not on chain yet, skipping", cycleMerkleProof.Cycle)
+			continue
+		}
+		if err != nil {
+			m.log.Printlnf("Downloaded merkle proof for cycle %d failed verification: %s", cycleMerkleProof.Cycle, err.Error())
+			quarantinePath, err := stader.QuarantineCycleMerkleProofs(m.cfg, cycleMerkleProof)
+			if err != nil {
+				return err
+			}
+			m.log.Printlnf("Quarantined the downloaded merkle proof for cycle %d to %s", cycleMerkleProof.Cycle, quarantinePath)
 			continue
 		}
 
@@ -80,6 +118,7 @@ func (m *MerkleProofsDownloader) run() error {
 
 		encoder := json.NewEncoder(file)
 		err = encoder.Encode(cycleMerkleProof)
+		file.Close()
 		if err != nil {
 			return fmt.Errorf("error encoding JSON: %v", err)
 		}
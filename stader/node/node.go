@@ -20,7 +20,6 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package node
 
 import (
-	"crypto/ecdsa"
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
@@ -28,30 +27,23 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/accounts"
-	eCryto "github.com/ethereum/go-ethereum/crypto"
-
 	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
 	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
-	"github.com/stader-labs/stader-node/shared/utils/crypto"
-	"github.com/stader-labs/stader-node/shared/utils/eth2"
 	"github.com/stader-labs/stader-node/shared/utils/stader"
-	"github.com/stader-labs/stader-node/shared/utils/stdr"
-	"github.com/stader-labs/stader-node/shared/utils/validator"
 	"github.com/stader-labs/stader-node/stader-lib/node"
 	stader_lib "github.com/stader-labs/stader-node/stader-lib/stader"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
 
 	"github.com/fatih/color"
 	"github.com/urfave/cli"
 
 	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/automation"
 	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/presign"
 	"github.com/stader-labs/stader-node/shared/services/wallet"
 	"github.com/stader-labs/stader-node/shared/utils/log"
 )
@@ -68,6 +60,11 @@ const (
 	MaxConcurrentEth1Requests   = 200
 	ManageFeeRecipientColor     = color.FgHiCyan
 	MerkleProofsDownloaderColor = color.FgHiBlue
+	IndexEventsColor            = color.FgHiMagenta
+	ProtectSdCollateralColor    = color.FgHiYellow
+	AutoClaimColor              = color.FgYellow
+	SweepClRewardsColor         = color.FgCyan
+	ReconcileTransactionsColor  = color.FgBlue
 	ErrorColor                  = color.FgRed
 	InfoColor                   = color.FgHiGreen
 	blocksPerThreeEpoch         = 96
@@ -139,18 +136,51 @@ func run(c *cli.Context) error {
 		return err
 	}
 
+	indexEvents, err := newIndexEvents(c, log.NewColorLogger(IndexEventsColor), nodeAccount.Address)
+	if err != nil {
+		return err
+	}
+
 	// Initialize loggers
 	errorLog := log.NewColorLogger(ErrorColor)
 	infoLog := log.NewColorLogger(InfoColor)
 
-	publicKey, err := stader.GetPublicKey(c)
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	presignLedger, err := presign.LoadLedger(cfg.StaderNode.GetPresignLedgerPath(true))
+	if err != nil {
+		return err
+	}
+	presignExitMessages, err := newPresignExitMessages(c, infoLog, errorLog, nodeAccount.Address, presignLedger)
+	if err != nil {
+		return err
+	}
+	automationLedger, err := automation.LoadLedger(cfg.StaderNode.GetAutomationLedgerPath(true))
+	if err != nil {
+		return err
+	}
+	protectSdCollateral, err := newProtectSdCollateral(c, log.NewColorLogger(ProtectSdCollateralColor), nodeAccount.Address, automationLedger)
+	if err != nil {
+		return err
+	}
+	autoClaim, err := newAutoClaim(c, log.NewColorLogger(AutoClaimColor), nodeAccount.Address, automationLedger)
+	if err != nil {
+		return err
+	}
+	sweepClRewards, err := newSweepClRewards(c, log.NewColorLogger(SweepClRewardsColor), nodeAccount.Address, automationLedger)
+	if err != nil {
+		return err
+	}
+	reconcileTransactions, err := newReconcileTransactions(c, log.NewColorLogger(ReconcileTransactionsColor))
 	if err != nil {
 		return err
 	}
 
 	// Wait group to handle the various threads
 	wg := new(sync.WaitGroup)
-	wg.Add(4)
+	wg.Add(9)
 
 	// validator presigned loop
 	go func() {
@@ -169,181 +199,10 @@ func run(c *cli.Context) error {
 				}
 			}
 
-			cfg, err := services.GetConfig(c)
-			if err != nil {
-				errorLog.Printf("Failed to get config with error %s\n", err.Error())
-				continue
-			}
-
-			network, ok := cfg.StaderNode.Network.Value.(cfgtypes.Network)
-			if !ok {
-				errorLog.Printf("Failed to get network from config: %s\n", cfg.StaderNode.Network.Value)
-				continue
-			}
-
-			operatorId, err := node.GetOperatorId(pnr, nodeAccount.Address, nil)
-			if err != nil {
-				errorLog.Printf("Failed to get operator id: %s\n", err.Error())
-				continue
-			}
-
-			// make a map of all validators actually registered with stader
-			// user might just move the validator keys to the directory. we don't wanna send the presigned msg of them
-			infoLog.Println("Building a map of user validators registered with stader")
-			registeredValidators, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(pnr, operatorId, nodeAccount.Address, nil)
-			if err != nil {
-				errorLog.Printf("Could not get all validators registered with operator %s with error %s\n", operatorId, err.Error())
-				continue
-			}
-
-			infoLog.Printlnf("Found %d validators registered with operator %s", len(registeredValidators), operatorId)
-			infoLog.Println("Starting a pass of the presign daemon!")
-
-			currentHead, err := bc.GetBeaconHead()
-			if err != nil {
-				errorLog.Printf("Could not get beacon head with error %s\n", err.Error())
-				continue
-			}
-
-			err = w.Reload()
-			if err != nil {
-				errorLog.Printf("Could not reload wallet: %s\n", err.Error())
-				continue
-			}
-
-			preSignRegisteredMap, err := stader.BulkIsPresignedKeyRegistered(c, validatorPubKeys)
-			if err != nil {
-				errorLog.Printf("Could not bulk check presigned keys with error %s\n", err.Error())
-				continue
-			}
-
-			pageNumber := 0
-			pageSize := 5
-			for {
-				startIndex := pageNumber * pageSize
-				if startIndex > len(validatorPubKeys) {
-					break
-				}
-				endIndex := (pageNumber + 1) * pageSize
-				if endIndex > len(validatorPubKeys) {
-					endIndex = len(validatorPubKeys)
-				}
-				infoLog.Printf("Starting index: %d, End index: %d\n", startIndex, endIndex)
-
-				validatorKeyBatch := validatorPubKeys[startIndex:endIndex]
-				infoLog.Printf("Checking %d validator keys\n", len(validatorKeyBatch))
-
-				preSignSendMessages := []stader_backend.PreSignSendApiRequestType{}
-
-				for _, validatorPubKey := range validatorKeyBatch {
-					infoLog.Printf("Checking validator pubkey %s\n", validatorPubKey.String())
-					validatorKeyPair, err := w.GetValidatorKeyByPubkey(validatorPubKey)
-					// log the errors and continue. dont need to sleep post an error
-					if err != nil {
-						errorLog.Printf("Could not find validator private key for %s with err: %s\n", validatorPubKey, err.Error())
-						continue
-					}
-
-					validatorInfo, ok := registeredValidators[validatorPubKey]
-					if !ok {
-						errorLog.Printf("Validator pub key: %s not found in stader contracts\n", validatorPubKey)
-						continue
-					}
-					if stdr.IsValidatorTerminal(validatorInfo) {
-						errorLog.Printf("Validator pub key: %s is in terminal state in the stader contracts\n", validatorPubKey)
-						continue
-					}
-
-					registeredPresign, ok := preSignRegisteredMap[validatorPubKey.String()]
-					if !ok {
-						errorLog.Printf("Could not query presign api to check if validator: %s is registered\n", validatorPubKey)
-						continue
-					}
-					if registeredPresign {
-						infoLog.Printf("Validator pub key: %s pre signed key already registered\n", validatorPubKey)
-						continue
-					} else {
-						infoLog.Printf("Validator pub key: %s pre signed key not registered. Creating presigned message\n", validatorPubKey)
-					}
-
-					// check if validator has not yet been registered on beacon chain
-					validatorStatus, err := bc.GetValidatorStatus(validatorPubKey, nil)
-					if err != nil {
-						errorLog.Printf("Error finding validator status for validator: %s with err: %s\n", validatorPubKey, err.Error())
-						continue
-					}
-					if !validatorStatus.Exists {
-						errorLog.Printf("Validator pub key: %s not found on beacon chain\n", validatorPubKey)
-						continue
-					}
-
-					// check if validator is already in an exiting phase, then no point sending a pre-signed message
-					if eth2.IsValidatorExiting(validatorStatus) {
-						errorLog.Printf("Validator pub key: %s already exiting or exited with status %s", validatorPubKey, validatorStatus.Status)
-						continue
-					}
-
-					exitEpoch := currentHead.Epoch
-
-					signatureDomain, err := bc.GetExitDomainData(eth2types.DomainVoluntaryExit[:], network)
-					if err != nil {
-						errorLog.Printf("Failed to get the signature domain from beacon chain with err: %s\n", err.Error())
-						continue
-					}
-
-					// get the presigned msg
-					exitSignature, _, err := validator.GetSignedExitMessage(validatorKeyPair, validatorStatus.Index, exitEpoch, signatureDomain)
-					if err != nil {
-						errorLog.Printf("Failed to generate the SignedExitMessage for validator with beacon chain index: %d with err: %s\n", validatorStatus.Index, err.Error())
-						continue
-					}
-
-					// encrypt the signature and srHash
-					exitSignatureEncrypted, err := crypto.EncryptUsingPublicKey([]byte(exitSignature.String()), publicKey)
-					if err != nil {
-						errorLog.Printf("Failed to encrypt exit signature for validator: %s with err: %s\n", validatorPubKey, err.Error())
-						continue
-					}
-					exitSignatureEncryptedString := crypto.EncodeBase64(exitSignatureEncrypted)
-
-					// send it to the presigned api
-					preSignSendMessages = append(preSignSendMessages, stader_backend.PreSignSendApiRequestType{
-						Message: struct {
-							Epoch          string `json:"epoch"`
-							ValidatorIndex string `json:"validator_index"`
-						}{
-							Epoch:          strconv.FormatUint(exitEpoch, 10),
-							ValidatorIndex: strconv.FormatUint(validatorStatus.Index, 10),
-						},
-						Signature:          exitSignatureEncryptedString,
-						ValidatorPublicKey: validatorPubKey.String(),
-					})
-				}
-
-				//fmt.Printf("Sending %d presigned messages to stader backend\n", len(preSignSendMessages))
-				fmt.Printf("Sending %d presigned messages to stader backend\n", len(preSignSendMessages))
-				//fmt.Printf("Pre-sign messages being sent are %v\n", preSignSendMessages)
-				if len(preSignSendMessages) > 0 {
-					res, err := stader.SendBulkPresignedMessageToStaderBackend(c, preSignSendMessages)
-					if err != nil {
-						errorLog.Printf("Sending bulk presigned message failed with %v\n", err.Error())
-					} else {
-						for pubKey, response := range *res {
-							if response.Success {
-								infoLog.Printf("Successfully sent the presigned message for validator: %s\n", pubKey)
-							} else {
-								errorLog.Printf("Failed to send the presigned api for validator: %s with err: %s\n", pubKey, response.Error)
-							}
-						}
-					}
-				}
-
-				pageNumber += 1
+			if err := presignExitMessages.run(); err != nil {
+				errorLog.Println(err)
 			}
-
-			infoLog.Printf("Done with the pass of presign daemon")
-			// run loop every 12 hours
-			time.Sleep(preSignedCooldown)
+			time.Sleep(presignExitMessages.nextRunDelay())
 		}
 
 		wg.Done()
@@ -400,23 +259,94 @@ func run(c *cli.Context) error {
 		wg.Done()
 	}()
 
+	// Event indexer loop
 	go func() {
-		defer wg.Done()
+		for {
+			caughtUp := false
+			// Check the EC status
+			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
+			if err != nil {
+				errorLog.Println(err)
+			} else {
+				caughtUp, err = indexEvents.run()
+				if err != nil {
+					errorLog.Println(err)
+					caughtUp = true
+				}
+			}
+			time.Sleep(indexEvents.nextRunDelay(caughtUp))
+		}
+		wg.Done()
+	}()
 
+	// SD collateral protection loop
+	go func() {
 		for {
+			// Check the EC status
+			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
+			if err != nil {
+				errorLog.Println(err)
+			} else if err := protectSdCollateral.run(); err != nil {
+				errorLog.Println(err)
+			}
+			time.Sleep(sdProtectionInterval)
+		}
+		wg.Done()
+	}()
 
-			infoLog.Println("Start checking node diversity metrics")
+	// Auto claim loop
+	go func() {
+		for {
 			// Check the EC status
 			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
 				errorLog.Println(err)
-				time.Sleep(nodeDiversityTrackerCooldown)
+			} else if err := autoClaim.run(); err != nil {
+				errorLog.Println(err)
+			}
+			time.Sleep(autoClaimInterval)
+		}
+		wg.Done()
+	}()
 
-				continue
+	// CL rewards sweep loop
+	go func() {
+		for {
+			// Check the EC status
+			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
+			if err != nil {
+				errorLog.Println(err)
+			} else if err := sweepClRewards.run(); err != nil {
+				errorLog.Println(err)
 			}
+			time.Sleep(clRewardsSweepInterval)
+		}
+		wg.Done()
+	}()
 
-			// Check the BC status
-			err = services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
+	// Transaction journal loop
+	go func() {
+		for {
+			// Check the EC status
+			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
+			if err != nil {
+				errorLog.Println(err)
+			} else if err := reconcileTransactions.run(); err != nil {
+				errorLog.Println(err)
+			}
+			time.Sleep(reconcileTransactionsInterval)
+		}
+		wg.Done()
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for {
+
+			infoLog.Println("Start checking node diversity metrics")
+			// Check the EC status
+			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(nodeDiversityTrackerCooldown)
@@ -424,9 +354,10 @@ func run(c *cli.Context) error {
 				continue
 			}
 
-			privateKey, err := w.GetNodePrivateKey()
+			// Check the BC status
+			err = services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
 			if err != nil {
-				errorLog.Printlnf("Error GetNodePrivateKey %+v", err)
+				errorLog.Println(err)
 				time.Sleep(nodeDiversityTrackerCooldown)
 
 				continue
@@ -450,7 +381,10 @@ func run(c *cli.Context) error {
 				continue
 			}
 
-			request, err := makeNodeDiversityRequest(message, privateKey)
+			// Signed by the node wallet, or by the external signer holding the node account key
+			request, err := makeSignedNodeDiversityRequest(message, func(message []byte) ([]byte, error) {
+				return w.SignMessage(string(message))
+			})
 			if err != nil {
 				errorLog.Printlnf("Error makesNodeDiversityRequest %+v", err)
 				time.Sleep(nodeDiversityTrackerCooldown)
@@ -563,15 +497,14 @@ func makeNodeDiversityMessage(
 	return &message, nil
 }
 
-func makeNodeDiversityRequest(msg *stader_backend.NodeDiversity, privateKey *ecdsa.PrivateKey) (*stader_backend.NodeDiversityRequest, error) {
+// Make the request with a signature of the message as text, made by the node account
+func makeSignedNodeDiversityRequest(msg *stader_backend.NodeDiversity, signText func(message []byte) ([]byte, error)) (*stader_backend.NodeDiversityRequest, error) {
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		return nil, err
 	}
 
-	msgHashed := accounts.TextHash(msgBytes)
-
-	signedMessage, err := eCryto.Sign(msgHashed, privateKey)
+	signedMessage, err := signText(msgBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func TestVerifySignature(t *testing.T) {
 
 	pubkeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
 
-	req, err := makeNodeDiversityRequest(&stader_backend.NodeDiversity{
+	req, err := makeSignedNodeDiversityRequest(&stader_backend.NodeDiversity{
 		ExecutionClient:      ExecutionClient,
 		ConsensusClient:      ConsensusClient,
 		ValidatorClient:      ValidatorClient,
@@ -44,7 +44,7 @@ func TestVerifySignature(t *testing.T) {
 		NodeAddress:          crypto.PubkeyToAddress(*publicKeyECDSA).String(),
 		NodePublicKey:        hex.EncodeToString(pubkeyBytes),
 		Relays:               "ultrasound,aestus",
-	}, privateKey)
+	}, signWithKey(privateKey))
 	if err != nil {
 		t.Error(err)
 	}
@@ -87,7 +87,7 @@ func TestVerifySignatureFailed(t *testing.T) {
 		NodePublicKey:        hex.EncodeToString(publickeyBytes),
 	}
 
-	req, err := makeNodeDiversityRequest(&msg, privateKeyFake)
+	req, err := makeSignedNodeDiversityRequest(&msg, signWithKey(privateKeyFake))
 	if err != nil {
 		t.Error(err)
 	}
@@ -99,6 +99,13 @@ func TestVerifySignatureFailed(t *testing.T) {
 	}
 }
 
+// Sign a message as text with the given key, like the node wallet does
+func signWithKey(privateKey *ecdsa.PrivateKey) func(message []byte) ([]byte, error) {
+	return func(message []byte) ([]byte, error) {
+		return crypto.Sign(accounts.TextHash(message), privateKey)
+	}
+}
+
 func verifySignature(t *testing.T, msg *stader_backend.NodeDiversity, signEncoded string) bool {
 	t.Helper()
 
@@ -0,0 +1,423 @@
+package node
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/beacon"
+	"github.com/stader-labs/stader-node/shared/services/presign"
+	"github.com/stader-labs/stader-node/shared/services/signer"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	cfgtypes "github.com/stader-labs/stader-node/shared/types/config"
+	stader_backend "github.com/stader-labs/stader-node/shared/types/stader-backend"
+	"github.com/stader-labs/stader-node/shared/utils/crypto"
+	"github.com/stader-labs/stader-node/shared/utils/eth2"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/stader"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/shared/utils/validator"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	stader_lib "github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/types"
+)
+
+// Config
+var presignMinBackoff, _ = time.ParseDuration("1m")
+
+const presignSigningWorkers = 8
+
+// Presign exit messages task
+type presignExitMessages struct {
+	c           *cli.Context
+	infoLog     log.ColorLogger
+	errorLog    log.ColorLogger
+	w           *wallet.Wallet
+	signer      signer.Signer
+	pnr         *stader_lib.PermissionlessNodeRegistryContractManager
+	bc          beacon.Client
+	publicKey   *rsa.PublicKey
+	ledger      *presign.Ledger
+	nodeAddress common.Address
+
+	// The voluntary exit fork is pinned to Capella since Deneb (EIP-7044), so it only changes with the network
+	exitForkInfo        *signer.ForkInfo
+	exitForkInfoNetwork cfgtypes.Network
+
+	backoff time.Duration
+}
+
+// A key that needs a fresh exit message
+type presignCandidate struct {
+	pubkey types.ValidatorPubkey
+	index  uint64
+}
+
+// Messages sent to the backend in one request
+type presignPage struct {
+	messages []stader_backend.PreSignSendApiRequestType
+	resend   bool
+}
+
+// Create presign exit messages task
+func newPresignExitMessages(c *cli.Context, infoLog log.ColorLogger, errorLog log.ColorLogger, nodeAddress common.Address, ledger *presign.Ledger) (*presignExitMessages, error) {
+
+	// Get services
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	validatorSigner, err := services.GetValidatorSigner(c)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := stader.GetPublicKey(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &presignExitMessages{
+		c:           c,
+		infoLog:     infoLog,
+		errorLog:    errorLog,
+		w:           w,
+		signer:      validatorSigner,
+		pnr:         pnr,
+		bc:          bc,
+		publicKey:   publicKey,
+		ledger:      ledger,
+		nodeAddress: nodeAddress,
+	}, nil
+
+}
+
+// How long to wait before the next pass; backend failures back off exponentially up to the regular cooldown
+func (t *presignExitMessages) nextRunDelay() time.Duration {
+	if t.backoff > 0 {
+		return t.backoff
+	}
+	return preSignedCooldown
+}
+
+func (t *presignExitMessages) recordBackendResult(err error) {
+	if err == nil {
+		t.backoff = 0
+		return
+	}
+	if t.backoff == 0 {
+		t.backoff = presignMinBackoff
+	} else {
+		t.backoff *= 2
+	}
+	if t.backoff > preSignedCooldown {
+		t.backoff = preSignedCooldown
+	}
+	t.errorLog.Printlnf("Stader backend request failed, retrying the presign pass in %s", t.backoff)
+}
+
+// Run a pass of the presign daemon
+func (t *presignExitMessages) run() error {
+
+	cfg, err := services.GetConfig(t.c)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+	network, ok := cfg.StaderNode.Network.Value.(cfgtypes.Network)
+	if !ok {
+		return fmt.Errorf("failed to get network from config: %s", cfg.StaderNode.Network.Value)
+	}
+
+	operatorId, err := node.GetOperatorId(t.pnr, t.nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get operator id: %w", err)
+	}
+
+	// make a map of all validators actually registered with stader
+	// user might just move the validator keys to the directory. we don't wanna send the presigned msg of them
+	t.infoLog.Println("Building a map of user validators registered with stader")
+	registeredValidators, validatorPubKeys, err := stdr.GetAllValidatorsRegisteredWithOperator(t.pnr, operatorId, t.nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("could not get all validators registered with operator %s: %w", operatorId, err)
+	}
+
+	t.infoLog.Printlnf("Found %d validators registered with operator %s", len(registeredValidators), operatorId)
+	t.infoLog.Println("Starting a pass of the presign daemon!")
+	pass := presign.PassInfo{StartedAt: time.Now().Unix()}
+	defer func() {
+		pass.FinishedAt = time.Now().Unix()
+		t.ledger.SetLastPass(pass)
+		if err := t.ledger.Save(); err != nil {
+			t.errorLog.Printlnf("Could not save the presign ledger: %s", err.Error())
+		}
+	}()
+
+	currentHead, err := t.bc.GetBeaconHead()
+	if err != nil {
+		return fmt.Errorf("could not get beacon head: %w", err)
+	}
+
+	if err := t.w.Reload(); err != nil {
+		return fmt.Errorf("could not reload wallet: %w", err)
+	}
+	if cfg.RemoteSigner.GetUrl() == "" && !t.w.IsInitialized() {
+		return fmt.Errorf("the validator keys are derived from the node wallet's mnemonic, which has not been set up on this machine")
+	}
+
+	preSignRegisteredMap, err := stader.BulkIsPresignedKeyRegistered(t.c, validatorPubKeys)
+	t.recordBackendResult(err)
+	if err != nil {
+		pass.BackendError = err.Error()
+		return fmt.Errorf("could not bulk check presigned keys: %w", err)
+	}
+
+	// work out which keys still need a message, reusing ones signed in an earlier pass
+	resends := []stader_backend.PreSignSendApiRequestType{}
+	unsignedKeys := []types.ValidatorPubkey{}
+	for _, validatorPubKey := range validatorPubKeys {
+		pass.KeysChecked++
+
+		validatorInfo := registeredValidators[validatorPubKey]
+		if stdr.IsValidatorTerminal(validatorInfo) {
+			continue
+		}
+
+		registeredPresign, ok := preSignRegisteredMap[validatorPubKey.String()]
+		if !ok {
+			t.errorLog.Printlnf("Could not query presign api to check if validator: %s is registered", validatorPubKey)
+			continue
+		}
+		if registeredPresign {
+			t.ledger.RecordAcknowledged(validatorPubKey.String())
+			continue
+		}
+
+		if t.ledger.HasPendingMessage(validatorPubKey.String()) {
+			entry, _ := t.ledger.Get(validatorPubKey.String())
+			resends = append(resends, newPreSignSendMessage(validatorPubKey.String(), entry.ValidatorIndex, entry.ExitEpoch, entry.EncryptedSignature))
+			continue
+		}
+		unsignedKeys = append(unsignedKeys, validatorPubKey)
+	}
+	t.infoLog.Printlnf("%d validators need a new presigned message and %d signed messages are waiting to be resent", len(unsignedKeys), len(resends))
+
+	candidates, err := t.getCandidates(unsignedKeys)
+	if err != nil {
+		return err
+	}
+
+	var forkInfo signer.ForkInfo
+	if len(candidates) > 0 {
+		forkInfo, err = t.getExitForkInfo(network)
+		if err != nil {
+			return err
+		}
+	}
+
+	// sign pages in the background while the previous page is being sent
+	batchSize := int(cfg.StaderNode.GetPresignBatchSize())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pages := make(chan presignPage, 1)
+	go func() {
+		defer close(pages)
+		for start := 0; start < len(resends); start += batchSize {
+			end := min(start+batchSize, len(resends))
+			select {
+			case pages <- presignPage{messages: resends[start:end], resend: true}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		for start := 0; start < len(candidates); start += batchSize {
+			end := min(start+batchSize, len(candidates))
+			page := presignPage{messages: t.signPage(candidates[start:end], currentHead.Epoch, forkInfo)}
+			select {
+			case pages <- page:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for page := range pages {
+		if len(page.messages) == 0 {
+			continue
+		}
+		if !page.resend {
+			pass.KeysSigned += uint64(len(page.messages))
+		}
+		t.infoLog.Printlnf("Sending %d presigned messages to stader backend", len(page.messages))
+		sent, err := t.sendPage(page.messages)
+		pass.KeysSent += sent
+		t.recordBackendResult(err)
+		if err != nil {
+			// leave the rest for the next pass, anything already signed stays pending in the ledger
+			pass.BackendError = err.Error()
+			cancel()
+			for range pages {
+			}
+			return fmt.Errorf("sending bulk presigned message failed: %w", err)
+		}
+		if err := t.ledger.Save(); err != nil {
+			t.errorLog.Printlnf("Could not save the presign ledger: %s", err.Error())
+		}
+	}
+	t.infoLog.Println("Done with the pass of presign daemon")
+	return nil
+
+}
+
+// Look up the private keys and beacon state of the keys to sign, with a single beacon request
+func (t *presignExitMessages) getCandidates(validatorPubKeys []types.ValidatorPubkey) ([]presignCandidate, error) {
+	if len(validatorPubKeys) == 0 {
+		return nil, nil
+	}
+
+	validatorStatuses, err := t.bc.GetValidatorStatuses(validatorPubKeys, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator statuses: %w", err)
+	}
+
+	hasKeys, err := t.signer.HasKeys(validatorPubKeys)
+	if err != nil {
+		return nil, fmt.Errorf("could not check the validator keys of the signer: %w", err)
+	}
+
+	candidates := []presignCandidate{}
+	for _, validatorPubKey := range validatorPubKeys {
+		validatorStatus, ok := validatorStatuses[validatorPubKey]
+		if !ok || !validatorStatus.Exists {
+			t.errorLog.Printlnf("Validator pub key: %s not found on beacon chain", validatorPubKey)
+			continue
+		}
+		// check if validator is already in an exiting phase, then no point sending a pre-signed message
+		if eth2.IsValidatorExiting(validatorStatus) {
+			t.errorLog.Printlnf("Validator pub key: %s already exiting or exited with status %s", validatorPubKey, validatorStatus.Status)
+			continue
+		}
+
+		if !hasKeys[validatorPubKey] {
+			t.errorLog.Printlnf("Could not find validator private key for %s in the wallet or remote signer", validatorPubKey)
+			continue
+		}
+
+		candidates = append(candidates, presignCandidate{
+			pubkey: validatorPubKey,
+			index:  validatorStatus.Index,
+		})
+	}
+
+	return candidates, nil
+}
+
+func (t *presignExitMessages) getExitForkInfo(network cfgtypes.Network) (signer.ForkInfo, error) {
+	if t.exitForkInfo != nil && t.exitForkInfoNetwork == network {
+		return *t.exitForkInfo, nil
+	}
+
+	eth2Config, err := t.bc.GetEth2Config()
+	if err != nil {
+		return signer.ForkInfo{}, fmt.Errorf("failed to get the eth2 config from beacon chain: %w", err)
+	}
+	forkInfo, err := validator.GetExitForkInfo(eth2Config, network)
+	if err != nil {
+		return signer.ForkInfo{}, err
+	}
+	t.exitForkInfo = &forkInfo
+	t.exitForkInfoNetwork = network
+	return forkInfo, nil
+}
+
+// Sign and encrypt the exit messages of a page on a bounded worker pool
+func (t *presignExitMessages) signPage(candidates []presignCandidate, exitEpoch uint64, forkInfo signer.ForkInfo) []stader_backend.PreSignSendApiRequestType {
+	messages := make([]*stader_backend.PreSignSendApiRequestType, len(candidates))
+
+	var wg errgroup.Group
+	wg.SetLimit(presignSigningWorkers)
+	for i, candidate := range candidates {
+		i, candidate := i, candidate
+		wg.Go(func() error {
+			exitSignature, _, err := validator.GetSignedExitMessage(t.signer, candidate.pubkey, candidate.index, exitEpoch, forkInfo)
+			if err != nil {
+				t.errorLog.Printlnf("Failed to generate the SignedExitMessage for validator with beacon chain index: %d with err: %s", candidate.index, err.Error())
+				return nil
+			}
+
+			// encrypt the signature and srHash
+			exitSignatureEncrypted, err := crypto.EncryptUsingPublicKey([]byte(exitSignature.String()), t.publicKey)
+			if err != nil {
+				t.errorLog.Printlnf("Failed to encrypt exit signature for validator: %s with err: %s", candidate.pubkey, err.Error())
+				return nil
+			}
+			exitSignatureEncryptedString := crypto.EncodeBase64(exitSignatureEncrypted)
+			t.ledger.RecordSigned(candidate.pubkey.String(), candidate.index, exitEpoch, exitSignatureEncryptedString)
+
+			message := newPreSignSendMessage(candidate.pubkey.String(), candidate.index, exitEpoch, exitSignatureEncryptedString)
+			messages[i] = &message
+			return nil
+		})
+	}
+	_ = wg.Wait()
+
+	page := []stader_backend.PreSignSendApiRequestType{}
+	for _, message := range messages {
+		if message != nil {
+			page = append(page, *message)
+		}
+	}
+	return page
+}
+
+// Send a page to the backend and record the outcome of each key
+func (t *presignExitMessages) sendPage(page []stader_backend.PreSignSendApiRequestType) (uint64, error) {
+	res, err := stader.SendBulkPresignedMessageToStaderBackend(t.c, page)
+	if err != nil {
+		for _, message := range page {
+			t.ledger.RecordFailure(message.ValidatorPublicKey, false, err.Error())
+		}
+		return 0, err
+	}
+
+	sent := uint64(0)
+	for pubKey, response := range *res {
+		if response.Success {
+			t.infoLog.Printlnf("Successfully sent the presigned message for validator: %s", pubKey)
+			t.ledger.RecordAcknowledged(pubKey)
+			sent++
+		} else {
+			t.errorLog.Printlnf("Failed to send the presigned api for validator: %s with err: %s", pubKey, response.Error)
+			t.ledger.RecordFailure(pubKey, true, response.Error)
+		}
+	}
+	return sent, nil
+}
+
+func newPreSignSendMessage(validatorPubKey string, validatorIndex uint64, exitEpoch uint64, encryptedSignature string) stader_backend.PreSignSendApiRequestType {
+	return stader_backend.PreSignSendApiRequestType{
+		Message: struct {
+			Epoch          string `json:"epoch"`
+			ValidatorIndex string `json:"validator_index"`
+		}{
+			Epoch:          strconv.FormatUint(exitEpoch, 10),
+			ValidatorIndex: strconv.FormatUint(validatorIndex, 10),
+		},
+		Signature:          encryptedSignature,
+		ValidatorPublicKey: validatorPubKey,
+	}
+}
@@ -0,0 +1,286 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/automation"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	sd_collateral "github.com/stader-labs/stader-node/stader-lib/sd-collateral"
+	"github.com/stader-labs/stader-node/stader-lib/sdutility"
+	"github.com/stader-labs/stader-node/stader-lib/stader"
+	"github.com/stader-labs/stader-node/stader-lib/tokens"
+	"github.com/stader-labs/stader-node/stader-lib/utils"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Config
+var sdProtectionInterval, _ = time.ParseDuration("10m")
+
+const (
+	sdProtectionTask = "sd-protection"
+
+	// A repay keeps the health factor above the trigger for this long, so the task doesn't fire again right away
+	sdProtectionHorizonDays = 30
+)
+
+// Protect SD collateral task
+type protectSdCollateral struct {
+	c           *cli.Context
+	log         log.ColorLogger
+	cfg         *config.StaderConfig
+	w           *wallet.Wallet
+	nodeAddress common.Address
+	ledger      *automation.Ledger
+}
+
+// Create protect SD collateral task
+func newProtectSdCollateral(c *cli.Context, logger log.ColorLogger, nodeAddress common.Address, ledger *automation.Ledger) (*protectSdCollateral, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protectSdCollateral{
+		c:           c,
+		log:         logger,
+		cfg:         cfg,
+		w:           w,
+		nodeAddress: nodeAddress,
+		ledger:      ledger,
+	}, nil
+}
+
+// Repay utilized SD when the health factor is below the trigger, otherwise top up the SD collateral if the validators lack it
+func (t *protectSdCollateral) run() error {
+	if t.cfg.Automation.EnableSdProtection.Value != true {
+		return nil
+	}
+	automationTxLock.Lock()
+	defer automationTxLock.Unlock()
+
+	sdu, err := services.GetSdUtilityContract(t.c)
+	if err != nil {
+		return err
+	}
+	sdc, err := services.GetSdCollateralContract(t.c)
+	if err != nil {
+		return err
+	}
+	sdt, err := services.GetSdTokenContract(t.c)
+	if err != nil {
+		return err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(t.c)
+	if err != nil {
+		return err
+	}
+
+	alreadyLiquidated, err := sdutility.AlreadyLiquidated(sdu, t.nodeAddress)
+	if err != nil {
+		return err
+	}
+	if alreadyLiquidated {
+		t.log.Println("The SD Utility Pool position is being liquidated, SD protection has nothing to do.")
+		return nil
+	}
+
+	// Work out the SD this run may spend
+	walletSd, err := tokens.BalanceOf(sdt, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	available := t.getSpendableSd(walletSd, time.Now())
+
+	// Repay utilized SD when the health factor is below the trigger
+	position, err := sdutility.GetRiskPosition(sdu, sdc, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	trigger := eth.EthToWei(t.cfg.Automation.SdProtectionHealthFactor.Value.(float64))
+	if position.HealthFactor().Cmp(trigger) < 0 {
+		needed := position.RepayForHealthFactor(trigger, sdProtectionHorizonDays*sdutility.BlocksPerDay)
+		reason := fmt.Sprintf("health factor %.4f is below the trigger of %.4f", eth.WeiToEth(position.HealthFactor()), eth.WeiToEth(trigger))
+		amount, ok := t.limitAmount(needed, available, reason)
+		if !ok {
+			return nil
+		}
+		return t.spendSd(sdt, *sdu.SDUtilityPoolContract.Address, "repay", amount, reason,
+			func(opts *bind.TransactOpts) (stader.GasInfo, error) {
+				return sdutility.EstimateRepay(sdu, amount, opts)
+			},
+			func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return sdutility.Repay(sdu, amount, opts)
+			})
+	}
+
+	// Deposit SD collateral when the validators don't have the minimum bond
+	operatorId, err := node.GetOperatorId(pnr, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	totalKeys, err := node.GetTotalValidatorKeys(pnr, operatorId, nil)
+	if err != nil {
+		return err
+	}
+	nonTerminalKeys, err := node.GetTotalNonTerminalValidatorKeys(pnr, t.nodeAddress, totalKeys, nil)
+	if err != nil {
+		return err
+	}
+	if nonTerminalKeys == 0 {
+		return nil
+	}
+	minimumSd, err := sd_collateral.MinimumSDToBond(sdc, 1, new(big.Int).SetUint64(nonTerminalKeys), nil)
+	if err != nil {
+		return err
+	}
+	bondedSd, err := sd_collateral.GetOperatorSdBalance(sdc, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	utilizedSd, err := sd_collateral.GetOperatorUtilizedSDBalance(sdc, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	missing := new(big.Int).Sub(minimumSd, new(big.Int).Add(bondedSd, utilizedSd))
+	if missing.Sign() <= 0 {
+		return nil
+	}
+	reason := fmt.Sprintf("SD collateral is %s short of the minimum for %d validators", eth.DisplayAmountInUnits(missing, "sd"), nonTerminalKeys)
+	amount, ok := t.limitAmount(missing, available, reason)
+	if !ok {
+		return nil
+	}
+	return t.spendSd(sdt, *sdc.SdCollateralContract.Address, "deposit", amount, reason,
+		func(opts *bind.TransactOpts) (stader.GasInfo, error) {
+			return sd_collateral.EstimateDepositSdAsCollateral(sdc, amount, opts)
+		},
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return sd_collateral.DepositSdAsCollateral(sdc, amount, opts)
+		})
+}
+
+// The SD a run may spend: the wallet balance above the reserve, within what is left of the daily limit
+func (t *protectSdCollateral) getSpendableSd(walletSd *big.Int, now time.Time) *big.Int {
+	available := new(big.Int).Sub(walletSd, eth.EthToWei(t.cfg.Automation.SdProtectionReserveSd.Value.(float64)))
+	budget := new(big.Int).Sub(eth.EthToWei(t.cfg.Automation.SdProtectionMaxSdPerDay.Value.(float64)),
+		t.ledger.SpentSince(sdProtectionTask, "SD", now.Add(-24*time.Hour)))
+	if budget.Cmp(available) < 0 {
+		available = budget
+	}
+	return available
+}
+
+// Cap the SD an action needs at what the limits allow; a partial action still helps
+func (t *protectSdCollateral) limitAmount(needed *big.Int, available *big.Int, reason string) (*big.Int, bool) {
+	if available.Sign() <= 0 {
+		t.log.Printlnf("SD protection can't act although the %s: the daily limit is used up or the wallet holds no SD above the reserve.", reason)
+		return nil, false
+	}
+	if needed.Cmp(available) > 0 {
+		t.log.Printlnf("The %s; %s is needed but the limits allow only %s.", reason, eth.DisplayAmountInUnits(needed, "sd"), eth.DisplayAmountInUnits(available, "sd"))
+		return new(big.Int).Set(available), true
+	}
+	return needed, true
+}
+
+// Approve the spender for the amount if needed, then send the action and wait for it
+func (t *protectSdCollateral) spendSd(
+	sdt *stader.Erc20TokenContractManager,
+	spender common.Address,
+	kind string,
+	amount *big.Int,
+	reason string,
+	estimate func(opts *bind.TransactOpts) (stader.GasInfo, error),
+	send func(opts *bind.TransactOpts) (*types.Transaction, error),
+) error {
+	ec, err := services.GetEthClient(t.c)
+	if err != nil {
+		return err
+	}
+	maxFeeCap := eth.GweiToWei(t.cfg.Automation.SdProtectionMaxFee.Value.(float64))
+
+	allowance, err := tokens.Allowance(sdt, t.nodeAddress, spender, nil)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(amount) < 0 {
+		opts, err := t.w.GetNodeAccountTransactor()
+		if err != nil {
+			return err
+		}
+		gasInfo, err := tokens.EstimateApproveGas(sdt, spender, amount, opts)
+		if err != nil {
+			return fmt.Errorf("could not estimate the gas of the SD approval: %w", err)
+		}
+		opts, err = getAutomationTransactor(t.cfg, t.w, ec, gasInfo, maxFeeCap)
+		if errors.Is(err, errFeeTooHigh) {
+			t.log.Printlnf("Waiting to %s %s: %s.", kind, eth.DisplayAmountInUnits(amount, "sd"), err.Error())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		hash, err := tokens.Approve(sdt, spender, amount, opts)
+		if err != nil {
+			return fmt.Errorf("could not approve %s for %s: %w", eth.DisplayAmountInUnits(amount, "sd"), spender.Hex(), err)
+		}
+		t.log.Printlnf("Approving %s for %s in transaction %s, so it can be used to %s.", eth.DisplayAmountInUnits(amount, "sd"), spender.Hex(), hash.Hex(), kind)
+		if err := t.ledger.Record(automation.Action{Task: sdProtectionTask, Kind: "approve", Amount: amount, TxHash: hash, Reason: reason}); err != nil {
+			t.log.Println(err)
+		}
+		if _, err := utils.WaitForTransaction(ec, hash); err != nil {
+			return fmt.Errorf("error waiting for the SD approval %s: %w", hash.Hex(), err)
+		}
+	}
+
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	gasInfo, err := estimate(opts)
+	if err != nil {
+		return fmt.Errorf("could not estimate the gas to %s %s: %w", kind, eth.DisplayAmountInUnits(amount, "sd"), err)
+	}
+	opts, err = getAutomationTransactor(t.cfg, t.w, ec, gasInfo, maxFeeCap)
+	if errors.Is(err, errFeeTooHigh) {
+		t.log.Printlnf("Waiting to %s %s: %s.", kind, eth.DisplayAmountInUnits(amount, "sd"), err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	tx, err := send(opts)
+	if err != nil {
+		return fmt.Errorf("could not %s %s: %w", kind, eth.DisplayAmountInUnits(amount, "sd"), err)
+	}
+	t.log.Printlnf("The %s; sending a %s of %s in transaction %s.", reason, kind, eth.DisplayAmountInUnits(amount, "sd"), tx.Hash().Hex())
+	if err := t.ledger.Record(automation.Action{Task: sdProtectionTask, Kind: kind, Asset: "SD", Amount: amount, TxHash: tx.Hash(), Reason: reason}); err != nil {
+		t.log.Println(err)
+	}
+
+	receipt, err := utils.WaitForTransaction(ec, tx.Hash())
+	if err != nil {
+		return fmt.Errorf("error waiting for transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("the %s transaction %s failed", kind, tx.Hash().Hex())
+	}
+	t.log.Printlnf("The %s of %s succeeded.", kind, eth.DisplayAmountInUnits(amount, "sd"))
+	return nil
+}
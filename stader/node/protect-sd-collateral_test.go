@@ -0,0 +1,116 @@
+package node
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/stader-labs/stader-node/shared/services/automation"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+func newTestProtectSdCollateral(t *testing.T, reserveSd float64, maxSdPerDay float64, spent ...automation.Action) *protectSdCollateral {
+	cfg := &config.StaderConfig{Automation: &config.AutomationConfig{}}
+	cfg.Automation.SdProtectionReserveSd.Value = reserveSd
+	cfg.Automation.SdProtectionMaxSdPerDay.Value = maxSdPerDay
+
+	ledger, err := automation.LoadLedger(filepath.Join(t.TempDir(), "ledger.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, action := range spent {
+		if err := ledger.Record(action); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &protectSdCollateral{
+		log:    log.NewColorLogger(color.FgWhite),
+		cfg:    cfg,
+		ledger: ledger,
+	}
+}
+
+func spentSd(amount float64, ago time.Duration) automation.Action {
+	return automation.Action{
+		Time:   time.Now().Add(-ago).Unix(),
+		Task:   sdProtectionTask,
+		Kind:   "repay",
+		Asset:  "SD",
+		Amount: eth.EthToWei(amount),
+	}
+}
+
+func TestGetSpendableSd(t *testing.T) {
+	tests := []struct {
+		name        string
+		walletSd    float64
+		reserveSd   float64
+		maxSdPerDay float64
+		spent       []automation.Action
+		expected    *big.Int
+	}{
+		{"whole wallet", 500, 0, 1000, nil, eth.EthToWei(500)},
+		{"above the reserve", 500, 200, 1000, nil, eth.EthToWei(300)},
+		{"daily limit", 5000, 0, 1000, nil, eth.EthToWei(1000)},
+		{"what is left of the daily limit", 5000, 0, 1000, []automation.Action{spentSd(300, time.Hour), spentSd(200, 20*time.Hour)}, eth.EthToWei(500)},
+		{"spending older than a day", 5000, 0, 1000, []automation.Action{spentSd(900, 25*time.Hour)}, eth.EthToWei(1000)},
+		{"other tasks", 5000, 0, 1000, []automation.Action{{Time: time.Now().Unix(), Task: "cl-rewards-sweep", Asset: "SD", Amount: eth.EthToWei(900)}}, eth.EthToWei(1000)},
+		{"daily limit used up", 5000, 0, 1000, []automation.Action{spentSd(1000, time.Hour)}, big.NewInt(0)},
+		{"wallet below the reserve", 100, 200, 1000, nil, eth.EthToWei(-100)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := newTestProtectSdCollateral(t, test.reserveSd, test.maxSdPerDay, test.spent...)
+			if available := task.getSpendableSd(eth.EthToWei(test.walletSd), time.Now()); available.Cmp(test.expected) != 0 {
+				t.Errorf("expected %s, got %s", test.expected, available)
+			}
+		})
+	}
+}
+
+func TestLimitAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		needed    *big.Int
+		available *big.Int
+		expected  *big.Int
+		ok        bool
+	}{
+		{"enough SD", eth.EthToWei(10), eth.EthToWei(50), eth.EthToWei(10), true},
+		{"exactly enough SD", eth.EthToWei(50), eth.EthToWei(50), eth.EthToWei(50), true},
+		{"partial amount", eth.EthToWei(80), eth.EthToWei(50), eth.EthToWei(50), true},
+		{"nothing available", eth.EthToWei(10), big.NewInt(0), nil, false},
+		{"wallet below the reserve", eth.EthToWei(10), eth.EthToWei(-5), nil, false},
+	}
+
+	task := newTestProtectSdCollateral(t, 0, 1000)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			amount, ok := task.limitAmount(test.needed, test.available, "test")
+			if ok != test.ok {
+				t.Fatalf("expected ok to be %t, got %t", test.ok, ok)
+			}
+			if ok && amount.Cmp(test.expected) != 0 {
+				t.Errorf("expected %s, got %s", test.expected, amount)
+			}
+		})
+	}
+}
+
+func TestLimitAmountDoesNotShareAvailable(t *testing.T) {
+	task := newTestProtectSdCollateral(t, 0, 1000)
+	available := eth.EthToWei(50)
+
+	amount, _ := task.limitAmount(eth.EthToWei(80), available, "test")
+	amount.SetInt64(0)
+	if available.Cmp(eth.EthToWei(50)) != 0 {
+		t.Error("the capped amount should not alias the available amount")
+	}
+}
@@ -0,0 +1,76 @@
+package node
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/txjournal"
+	"github.com/stader-labs/stader-node/shared/types/transactions"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+)
+
+// Config
+var reconcileTransactionsInterval, _ = time.ParseDuration("5m")
+
+// Reconcile transactions task
+type reconcileTransactions struct {
+	c       *cli.Context
+	log     log.ColorLogger
+	journal *txjournal.Journal
+}
+
+// Create reconcile transactions task
+func newReconcileTransactions(c *cli.Context, logger log.ColorLogger) (*reconcileTransactions, error) {
+	journal, err := services.GetTxJournal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reconcileTransactions{
+		c:       c,
+		log:     logger,
+		journal: journal,
+	}, nil
+}
+
+// Update the transaction journal from the transaction receipts and drop the old final transactions
+func (t *reconcileTransactions) run() error {
+	ec, err := services.GetEthClient(t.c)
+	if err != nil {
+		return err
+	}
+
+	before, err := t.journal.Entries()
+	if err != nil {
+		return err
+	}
+	wasPending := map[common.Hash]bool{}
+	for _, entry := range before {
+		if entry.IsPending() {
+			wasPending[entry.Hash] = true
+		}
+	}
+
+	entries, err := t.journal.Reconcile(ec)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !wasPending[entry.Hash] || entry.IsPending() {
+			continue
+		}
+		switch entry.Status {
+		case transactions.StatusConfirmed:
+			t.log.Printlnf("Transaction %s (%s, nonce %d) was mined in block %d.", entry.Hash.Hex(), entry.Purpose, entry.Nonce, entry.BlockNumber)
+		case transactions.StatusFailed:
+			t.log.Printlnf("Transaction %s (%s, nonce %d) failed in block %d.", entry.Hash.Hex(), entry.Purpose, entry.Nonce, entry.BlockNumber)
+		default:
+			t.log.Printlnf("Transaction %s (%s, nonce %d) was %s.", entry.Hash.Hex(), entry.Purpose, entry.Nonce, entry.Status)
+		}
+	}
+
+	return t.journal.Prune()
+}
@@ -0,0 +1,146 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/stader-labs/stader-node/shared/services"
+	"github.com/stader-labs/stader-node/shared/services/automation"
+	"github.com/stader-labs/stader-node/shared/services/config"
+	"github.com/stader-labs/stader-node/shared/services/wallet"
+	"github.com/stader-labs/stader-node/shared/utils/log"
+	"github.com/stader-labs/stader-node/shared/utils/stdr"
+	"github.com/stader-labs/stader-node/stader-lib/node"
+	"github.com/stader-labs/stader-node/stader-lib/utils"
+	"github.com/stader-labs/stader-node/stader-lib/utils/eth"
+)
+
+// Config
+var clRewardsSweepInterval, _ = time.ParseDuration("6h")
+
+const clRewardsSweepTask = "cl-rewards-sweep"
+
+// Sweep CL rewards task
+type sweepClRewards struct {
+	c           *cli.Context
+	log         log.ColorLogger
+	cfg         *config.StaderConfig
+	w           *wallet.Wallet
+	nodeAddress common.Address
+	ledger      *automation.Ledger
+}
+
+// Create sweep CL rewards task
+func newSweepClRewards(c *cli.Context, logger log.ColorLogger, nodeAddress common.Address, ledger *automation.Ledger) (*sweepClRewards, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sweepClRewards{
+		c:           c,
+		log:         logger,
+		cfg:         cfg,
+		w:           w,
+		nodeAddress: nodeAddress,
+		ledger:      ledger,
+	}, nil
+}
+
+// Distribute the CL rewards of the withdraw vaults above the minimum, the most rewards per unit of gas first
+func (t *sweepClRewards) run() error {
+	if t.cfg.Automation.EnableClRewardsSweep.Value != true {
+		return nil
+	}
+	automationTxLock.Lock()
+	defer automationTxLock.Unlock()
+
+	ec, err := services.GetEthClient(t.c)
+	if err != nil {
+		return err
+	}
+	pnr, err := services.GetPermissionlessNodeRegistry(t.c)
+	if err != nil {
+		return err
+	}
+	sdcfg, err := services.GetStaderConfigContract(t.c)
+	if err != nil {
+		return err
+	}
+	putils, err := services.GetPoolUtilsContract(t.c)
+	if err != nil {
+		return err
+	}
+
+	operatorId, err := node.GetOperatorId(pnr, t.nodeAddress, nil)
+	if err != nil {
+		return err
+	}
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	minimum := eth.EthToWei(t.cfg.Automation.ClRewardsSweepMinimum.Value.(float64))
+	vaults, _, err := stdr.GetClRewardsVaults(pnr, putils, sdcfg, operatorId, t.nodeAddress, minimum, opts)
+	if err != nil {
+		return err
+	}
+
+	maxGasShare := t.cfg.Automation.ClRewardsSweepMaxGasShare.Value.(float64)
+	for _, vault := range vaults {
+		if vault.SkipReason != "" {
+			t.log.Printlnf("Skipping the CL rewards of %s: %s.", vault.ValidatorPubKey, vault.SkipReason)
+			continue
+		}
+		if !vault.Distribute {
+			continue
+		}
+
+		opts, err := getAutomationTransactor(t.cfg, t.w, ec, vault.GasInfo, nil)
+		if errors.Is(err, errFeeTooHigh) {
+			t.log.Printlnf("Waiting to distribute CL rewards: %s.", err.Error())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		cost, err := getExpectedTxCost(ec, opts, vault.GasInfo)
+		if err != nil {
+			return err
+		}
+		// The vaults are in order of rewards per unit of gas, so the rest cost even more
+		if cost.Cmp(getMaxGasCost(vault.OperatorRewards, maxGasShare)) > 0 {
+			t.log.Printlnf("Waiting to distribute the CL rewards of %s worth %s: the gas would cost about %s, above %.2f%% of it.", vault.ValidatorPubKey, eth.DisplayAmountInUnits(vault.OperatorRewards, "eth"), eth.DisplayAmountInUnits(cost, "eth"), maxGasShare)
+			return nil
+		}
+
+		tx, err := node.DistributeRewards(pnr.Client, vault.WithdrawVaultAddress, opts)
+		if err != nil {
+			t.log.Printlnf("Could not distribute the CL rewards of %s: %s", vault.ValidatorPubKey, err.Error())
+			continue
+		}
+		t.log.Printlnf("Distributing the CL rewards of %s worth %s in transaction %s.", vault.ValidatorPubKey, eth.DisplayAmountInUnits(vault.OperatorRewards, "eth"), tx.Hash().Hex())
+		if err := t.ledger.Record(automation.Action{Task: clRewardsSweepTask, Kind: "distribute", Asset: "ETH", Amount: vault.OperatorRewards, TxHash: tx.Hash(), Reason: fmt.Sprintf("CL rewards of %s", vault.ValidatorPubKey)}); err != nil {
+			t.log.Println(err)
+		}
+
+		receipt, err := utils.WaitForTransaction(ec, tx.Hash())
+		if err != nil {
+			return fmt.Errorf("error waiting for transaction %s: %w", tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.log.Printlnf("The CL rewards distribution %s failed.", tx.Hash().Hex())
+		}
+	}
+
+	return nil
+}
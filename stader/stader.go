@@ -781,6 +781,14 @@ Public License instead of this License. But first, please read
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.StringFlag{
+			Name:  "offline",
+			Usage: "Add the transactions of the node account with this `address` to its offline bundle instead of signing and sending them",
+		},
+		cli.StringFlag{
+			Name:  "address",
+			Usage: "Watch the node account with this `address` without its keys, refusing anything that needs them",
+		},
 		cli.StringFlag{
 			Name:  "metricsAddress, m",
 			Usage: "Address to serve metrics on if enabled",